@@ -0,0 +1,314 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Minimum API version of resources and of their attributes. The key "" stands for the resource itself,
+// nested attributes are separated by dots (e.g. "retention_policy.max_disk_space"). Gaia resources are
+// checked against the Gaia API version, other resources against the Management API version.
+// Management API versions: R80.40 is 1.6, R81 is 1.7, R81.10 is 1.8, R81.20 is 1.9, R82 is 2.0 and
+// R82.10 is 2.1. Gaia API versions: R81.10 is 1.6, R81.20 is 1.7 and R82 is 1.8.
+// The entries are the versions the resource documentation states. Requirements of a Jumbo Hotfix
+// can't be told from the API version, such attributes are checked against the version of the release.
+var attributeMinVersions = map[string]map[string]string{
+	"checkpoint_management_smart_task":                 {"": "1.6"},
+	"checkpoint_management_data_center_query":          {"": "1.7"},
+	"checkpoint_management_repository_script":          {"": "1.9"},
+	"checkpoint_management_proxmox_data_center_server": {"": "2.1"},
+	"checkpoint_gaia_scheduled_backup":                 {"retention_policy": "1.6"},
+	"checkpoint_gaia_syslog":                           {"tls_configuration": "1.8"},
+	"checkpoint_gaia_ntp":                              {"servers.type": "1.8"},
+	"checkpoint_gaia_arp":                              {"settings.auto_cache_size": "1.8"},
+	"checkpoint_gaia_physical_interface":               {"sd_wan": "1.7", "sd_wan.bandwidth": "1.7", "sd_wan.circuit_id": "1.7", "sd_wan.next_hop_ipv6": "1.8", "sd_wan.nat.ipv6": "1.8"},
+	"checkpoint_gaia_bond_interface":                   {"sd_wan": "1.7", "sd_wan.bandwidth": "1.7", "sd_wan.circuit_id": "1.7", "sd_wan.next_hop_ipv6": "1.8", "sd_wan.nat.ipv6": "1.8"},
+	"checkpoint_gaia_vlan_interface":                   {"sd_wan": "1.7", "sd_wan.bandwidth": "1.7", "sd_wan.circuit_id": "1.7", "sd_wan.next_hop_ipv6": "1.8", "sd_wan.nat.ipv6": "1.8"},
+	"checkpoint_gaia_pppoe_interface":                  {"sd_wan": "1.7", "sd_wan.bandwidth": "1.7", "sd_wan.circuit_id": "1.7", "sd_wan.next_hop_ipv6": "1.8", "sd_wan.nat.ipv6": "1.8"},
+}
+
+// apiVersionGate fails the plan of resources which configure attributes the API version doesn't
+// support. The version is the provider "api_version", or when it's not set the current version of the
+// server, which is read with show-api-versions on first use. Gaia resources are checked against the
+// server of the provider "gaia" block when it's configured.
+type apiVersionGate struct {
+	mu       sync.Mutex
+	pinned   string
+	gaia     *gaiaConnection
+	versions map[*checkpoint.ApiClient]string
+}
+
+func (g *apiVersionGate) configure(apiVersion string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.pinned = apiVersion
+	g.versions = nil
+}
+
+// route adds the version check to the plan of resources with minimum versions.
+func (g *apiVersionGate) route(provider *schema.Provider) {
+	for name, minVersions := range attributeMinVersions {
+		r, ok := provider.ResourcesMap[name]
+		if !ok {
+			continue
+		}
+		for path, version := range minVersions {
+			describeMinVersion(name, r, path, version)
+		}
+		check := g.customizeDiff(name, minVersions)
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, check)
+		} else {
+			r.CustomizeDiff = check
+		}
+	}
+}
+
+func (g *apiVersionGate) customizeDiff(name string, minVersions map[string]string) schema.CustomizeDiffFunc {
+	paths := make([]string, 0, len(minVersions))
+	for path := range minVersions {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	api := "Management API"
+	if isGaiaResource(name) {
+		api = "Gaia API"
+	}
+
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		// only new resources and changed attributes are checked, resources already in the state were
+		// created with a version which supports them
+		var unsupported []string
+		for _, path := range paths {
+			if path == "" && d.Id() != "" {
+				continue
+			}
+			if path != "" && (!isConfigured(d, path) || (d.Id() != "" && !d.HasChange(strings.SplitN(path, ".", 2)[0]))) {
+				continue
+			}
+			version, pinned, err := g.clientVersion(name, m)
+			if err != nil {
+				return err
+			}
+			if version == "" || compareApiVersions(version, minVersions[path]) >= 0 {
+				continue
+			}
+			subject := fmt.Sprintf("attribute %q of %s", path, name)
+			if path == "" {
+				subject = name
+			}
+			source := "the server API version is " + version
+			if pinned {
+				source = "the provider api_version is " + version
+			}
+			unsupported = append(unsupported, fmt.Sprintf("%s requires %s version %s or later, %s", subject, api, minVersions[path], source))
+		}
+		if len(unsupported) > 0 {
+			return fmt.Errorf("%s", strings.Join(unsupported, "\n"))
+		}
+		return nil
+	}
+}
+
+// describeMinVersion adds the minimum version to the description of the resource or the attribute.
+func describeMinVersion(name string, r *schema.Resource, path string, version string) {
+	api := "Management API"
+	if isGaiaResource(name) {
+		api = "Gaia API"
+	}
+	note := "Requires " + api + " version " + version + " or later."
+	if path == "" {
+		r.Description = strings.TrimSpace(r.Description + " " + note)
+		return
+	}
+	schemaMap := r.Schema
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		s, ok := schemaMap[part]
+		if !ok {
+			return
+		}
+		if i == len(parts)-1 {
+			s.Description = strings.TrimSpace(s.Description + " " + note)
+			return
+		}
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			return
+		}
+		schemaMap = elem.Schema
+	}
+}
+
+// clientVersion returns the API version the calls of the resource are sent with and whether it's the
+// provider api_version. An empty version is returned when the version of the server can't be detected.
+func (g *apiVersionGate) clientVersion(name string, m interface{}) (string, bool, error) {
+	meta := m
+	if isGaiaResource(name) && g.gaia != nil {
+		var err error
+		if m, err = g.gaia.clientFor(meta); err != nil {
+			return "", false, err
+		}
+	}
+	client, ok := m.(*checkpoint.ApiClient)
+	if !ok {
+		return "", false, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// the provider api_version isn't sent by the connection of the provider "gaia" block
+	pinned := ""
+	if m == meta {
+		pinned = g.pinned
+	}
+	if version, ok := g.versions[client]; ok {
+		return version, pinned != "", nil
+	}
+	version, err := serverVersion(client, pinned)
+	if err != nil {
+		return "", true, err
+	}
+	if g.versions == nil {
+		g.versions = make(map[*checkpoint.ApiClient]string)
+	}
+	g.versions[client] = version
+	return version, pinned != "", nil
+}
+
+// serverVersion returns the pinned version when it's supported by the server, or the current version
+// of the server.
+func serverVersion(client *checkpoint.ApiClient, pinned string) (string, error) {
+	res, err := apiCall(client, "show-api-versions", map[string]interface{}{}, client.GetSessionID(), false, client.IsProxyUsed())
+	if err != nil || !res.Success {
+		if err == nil {
+			err = fmt.Errorf("%s", res.ErrorMsg)
+		}
+		log.Printf("[WARN] failed to detect the API version of the server, attributes aren't checked against it: %s", err)
+		return pinned, nil
+	}
+	current := toString(res.GetData()["current-version"])
+	if pinned == "" {
+		log.Printf("Check Point server API version is %s", current)
+		return current, nil
+	}
+	if supported, ok := res.GetData()["supported-versions"].([]interface{}); ok && len(supported) > 0 {
+		versions := make([]string, 0, len(supported))
+		for _, v := range supported {
+			if toString(v) == pinned {
+				return pinned, nil
+			}
+			versions = append(versions, toString(v))
+		}
+		return "", fmt.Errorf("api_version %s isn't supported by the server, supported versions: %s", pinned, strings.Join(versions, ", "))
+	}
+	return pinned, nil
+}
+
+// isConfigured reports whether the attribute at the dotted path is set in the resource configuration.
+// Attributes in nested blocks are configured when they are set in any of the blocks.
+func isConfigured(d *schema.ResourceDiff, path string) bool {
+	parts := strings.Split(path, ".")
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		return ctyConfigured(raw, parts)
+	}
+	// configuration which didn't come from Terraform core, e.g. in unit tests
+	v, ok := d.GetOk(parts[0])
+	return ok && valueConfigured(v, parts[1:])
+}
+
+func ctyConfigured(v cty.Value, parts []string) bool {
+	if v.IsNull() {
+		return false
+	}
+	if !v.IsKnown() || len(parts) == 0 {
+		return true
+	}
+	t := v.Type()
+	switch {
+	case t.IsObjectType():
+		if !t.HasAttribute(parts[0]) {
+			return false
+		}
+		return ctyConfigured(v.GetAttr(parts[0]), parts[1:])
+	case t.IsListType() || t.IsSetType() || t.IsTupleType():
+		for it := v.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			if ctyConfigured(element, parts) {
+				return true
+			}
+		}
+	case t.IsMapType():
+		key := cty.StringVal(parts[0])
+		if v.HasIndex(key).True() {
+			return ctyConfigured(v.Index(key), parts[1:])
+		}
+	}
+	return false
+}
+
+func valueConfigured(v interface{}, parts []string) bool {
+	if len(parts) == 0 {
+		return v != nil
+	}
+	switch value := v.(type) {
+	case *schema.Set:
+		return valueConfigured(value.List(), parts)
+	case []interface{}:
+		for _, element := range value {
+			if valueConfigured(element, parts) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		child, ok := value[parts[0]]
+		if !ok {
+			return false
+		}
+		switch c := child.(type) {
+		case string:
+			return c != "" && len(parts) == 1
+		case bool:
+			return c && len(parts) == 1
+		case int:
+			return c != 0 && len(parts) == 1
+		case float64:
+			return c != 0 && len(parts) == 1
+		}
+		return valueConfigured(child, parts[1:])
+	}
+	return false
+}
+
+// compareApiVersions compares versions such as "1.9" and "1.10" by their numbers.
+func compareApiVersions(a string, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,122 @@
+package checkpoint
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCompareApiVersions(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.9", "1.10", -1},
+		{"2.0", "1.9", 1},
+		{"1.8", "1.8", 0},
+		{"1.8.1", "1.8", 1},
+	} {
+		if got := compareApiVersions(tc.a, tc.b); got != tc.expected {
+			t.Errorf("compareApiVersions(%s, %s) expected %d, got %d", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
+func TestAttributeMinVersions(t *testing.T) {
+	provider := Provider()
+	for name, minVersions := range attributeMinVersions {
+		r, ok := provider.ResourcesMap[name]
+		if !ok {
+			t.Errorf("resource %s of the minimum versions doesn't exist", name)
+			continue
+		}
+		for path := range minVersions {
+			schemaMap := r.Schema
+			for _, part := range strings.Split(path, ".") {
+				if path == "" {
+					break
+				}
+				s, ok := schemaMap[part]
+				if !ok {
+					t.Errorf("attribute %s of %s doesn't exist", path, name)
+					break
+				}
+				if elem, ok := s.Elem.(*schema.Resource); ok {
+					schemaMap = elem.Schema
+				}
+			}
+		}
+	}
+}
+
+func TestApiVersionGate_offline(t *testing.T) {
+	syslog := map[string]interface{}{"tls_configuration": []interface{}{map[string]interface{}{"ca_certification": "/home/admin/ca.pem"}}}
+	for _, tc := range []struct {
+		name       string
+		context    string
+		version    string // server version
+		apiVersion string // provider api_version
+		resource   string
+		config     map[string]interface{}
+		wantErr    string
+	}{
+		{"supported resource", "web_api", "1.8", "", "checkpoint_management_data_center_query", map[string]interface{}{"name": "q"}, ""},
+		{"unsupported resource", "web_api", "1.8", "", "checkpoint_management_repository_script", map[string]interface{}{"name": "s", "script_body": "ls"},
+			"checkpoint_management_repository_script requires Management API version 1.9 or later, the server API version is 1.8"},
+		{"pinned version", "web_api", "1.9", "1.7", "checkpoint_management_repository_script", map[string]interface{}{"name": "s", "script_body": "ls"},
+			"the provider api_version is 1.7"},
+		{"unsupported pinned version", "web_api", "1.9", "1.5", "checkpoint_management_repository_script", map[string]interface{}{"name": "s", "script_body": "ls"},
+			"api_version 1.5 isn't supported by the server, supported versions: 1.6, 1.7, 1.8, 1.9"},
+		{"attribute not set", "gaia_api", "1.7", "", "checkpoint_gaia_syslog", map[string]interface{}{"audit_log": true}, ""},
+		{"unsupported attribute", "gaia_api", "1.7", "", "checkpoint_gaia_syslog", syslog,
+			`attribute "tls_configuration" of checkpoint_gaia_syslog requires Gaia API version 1.8 or later, the server API version is 1.7`},
+		{"supported attribute", "gaia_api", "1.8", "", "checkpoint_gaia_syslog", syslog, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			if tc.apiVersion != "" {
+				config["api_version"] = tc.apiVersion
+			}
+			server, provider := testFakeProvider(t, tc.context, config)
+			server.version = tc.version
+			r := provider.ResourcesMap[tc.resource]
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), provider.Meta())
+			if tc.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+			}
+			if tc.apiVersion != "" && !server.versions[tc.apiVersion] {
+				t.Errorf("expected the calls to be sent with version %s, got %v", tc.apiVersion, server.versions)
+			}
+		})
+	}
+}
+
+func TestApiVersionGateGaiaBlock_offline(t *testing.T) {
+	gaia := newFakeServer()
+	defer gaia.Close()
+	gaia.version = "1.7"
+	host, port := gaia.hostPort()
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{
+		"gaia": []interface{}{map[string]interface{}{"server": host, "port": port}},
+	})
+	server.version = "2.0"
+
+	r := provider.ResourcesMap["checkpoint_gaia_syslog"]
+	config := map[string]interface{}{"tls_configuration": []interface{}{map[string]interface{}{"ca_certification": "/home/admin/ca.pem"}}}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), provider.Meta())
+	if err == nil || !strings.Contains(err.Error(), "requires Gaia API version 1.8 or later, the server API version is 1.7") {
+		t.Fatalf("expected Gaia resources to be checked against the Gaia server version, got %v", err)
+	}
+
+	r = provider.ResourcesMap["checkpoint_management_repository_script"]
+	if _, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "s", "script_body": "ls"}), provider.Meta()); err != nil {
+		t.Fatalf("expected Management resources to be checked against the Management server version, got %v", err)
+	}
+}
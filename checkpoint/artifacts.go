@@ -0,0 +1,230 @@
+package checkpoint
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// clientConnection holds the connection settings of a provider client, for requests the SDK can't
+// send, such as file downloads.
+type clientConnection struct {
+	args  checkpoint.ApiClientArgs
+	gaia  *gaiaConnection // Gaia API of the management server, when the provider "gaia" block is configured
+	trust servercert.Trust
+}
+
+// Connections of the provider clients.
+var clientConnections sync.Map
+
+func setClientConnection(client *checkpoint.ApiClient, conn clientConnection) {
+	clientConnections.Store(client, conn)
+}
+
+// Task details fields which identify the attachment of a task, e.g. the archive of an export.
+var attachmentIdFields = []string{"attachment-id", "attachmentId", "attachment-uid"}
+
+// downloadCommandArtifact saves the artifact of a command to "output_path", when it's set, and
+// records its checksum in "output_sha256". data is the command response, which has the task of the
+// command or the file path on the server.
+func downloadCommandArtifact(d *schema.ResourceData, client *checkpoint.ApiClient, data map[string]interface{}, filePath string) error {
+	v, ok := d.GetOk("output_path")
+	if !ok {
+		return nil
+	}
+	taskData := data
+	if taskId, ok := resolveTaskId(data).(string); ok && taskId != "" {
+		if fullData, err := showTaskFull(client, taskId); err == nil {
+			taskData = fullData
+		} else {
+			log.Printf("[WARN] failed to read task %s details: %s", taskId, err)
+		}
+	}
+	sum, err := downloadArtifact(client, taskData, artifactServerPath(data, filePath), v.(string))
+	if err != nil {
+		return err
+	}
+	_ = d.Set("output_sha256", sum)
+	return nil
+}
+
+// downloadArtifact saves the artifact of a completed task to outputPath and returns its SHA-256
+// checksum. The artifact is fetched with get-attachment when the task details have an attachment,
+// otherwise serverPath is read from the management server with the Gaia get-file command.
+func downloadArtifact(client *checkpoint.ApiClient, taskData map[string]interface{}, serverPath string, outputPath string) (string, error) {
+	var content []byte
+	var err error
+	if attachmentId := findAttachmentId(taskData); attachmentId != "" {
+		log.Printf("Download attachment %s to %s", attachmentId, outputPath)
+		content, err = getAttachment(client, attachmentId)
+	} else if serverPath != "" {
+		log.Printf("Download %s to %s", serverPath, outputPath)
+		content, err = getServerFile(client, serverPath)
+	} else {
+		err = fmt.Errorf("the task has no attachment and the file path on the server is unknown")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to download to %s: %s", outputPath, err)
+	}
+	if err := writeArtifact(outputPath, content); err != nil {
+		return "", fmt.Errorf("failed to write %s: %s", outputPath, err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// findAttachmentId returns the first attachment id in the task data.
+func findAttachmentId(data interface{}) string {
+	switch value := data.(type) {
+	case map[string]interface{}:
+		for _, field := range attachmentIdFields {
+			if id, ok := value[field].(string); ok && id != "" {
+				return id
+			}
+		}
+		for _, child := range value {
+			if id := findAttachmentId(child); id != "" {
+				return id
+			}
+		}
+	case []interface{}:
+		for _, child := range value {
+			if id := findAttachmentId(child); id != "" {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// getAttachment runs get-attachment, which returns the file itself rather than a JSON document.
+func getAttachment(client *checkpoint.ApiClient, attachmentId string) ([]byte, error) {
+	v, ok := clientConnections.Load(client)
+	if !ok {
+		return nil, fmt.Errorf("connection settings of the client are unknown")
+	}
+	conn := v.(clientConnection)
+	args := conn.args
+
+	timeout := args.Timeout
+	if timeout == -1 || timeout == checkpoint.TimeOut {
+		timeout = checkpoint.TimeOut
+	} else {
+		timeout = timeout * time.Second
+	}
+	// the certificate is verified on the connection of the download itself
+	address := net.JoinHostPort(args.Server, strconv.Itoa(client.GetPort()))
+	transport := &http.Transport{TLSClientConfig: conn.trust.TLSConfig(address)}
+	if proxy := clientProxy(args); proxy != "" {
+		transport.Proxy = http.ProxyURL(&url.URL{Scheme: "http", Host: proxy})
+	}
+	httpClient := &http.Client{Timeout: timeout, Transport: transport}
+
+	reqUrl := "https://" + address
+	if args.CloudMgmtId != "" {
+		reqUrl += "/" + args.CloudMgmtId
+	}
+	reqUrl += "/" + client.GetContext() + "/get-attachment"
+	payload, _ := json.Marshal(map[string]interface{}{"attachment-id": attachmentId})
+	req, err := http.NewRequest("POST", reqUrl, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", args.UserAgent)
+	req.Header.Set("Accept", "*/*")
+	req.Header.Set("X-chkp-sid", client.GetSessionID())
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	content, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		var apiErr map[string]interface{}
+		if json.Unmarshal(content, &apiErr) == nil && apiErr["message"] != nil {
+			return nil, fmt.Errorf("get-attachment failed: %v", apiErr["message"])
+		}
+		return nil, fmt.Errorf("get-attachment failed: %s", res.Status)
+	}
+	return content, nil
+}
+
+// getServerFile reads a file of the management server with the Gaia get-file command.
+func getServerFile(client *checkpoint.ApiClient, path string) ([]byte, error) {
+	v, _ := clientConnections.Load(client)
+	conn, _ := v.(clientConnection)
+	if conn.gaia == nil {
+		return nil, fmt.Errorf("reading %s from the server requires the provider gaia block", path)
+	}
+	gaia, err := conn.gaia.clientFor(client)
+	if err != nil {
+		return nil, err
+	}
+	gaiaClient := gaia.(*checkpoint.ApiClient)
+	res, err := apiCallSimple(gaiaClient, "get-file", map[string]interface{}{"file-name": path})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return nil, fmt.Errorf("get-file failed: %s", res.ErrorMsg)
+	}
+	if encoded, ok := res.GetData()["base64-content"].(string); ok {
+		return base64.StdEncoding.DecodeString(encoded)
+	}
+	return []byte(toString(res.GetData()["text-content"])), nil
+}
+
+// writeArtifact writes the file readable by its owner only. The file is replaced only once it's complete.
+func writeArtifact(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// artifactServerPath returns the path of a file the management server writes to when the path is
+// known: the file path of the command or the file path the command returned.
+func artifactServerPath(data map[string]interface{}, filePath string) string {
+	if v, ok := data["file-path"].(string); ok && v != "" {
+		return v
+	}
+	return strings.TrimSpace(filePath)
+}
@@ -0,0 +1,52 @@
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDownloadArtifactAttachment_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["export"] = []interface{}{map[string]interface{}{"attachment-id": "a1"}}
+	server.files["a1"] = []byte("exported objects")
+
+	outputPath := filepath.Join(t.TempDir(), "exports", "export.tar.gz")
+	state := testFakeApply(t, provider, "checkpoint_management_export", nil, map[string]interface{}{"output_path": outputPath})
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil || string(content) != "exported objects" {
+		t.Fatalf("expected the attachment in %s, got %q %v", outputPath, content, err)
+	}
+	if info, _ := os.Stat(outputPath); info.Mode().Perm() != 0o600 {
+		t.Errorf("expected the file to be readable by its owner only, got %s", info.Mode())
+	}
+	sum := sha256.Sum256(content)
+	if state.Attributes["output_sha256"] != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected output_sha256 %q", state.Attributes["output_sha256"])
+	}
+}
+
+func TestDownloadArtifactServerFile_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["export-smart-task"] = nil
+
+	outputPath := filepath.Join(t.TempDir(), "task.txt")
+	state, diags := testFakeApplyDiags(t, provider, "checkpoint_management_command_export_smart_task", nil, map[string]interface{}{
+		"name":        "dummy",
+		"file_path":   "/var/log/dummy.txt",
+		"output_path": outputPath,
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "requires the provider gaia block") {
+		t.Fatalf("expected reading the server file without Gaia to fail, got %v", diags)
+	}
+	if state == nil || state.ID == "" {
+		t.Errorf("expected the resource to be kept in the state")
+	}
+	if _, err := os.Stat(outputPath); !os.IsNotExist(err) {
+		t.Errorf("expected no file to be written, got %v", err)
+	}
+}
@@ -83,7 +83,7 @@ func readGaiaShowAliasInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-alias-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-alias-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-alias-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
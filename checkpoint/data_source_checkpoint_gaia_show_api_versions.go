@@ -49,7 +49,7 @@ func readGaiaShowApiVersions(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-api-versions - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-api-versions", payload)
+    commandRes, err := apiCallSimple(client, "show-api-versions", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
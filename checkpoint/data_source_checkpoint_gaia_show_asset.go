@@ -207,7 +207,7 @@ func readGaiaShowAsset(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-asset - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-asset", payload)
+    commandRes, err := apiCallSimple(client, "show-asset", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -107,7 +107,7 @@ func readGaiaShowBgpErrors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-errors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-errors", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-errors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
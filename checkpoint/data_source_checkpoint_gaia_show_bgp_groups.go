@@ -120,7 +120,7 @@ func readGaiaShowBgpGroups(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-groups - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-groups", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-groups", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
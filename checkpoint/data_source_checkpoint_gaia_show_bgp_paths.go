@@ -137,7 +137,7 @@ func readGaiaShowBgpPaths(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-paths - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-paths", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-paths", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
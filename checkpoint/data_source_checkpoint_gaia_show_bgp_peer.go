@@ -324,7 +324,7 @@ func readGaiaShowBgpPeer(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-peer - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-peer", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -385,7 +385,7 @@ func readGaiaShowBgpPeers(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-peers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-peers", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-peers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -152,7 +152,7 @@ func readGaiaShowBgpRouteIn(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-route-in - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-route-in", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-route-in", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
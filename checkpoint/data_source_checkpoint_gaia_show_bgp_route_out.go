@@ -152,7 +152,7 @@ func readGaiaShowBgpRouteOut(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-route-out - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-route-out", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-route-out", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
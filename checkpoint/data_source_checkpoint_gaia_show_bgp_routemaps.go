@@ -237,7 +237,7 @@ func readGaiaShowBgpRoutemaps(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-routemaps - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-routemaps", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-routemaps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -185,7 +185,7 @@ func readGaiaShowBgpRoutesIn(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-routes-in - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-routes-in", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-routes-in", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
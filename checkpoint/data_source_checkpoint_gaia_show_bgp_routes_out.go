@@ -185,7 +185,7 @@ func readGaiaShowBgpRoutesOut(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-routes-out - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-routes-out", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-routes-out", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -160,7 +160,7 @@ func readGaiaShowBgpStats(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -86,7 +86,7 @@ func readGaiaShowBgpSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bgp-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bgp-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-bgp-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
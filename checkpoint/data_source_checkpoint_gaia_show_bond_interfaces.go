@@ -396,7 +396,7 @@ func readGaiaShowBondInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bond-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bond-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-bond-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
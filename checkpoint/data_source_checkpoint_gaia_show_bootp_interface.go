@@ -104,7 +104,7 @@ func readGaiaShowBootpInterface(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bootp-interface - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bootp-interface", payload)
+    commandRes, err := apiCallSimple(client, "show-bootp-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -151,7 +151,7 @@ func readGaiaShowBootpInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bootp-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bootp-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-bootp-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -198,7 +198,7 @@ func readGaiaShowBootpStats(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bootp-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bootp-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-bootp-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -269,7 +269,7 @@ func readGaiaShowBridgeInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-bridge-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-bridge-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-bridge-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
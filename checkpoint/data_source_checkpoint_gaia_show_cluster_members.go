@@ -155,7 +155,7 @@ func readGaiaShowClusterMembers(d *schema.ResourceData, m interface{}) error {
     payload := map[string]interface{}{}
 
     log.Println("Execute show-cluster-members - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-cluster-members", payload)
+    commandRes, err := apiCallSimple(client, "show-cluster-members", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -114,7 +114,7 @@ func readGaiaShowClusterState(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-cluster-state - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-cluster-state", payload)
+    commandRes, err := apiCallSimple(client, "show-cluster-state", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -191,7 +191,7 @@ func readGaiaShowConfigurationBgp(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-configuration-bgp - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -91,7 +91,7 @@ func readGaiaShowConfigurationBgpConfederation(d *schema.ResourceData, m interfa
     }
 
     log.Println("Execute show-configuration-bgp-confederation - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-confederation", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-confederation", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -182,7 +182,7 @@ func readGaiaShowConfigurationBgpConfederationPeer(d *schema.ResourceData, m int
     }
 
     log.Println("Execute show-configuration-bgp-confederation-peer - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-confederation-peer", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-confederation-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
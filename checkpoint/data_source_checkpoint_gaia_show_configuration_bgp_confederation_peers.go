@@ -243,7 +243,7 @@ func readGaiaShowConfigurationBgpConfederationPeers(d *schema.ResourceData, m in
     }
 
     log.Println("Execute show-configuration-bgp-confederation-peers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-confederation-peers", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-confederation-peers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -161,7 +161,7 @@ func readGaiaShowConfigurationBgpExternal(d *schema.ResourceData, m interface{})
     }
 
     log.Println("Execute show-configuration-bgp-external - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-external", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-external", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
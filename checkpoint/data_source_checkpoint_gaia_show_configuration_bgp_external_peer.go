@@ -390,7 +390,7 @@ func readGaiaShowConfigurationBgpExternalPeer(d *schema.ResourceData, m interfac
     }
 
     log.Println("Execute show-configuration-bgp-external-peer - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-external-peer", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-external-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -451,7 +451,7 @@ func readGaiaShowConfigurationBgpExternalPeers(d *schema.ResourceData, m interfa
     }
 
     log.Println("Execute show-configuration-bgp-external-peers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-external-peers", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-external-peers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
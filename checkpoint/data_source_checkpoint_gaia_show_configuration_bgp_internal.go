@@ -149,7 +149,7 @@ func readGaiaShowConfigurationBgpInternal(d *schema.ResourceData, m interface{})
     }
 
     log.Println("Execute show-configuration-bgp-internal - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-internal", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-internal", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
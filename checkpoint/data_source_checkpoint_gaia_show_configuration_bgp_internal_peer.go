@@ -226,7 +226,7 @@ func readGaiaShowConfigurationBgpInternalPeer(d *schema.ResourceData, m interfac
     }
 
     log.Println("Execute show-configuration-bgp-internal-peer - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-internal-peer", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-internal-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -287,7 +287,7 @@ func readGaiaShowConfigurationBgpInternalPeers(d *schema.ResourceData, m interfa
     }
 
     log.Println("Execute show-configuration-bgp-internal-peers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-bgp-internal-peers", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-bgp-internal-peers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -238,7 +238,7 @@ func readGaiaShowConfigurationIpv6Pim(d *schema.ResourceData, m interface{}) err
     }
 
     log.Println("Execute show-configuration-ipv6-pim - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-ipv6-pim", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-ipv6-pim", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
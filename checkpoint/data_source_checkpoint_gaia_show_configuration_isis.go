@@ -493,7 +493,7 @@ func readGaiaShowConfigurationIsis(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-configuration-isis - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-isis", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-isis", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
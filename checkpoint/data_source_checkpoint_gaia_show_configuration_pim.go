@@ -238,7 +238,7 @@ func readGaiaShowConfigurationPim(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-configuration-pim - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-configuration-pim", payload)
+    commandRes, err := apiCallSimple(client, "show-configuration-pim", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
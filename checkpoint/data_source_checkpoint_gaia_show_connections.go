@@ -154,7 +154,7 @@ func readGaiaShowConnections(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-connections - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-connections", payload)
+    commandRes, err := apiCallSimple(client, "show-connections", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -199,7 +199,7 @@ func readGaiaShowConnections(d *schema.ResourceData, m interface{}) error {
         return fmt.Errorf(msg)
     }
 
-    _taskDetailsRes, _tdErr := client.ApiCallSimple("show-task", map[string]interface{}{"task-id": taskRes.TaskID})
+    _taskDetailsRes, _tdErr := apiCallSimple(client, "show-task", map[string]interface{}{"task-id": taskRes.TaskID})
     var _asyncRespData map[string]interface{}
     if _tdErr == nil && _taskDetailsRes.Success {
         _td := _taskDetailsRes.GetData()
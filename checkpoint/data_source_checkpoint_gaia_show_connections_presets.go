@@ -55,7 +55,7 @@ func readGaiaShowConnectionsPresets(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-connections-presets - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-connections-presets", payload)
+    commandRes, err := apiCallSimple(client, "show-connections-presets", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
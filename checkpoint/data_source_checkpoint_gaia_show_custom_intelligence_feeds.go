@@ -140,7 +140,7 @@ func readGaiaShowCustomIntelligenceFeeds(d *schema.ResourceData, m interface{})
     }
 
     log.Println("Execute show-custom-intelligence-feeds - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-custom-intelligence-feeds", payload)
+    commandRes, err := apiCallSimple(client, "show-custom-intelligence-feeds", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
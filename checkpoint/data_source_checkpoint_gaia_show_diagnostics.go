@@ -98,7 +98,7 @@ func readGaiaShowDiagnostics(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-diagnostics - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-diagnostics", payload)
+    commandRes, err := apiCallSimple(client, "show-diagnostics", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -41,7 +41,7 @@ func readGaiaShowDiagnosticsTopics(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-diagnostics-topics - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-diagnostics-topics", payload)
+    commandRes, err := apiCallSimple(client, "show-diagnostics-topics", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
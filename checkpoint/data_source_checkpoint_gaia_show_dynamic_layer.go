@@ -883,7 +883,7 @@ func readGaiaShowDynamicLayer(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-dynamic-layer - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-dynamic-layer", payload)
+    commandRes, err := apiCallSimple(client, "show-dynamic-layer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -129,7 +129,7 @@ func readGaiaShowDynamicLayers(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-dynamic-layers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-dynamic-layers", payload)
+    commandRes, err := apiCallSimple(client, "show-dynamic-layers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
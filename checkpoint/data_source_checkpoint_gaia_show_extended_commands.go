@@ -60,7 +60,7 @@ func readGaiaShowExtendedCommands(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-extended-commands - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-extended-commands", payload)
+    commandRes, err := apiCallSimple(client, "show-extended-commands", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
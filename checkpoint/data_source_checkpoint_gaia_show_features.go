@@ -55,7 +55,7 @@ func readGaiaShowFeatures(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-features - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-features", payload)
+    commandRes, err := apiCallSimple(client, "show-features", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -213,7 +213,7 @@ func readGaiaShowGreInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-gre-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-gre-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-gre-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
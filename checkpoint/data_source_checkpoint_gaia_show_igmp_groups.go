@@ -130,7 +130,7 @@ func readGaiaShowIgmpGroups(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-igmp-groups - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-igmp-groups", payload)
+    commandRes, err := apiCallSimple(client, "show-igmp-groups", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -143,7 +143,7 @@ func readGaiaShowIgmpInterfaceStats(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-igmp-interface-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-igmp-interface-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-igmp-interface-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -152,7 +152,7 @@ func readGaiaShowIgmpInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-igmp-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-igmp-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-igmp-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -190,7 +190,7 @@ func readGaiaShowIgmpInterfacesStats(d *schema.ResourceData, m interface{}) erro
     }
 
     log.Println("Execute show-igmp-interfaces-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-igmp-interfaces-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-igmp-interfaces-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -212,7 +212,7 @@ func readGaiaShowIgmpStats(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-igmp-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-igmp-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-igmp-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
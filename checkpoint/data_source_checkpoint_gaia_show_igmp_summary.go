@@ -46,7 +46,7 @@ func readGaiaShowIgmpSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-igmp-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-igmp-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-igmp-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -99,7 +99,7 @@ func readGaiaShowInterface(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-interface - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-interface", payload)
+    commandRes, err := apiCallSimple(client, "show-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
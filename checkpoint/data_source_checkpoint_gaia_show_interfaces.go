@@ -126,7 +126,7 @@ func readGaiaShowInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
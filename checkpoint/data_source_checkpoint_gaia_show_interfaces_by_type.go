@@ -1764,7 +1764,7 @@ func readGaiaShowInterfacesByType(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-interfaces-by-type - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-interfaces-by-type", payload)
+    commandRes, err := apiCallSimple(client, "show-interfaces-by-type", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
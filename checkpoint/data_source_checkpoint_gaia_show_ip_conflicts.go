@@ -89,7 +89,7 @@ func readGaiaShowIpConflicts(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ip-conflicts - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ip-conflicts", payload)
+    commandRes, err := apiCallSimple(client, "show-ip-conflicts", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
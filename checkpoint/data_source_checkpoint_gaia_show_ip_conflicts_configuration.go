@@ -55,7 +55,7 @@ func readGaiaShowIpConflictsConfiguration(d *schema.ResourceData, m interface{})
     }
 
     log.Println("Execute show-ip-conflicts-configuration - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ip-conflicts-configuration", payload)
+    commandRes, err := apiCallSimple(client, "show-ip-conflicts-configuration", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -61,7 +61,7 @@ func readGaiaShowIpv6PimBootstrap(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-bootstrap - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-bootstrap", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-bootstrap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -46,7 +46,7 @@ func readGaiaShowIpv6PimCandidateRp(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-ipv6-pim-candidate-rp - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-candidate-rp", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-candidate-rp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
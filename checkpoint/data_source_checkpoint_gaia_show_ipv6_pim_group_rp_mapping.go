@@ -50,7 +50,7 @@ func readGaiaShowIpv6PimGroupRpMapping(d *schema.ResourceData, m interface{}) er
     }
 
     log.Println("Execute show-ipv6-pim-group-rp-mapping - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-group-rp-mapping", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-group-rp-mapping", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
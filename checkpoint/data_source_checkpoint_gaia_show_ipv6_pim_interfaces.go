@@ -127,7 +127,7 @@ func readGaiaShowIpv6PimInterfaces(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-ipv6-pim-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
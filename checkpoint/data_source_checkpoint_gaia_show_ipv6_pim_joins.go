@@ -209,7 +209,7 @@ func readGaiaShowIpv6PimJoins(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-joins - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-joins", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-joins", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
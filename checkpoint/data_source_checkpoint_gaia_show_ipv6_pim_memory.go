@@ -56,7 +56,7 @@ func readGaiaShowIpv6PimMemory(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-memory - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-memory", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-memory", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
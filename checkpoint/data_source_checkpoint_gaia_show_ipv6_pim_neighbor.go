@@ -70,7 +70,7 @@ func readGaiaShowIpv6PimNeighbor(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-neighbor - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-neighbor", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-neighbor", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -122,7 +122,7 @@ func readGaiaShowIpv6PimNeighbors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-neighbors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-neighbors", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-neighbors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
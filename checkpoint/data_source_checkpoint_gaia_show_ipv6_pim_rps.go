@@ -131,7 +131,7 @@ func readGaiaShowIpv6PimRps(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-rps - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-rps", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-rps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
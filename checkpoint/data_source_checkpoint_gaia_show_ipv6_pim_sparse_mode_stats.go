@@ -136,7 +136,7 @@ func readGaiaShowIpv6PimSparseModeStats(d *schema.ResourceData, m interface{}) e
     }
 
     log.Println("Execute show-ipv6-pim-sparse-mode-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-sparse-mode-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-sparse-mode-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -70,7 +70,7 @@ func readGaiaShowIpv6PimStats(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
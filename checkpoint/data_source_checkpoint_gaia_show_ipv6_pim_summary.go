@@ -51,7 +51,7 @@ func readGaiaShowIpv6PimSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
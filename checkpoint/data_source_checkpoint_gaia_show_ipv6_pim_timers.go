@@ -66,7 +66,7 @@ func readGaiaShowIpv6PimTimers(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ipv6-pim-timers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-timers", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-timers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -102,7 +102,7 @@ func readGaiaShowIpv6PimVirtualInterfaces(d *schema.ResourceData, m interface{})
     }
 
     log.Println("Execute show-ipv6-pim-virtual-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ipv6-pim-virtual-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-ipv6-pim-virtual-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
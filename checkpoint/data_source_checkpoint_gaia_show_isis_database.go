@@ -386,7 +386,7 @@ func readGaiaShowIsisDatabase(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-database - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-database", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-database", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
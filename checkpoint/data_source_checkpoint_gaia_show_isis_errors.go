@@ -523,7 +523,7 @@ func readGaiaShowIsisErrors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-errors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-errors", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-errors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -106,7 +106,7 @@ func readGaiaShowIsisHostnames(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-hostnames - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-hostnames", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-hostnames", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -294,7 +294,7 @@ func readGaiaShowIsisInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
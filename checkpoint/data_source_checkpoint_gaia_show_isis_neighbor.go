@@ -167,7 +167,7 @@ func readGaiaShowIsisNeighbor(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-neighbor - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-neighbor", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-neighbor", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -209,7 +209,7 @@ func readGaiaShowIsisNeighbors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-neighbors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-neighbors", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-neighbors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
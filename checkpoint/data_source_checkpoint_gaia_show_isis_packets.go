@@ -147,7 +147,7 @@ func readGaiaShowIsisPackets(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-packets - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-packets", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-packets", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -123,7 +123,7 @@ func readGaiaShowIsisSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
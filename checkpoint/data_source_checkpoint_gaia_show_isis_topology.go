@@ -139,7 +139,7 @@ func readGaiaShowIsisTopology(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-isis-topology - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-isis-topology", payload)
+    commandRes, err := apiCallSimple(client, "show-isis-topology", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -85,7 +85,7 @@ func readGaiaShowLicenses(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-licenses - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-licenses", payload)
+    commandRes, err := apiCallSimple(client, "show-licenses", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -65,7 +65,7 @@ func readGaiaShowLightshots(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-lightshots - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-lightshots", payload)
+    commandRes, err := apiCallSimple(client, "show-lightshots", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -193,7 +193,7 @@ func readGaiaShowLoopbackInterfaces(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-loopback-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-loopback-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-loopback-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
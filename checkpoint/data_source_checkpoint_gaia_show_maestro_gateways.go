@@ -118,7 +118,7 @@ func readGaiaShowMaestroGateways(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-maestro-gateways - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-maestro-gateways", payload)
+    commandRes, err := apiCallSimple(client, "show-maestro-gateways", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
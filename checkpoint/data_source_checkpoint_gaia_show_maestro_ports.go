@@ -109,7 +109,7 @@ func readGaiaShowMaestroPorts(d *schema.ResourceData, m interface{}) error {
     payload := map[string]interface{}{}
 
     log.Println("Execute show-maestro-ports - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-maestro-ports", payload)
+    commandRes, err := apiCallSimple(client, "show-maestro-ports", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
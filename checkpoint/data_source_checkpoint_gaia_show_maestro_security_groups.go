@@ -277,7 +277,7 @@ func readGaiaShowMaestroSecurityGroups(d *schema.ResourceData, m interface{}) er
     }
 
     log.Println("Execute show-maestro-security-groups - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-maestro-security-groups", payload)
+    commandRes, err := apiCallSimple(client, "show-maestro-security-groups", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
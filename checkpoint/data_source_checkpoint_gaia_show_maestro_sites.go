@@ -151,7 +151,7 @@ func readGaiaShowMaestroSites(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-maestro-sites - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-maestro-sites", payload)
+    commandRes, err := apiCallSimple(client, "show-maestro-sites", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -130,7 +130,7 @@ func readGaiaShowMldGroups(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-mld-groups - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-mld-groups", payload)
+    commandRes, err := apiCallSimple(client, "show-mld-groups", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
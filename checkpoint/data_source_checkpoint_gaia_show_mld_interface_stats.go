@@ -98,7 +98,7 @@ func readGaiaShowMldInterfaceStats(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-mld-interface-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-mld-interface-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-mld-interface-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
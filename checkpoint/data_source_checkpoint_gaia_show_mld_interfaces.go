@@ -167,7 +167,7 @@ func readGaiaShowMldInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-mld-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-mld-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-mld-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -150,7 +150,7 @@ func readGaiaShowMldInterfacesStats(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-mld-interfaces-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-mld-interfaces-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-mld-interfaces-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -147,7 +147,7 @@ func readGaiaShowMldStats(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-mld-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-mld-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-mld-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -46,7 +46,7 @@ func readGaiaShowMldSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-mld-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-mld-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-mld-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
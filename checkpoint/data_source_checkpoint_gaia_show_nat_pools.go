@@ -97,7 +97,7 @@ func readGaiaShowNatPools(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-nat-pools - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-nat-pools", payload)
+    commandRes, err := apiCallSimple(client, "show-nat-pools", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -65,7 +65,7 @@ func readGaiaShowNfsMountPoints(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-nfs-mount-points - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-nfs-mount-points", payload)
+    commandRes, err := apiCallSimple(client, "show-nfs-mount-points", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -126,7 +126,7 @@ func readGaiaShowOspfBorderRouters(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-ospf-border-routers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-border-routers", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-border-routers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
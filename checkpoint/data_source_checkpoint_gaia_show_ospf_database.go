@@ -164,7 +164,7 @@ func readGaiaShowOspfDatabase(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-database - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-database", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-database", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -499,7 +499,7 @@ func readGaiaShowOspfErrors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-errors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-errors", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-errors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -95,7 +95,7 @@ func readGaiaShowOspfEvents(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-events - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-events", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-events", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
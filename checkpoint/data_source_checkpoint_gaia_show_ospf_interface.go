@@ -89,7 +89,7 @@ func readGaiaShowOspfInterface(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-interface - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-interface", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
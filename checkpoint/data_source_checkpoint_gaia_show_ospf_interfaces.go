@@ -146,7 +146,7 @@ func readGaiaShowOspfInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
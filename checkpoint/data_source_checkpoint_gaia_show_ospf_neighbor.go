@@ -94,7 +94,7 @@ func readGaiaShowOspfNeighbor(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-neighbor - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-neighbor", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-neighbor", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
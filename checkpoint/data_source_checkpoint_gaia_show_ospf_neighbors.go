@@ -141,7 +141,7 @@ func readGaiaShowOspfNeighbors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-neighbors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-neighbors", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-neighbors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
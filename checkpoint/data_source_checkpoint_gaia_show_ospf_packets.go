@@ -95,7 +95,7 @@ func readGaiaShowOspfPackets(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-packets - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-packets", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-packets", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -74,7 +74,7 @@ func readGaiaShowOspfRoutemap(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-routemap - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-routemap", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-routemap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -227,7 +227,7 @@ func readGaiaShowOspfSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-ospf-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-ospf-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-ospf-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -192,7 +192,7 @@ func readGaiaShowPbrRules(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pbr-rules - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pbr-rules", payload)
+    commandRes, err := apiCallSimple(client, "show-pbr-rules", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -95,7 +95,7 @@ func readGaiaShowPbrTables(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pbr-tables - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pbr-tables", payload)
+    commandRes, err := apiCallSimple(client, "show-pbr-tables", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
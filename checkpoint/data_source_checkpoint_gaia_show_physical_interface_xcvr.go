@@ -155,7 +155,7 @@ func readGaiaShowPhysicalInterfaceXcvr(d *schema.ResourceData, m interface{}) er
     }
 
     log.Println("Execute show-physical-interface-xcvr - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-physical-interface-xcvr", payload)
+    commandRes, err := apiCallSimple(client, "show-physical-interface-xcvr", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
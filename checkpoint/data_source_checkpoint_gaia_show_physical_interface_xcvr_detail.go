@@ -570,7 +570,7 @@ func readGaiaShowPhysicalInterfaceXcvrDetail(d *schema.ResourceData, m interface
     }
 
     log.Println("Execute show-physical-interface-xcvr-detail - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-physical-interface-xcvr-detail", payload)
+    commandRes, err := apiCallSimple(client, "show-physical-interface-xcvr-detail", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
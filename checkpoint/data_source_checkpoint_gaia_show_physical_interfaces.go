@@ -378,7 +378,7 @@ func readGaiaShowPhysicalInterfaces(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-physical-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-physical-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-physical-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
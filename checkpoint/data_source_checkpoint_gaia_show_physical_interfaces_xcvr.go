@@ -159,7 +159,7 @@ func readGaiaShowPhysicalInterfacesXcvr(d *schema.ResourceData, m interface{}) e
     }
 
     log.Println("Execute show-physical-interfaces-xcvr - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-physical-interfaces-xcvr", payload)
+    commandRes, err := apiCallSimple(client, "show-physical-interfaces-xcvr", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
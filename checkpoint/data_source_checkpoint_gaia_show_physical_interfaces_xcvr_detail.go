@@ -159,7 +159,7 @@ func readGaiaShowPhysicalInterfacesXcvrDetail(d *schema.ResourceData, m interfac
     }
 
     log.Println("Execute show-physical-interfaces-xcvr-detail - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-physical-interfaces-xcvr-detail", payload)
+    commandRes, err := apiCallSimple(client, "show-physical-interfaces-xcvr-detail", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
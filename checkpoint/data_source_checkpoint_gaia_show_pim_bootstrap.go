@@ -61,7 +61,7 @@ func readGaiaShowPimBootstrap(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-bootstrap - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-bootstrap", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-bootstrap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -46,7 +46,7 @@ func readGaiaShowPimCandidateRp(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-candidate-rp - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-candidate-rp", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-candidate-rp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -50,7 +50,7 @@ func readGaiaShowPimGroupRpMapping(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-pim-group-rp-mapping - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-group-rp-mapping", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-group-rp-mapping", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
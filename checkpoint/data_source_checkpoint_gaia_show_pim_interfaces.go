@@ -127,7 +127,7 @@ func readGaiaShowPimInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
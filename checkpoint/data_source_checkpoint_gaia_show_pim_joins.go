@@ -209,7 +209,7 @@ func readGaiaShowPimJoins(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-joins - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-joins", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-joins", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -56,7 +56,7 @@ func readGaiaShowPimMemory(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-memory - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-memory", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-memory", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -70,7 +70,7 @@ func readGaiaShowPimNeighbor(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-neighbor - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-neighbor", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-neighbor", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -122,7 +122,7 @@ func readGaiaShowPimNeighbors(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-neighbors - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-neighbors", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-neighbors", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
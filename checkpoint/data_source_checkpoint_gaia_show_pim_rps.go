@@ -131,7 +131,7 @@ func readGaiaShowPimRps(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-rps - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-rps", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-rps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
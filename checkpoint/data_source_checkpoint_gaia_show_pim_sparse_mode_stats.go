@@ -136,7 +136,7 @@ func readGaiaShowPimSparseModeStats(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-pim-sparse-mode-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-sparse-mode-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-sparse-mode-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
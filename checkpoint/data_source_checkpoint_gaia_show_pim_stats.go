@@ -70,7 +70,7 @@ func readGaiaShowPimStats(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-stats - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-stats", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-stats", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
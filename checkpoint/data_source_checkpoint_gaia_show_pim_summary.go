@@ -51,7 +51,7 @@ func readGaiaShowPimSummary(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-summary - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-summary", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-summary", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
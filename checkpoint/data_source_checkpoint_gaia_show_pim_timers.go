@@ -66,7 +66,7 @@ func readGaiaShowPimTimers(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pim-timers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-timers", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-timers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
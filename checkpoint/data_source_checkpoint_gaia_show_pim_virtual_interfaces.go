@@ -102,7 +102,7 @@ func readGaiaShowPimVirtualInterfaces(d *schema.ResourceData, m interface{}) err
     }
 
     log.Println("Execute show-pim-virtual-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pim-virtual-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-pim-virtual-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -197,7 +197,7 @@ func readGaiaShowPppoeInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-pppoe-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-pppoe-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-pppoe-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -97,7 +97,7 @@ func readGaiaShowRemoteSyslogs(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-remote-syslogs - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-remote-syslogs", payload)
+    commandRes, err := apiCallSimple(client, "show-remote-syslogs", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
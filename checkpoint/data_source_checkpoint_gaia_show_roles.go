@@ -85,7 +85,7 @@ func readGaiaShowRoles(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-roles - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-roles", payload)
+    commandRes, err := apiCallSimple(client, "show-roles", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
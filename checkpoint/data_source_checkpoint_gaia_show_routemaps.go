@@ -359,7 +359,7 @@ func readGaiaShowRoutemaps(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routemaps - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routemaps", payload)
+    commandRes, err := apiCallSimple(client, "show-routemaps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
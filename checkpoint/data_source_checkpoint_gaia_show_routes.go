@@ -178,7 +178,7 @@ func readGaiaShowRoutes(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes", payload)
+    commandRes, err := apiCallSimple(client, "show-routes", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -159,7 +159,7 @@ func readGaiaShowRoutesAggregate(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-aggregate - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-aggregate", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-aggregate", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
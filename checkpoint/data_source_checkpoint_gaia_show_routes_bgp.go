@@ -159,7 +159,7 @@ func readGaiaShowRoutesBgp(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-bgp - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-bgp", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-bgp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
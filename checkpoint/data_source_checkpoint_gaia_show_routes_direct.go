@@ -154,7 +154,7 @@ func readGaiaShowRoutesDirect(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-direct - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-direct", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-direct", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
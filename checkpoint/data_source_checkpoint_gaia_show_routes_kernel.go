@@ -168,7 +168,7 @@ func readGaiaShowRoutesKernel(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-kernel - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-kernel", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-kernel", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
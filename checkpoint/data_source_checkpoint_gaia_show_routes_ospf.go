@@ -178,7 +178,7 @@ func readGaiaShowRoutesOspf(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-ospf - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-ospf", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-ospf", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -173,7 +173,7 @@ func readGaiaShowRoutesRip(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-rip - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-rip", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
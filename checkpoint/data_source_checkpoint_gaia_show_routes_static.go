@@ -163,7 +163,7 @@ func readGaiaShowRoutesStatic(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-routes-static - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-routes-static", payload)
+    commandRes, err := apiCallSimple(client, "show-routes-static", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -41,7 +41,7 @@ func readGaiaShowSerialNumber(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-serial-number - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-serial-number", payload)
+    commandRes, err := apiCallSimple(client, "show-serial-number", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
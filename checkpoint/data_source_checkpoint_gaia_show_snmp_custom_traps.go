@@ -75,7 +75,7 @@ func readGaiaShowSnmpCustomTraps(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-snmp-custom-traps - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-snmp-custom-traps", payload)
+    commandRes, err := apiCallSimple(client, "show-snmp-custom-traps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
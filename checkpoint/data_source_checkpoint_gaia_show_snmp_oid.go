@@ -64,7 +64,7 @@ func readGaiaShowSnmpOid(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-snmp-oid - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-snmp-oid", payload)
+    commandRes, err := apiCallSimple(client, "show-snmp-oid", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -65,7 +65,7 @@ func readGaiaShowSnmpTrapReceivers(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-snmp-trap-receivers - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-snmp-trap-receivers", payload)
+    commandRes, err := apiCallSimple(client, "show-snmp-trap-receivers", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -98,7 +98,7 @@ func readGaiaShowSnmpUsers(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-snmp-users - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-snmp-users", payload)
+    commandRes, err := apiCallSimple(client, "show-snmp-users", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -135,7 +135,7 @@ func readGaiaShowStaticMroutes(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-static-mroutes - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-static-mroutes", payload)
+    commandRes, err := apiCallSimple(client, "show-static-mroutes", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
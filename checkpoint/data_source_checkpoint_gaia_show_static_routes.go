@@ -160,7 +160,7 @@ func readGaiaShowStaticRoutes(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-static-routes - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-static-routes", payload)
+    commandRes, err := apiCallSimple(client, "show-static-routes", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
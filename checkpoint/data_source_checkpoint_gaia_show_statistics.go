@@ -252,7 +252,7 @@ func readGaiaShowStatistics(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-statistics - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-statistics", payload)
+    commandRes, err := apiCallSimple(client, "show-statistics", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -127,7 +127,7 @@ func readGaiaShowStatisticsInfo(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-statistics-info - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-statistics-info", payload)
+    commandRes, err := apiCallSimple(client, "show-statistics-info", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
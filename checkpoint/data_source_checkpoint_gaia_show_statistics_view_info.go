@@ -93,7 +93,7 @@ func readGaiaShowStatisticsViewInfo(d *schema.ResourceData, m interface{}) error
     }
 
     log.Println("Execute show-statistics-view-info - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-statistics-view-info", payload)
+    commandRes, err := apiCallSimple(client, "show-statistics-view-info", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
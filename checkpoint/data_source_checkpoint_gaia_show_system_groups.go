@@ -68,7 +68,7 @@ func readGaiaShowSystemGroups(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-system-groups - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-system-groups", payload)
+    commandRes, err := apiCallSimple(client, "show-system-groups", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
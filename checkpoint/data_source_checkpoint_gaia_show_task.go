@@ -134,7 +134,7 @@ func readGaiaShowTask(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-task - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-task", payload)
+    commandRes, err := apiCallSimple(client, "show-task", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -44,7 +44,7 @@ func readGaiaShowTimezones(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-timezones - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-timezones", payload)
+    commandRes, err := apiCallSimple(client, "show-timezones", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -130,7 +130,7 @@ func readGaiaShowUsers(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-users - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-users", payload)
+    commandRes, err := apiCallSimple(client, "show-users", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -56,7 +56,7 @@ func readGaiaShowVersion(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-version - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-version", payload)
+    commandRes, err := apiCallSimple(client, "show-version", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
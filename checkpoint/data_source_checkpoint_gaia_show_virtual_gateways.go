@@ -138,7 +138,7 @@ func readGaiaShowVirtualGateways(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-virtual-gateways - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-virtual-gateways", payload)
+    commandRes, err := apiCallSimple(client, "show-virtual-gateways", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -110,7 +110,7 @@ func readGaiaShowVirtualSwitches(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-virtual-switches - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-virtual-switches", payload)
+    commandRes, err := apiCallSimple(client, "show-virtual-switches", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -138,7 +138,7 @@ func readGaiaShowVirtualSystems(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-virtual-systems - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-virtual-systems", payload)
+    commandRes, err := apiCallSimple(client, "show-virtual-systems", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
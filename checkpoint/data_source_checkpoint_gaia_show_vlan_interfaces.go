@@ -357,7 +357,7 @@ func readGaiaShowVlanInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-vlan-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-vlan-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-vlan-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -46,7 +46,7 @@ func readGaiaShowVsnextState(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-vsnext-state - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-vsnext-state", payload)
+    commandRes, err := apiCallSimple(client, "show-vsnext-state", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -208,7 +208,7 @@ func readGaiaShowVxlanInterfaces(d *schema.ResourceData, m interface{}) error {
     }
 
     log.Println("Execute show-vxlan-interfaces - Payload = ", payload)
-    commandRes, err := client.ApiCallSimple("show-vxlan-interfaces", payload)
+    commandRes, err := apiCallSimple(client, "show-vxlan-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && commandRes.Success
@@ -101,7 +101,7 @@ func dataSourceManagementAccessLayerRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showAccessLayerRes, err := client.ApiCall("show-access-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAccessLayerRes, err := apiCall(client, "show-access-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
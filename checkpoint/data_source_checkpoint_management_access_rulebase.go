@@ -757,7 +757,7 @@ func dataSourceManagementAccessRuleBaseRead(d *schema.ResourceData, m interface{
 	if v, ok := d.GetOk("show_membership"); ok {
 		payload["show-membership"] = v.(bool)
 	}
-	showRuleBaseRes, err := client.ApiCall("show-access-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRuleBaseRes, err := apiCall(client, "show-access-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
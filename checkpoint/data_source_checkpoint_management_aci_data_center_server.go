@@ -84,7 +84,7 @@ func dataSourceAciDataCenterServerRead(d *schema.ResourceData, m interface{}) er
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showAciDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAciDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -125,7 +125,7 @@ func dataSourceManagementAddressRangeRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showAddressRangeRes, err := client.ApiCall("show-address-range", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAddressRangeRes, err := apiCall(client, "show-address-range", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -132,7 +132,7 @@ func dataSourceManagementAdministratorRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showAdministratorRes, err := client.ApiCall("show-administrator", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAdministratorRes, err := apiCall(client, "show-administrator", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -89,7 +89,7 @@ func dataSourceManagementAntiMalwareUpdateScheduleRead(d *schema.ResourceData, m
 
 	var payload = map[string]interface{}{}
 
-	antiMalwareUpdateScheduleRes, err := client.ApiCallSimple("show-anti-malware-update-schedule", payload)
+	antiMalwareUpdateScheduleRes, err := apiCallSimple(client, "show-anti-malware-update-schedule", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
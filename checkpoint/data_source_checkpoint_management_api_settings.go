@@ -64,7 +64,7 @@ func dataSourceManagementApiSettingsRead(d *schema.ResourceData, m interface{})
 
 	payload := make(map[string]interface{})
 
-	showApiSettingsRes, err := client.ApiCall("show-api-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showApiSettingsRes, err := apiCall(client, "show-api-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -117,7 +117,7 @@ func dataSourceManagementAppControlAdvancedSettingsRead(d *schema.ResourceData,
 
 	var payload = map[string]interface{}{}
 
-	appControlAdvancedSettingsRes, _ := client.ApiCall("show-app-control-advanced-settings", payload, client.GetSessionID(), true, false)
+	appControlAdvancedSettingsRes, _ := apiCall(client, "show-app-control-advanced-settings", payload, client.GetSessionID(), true, false)
 	if !appControlAdvancedSettingsRes.Success {
 		return fmt.Errorf("%s", appControlAdvancedSettingsRes.ErrorMsg)
 	}
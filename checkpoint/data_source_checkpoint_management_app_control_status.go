@@ -68,7 +68,7 @@ func dataSourceManagementAppControlStatusRead(d *schema.ResourceData, m interfac
 
 	payload := make(map[string]interface{})
 
-	showAppControlStatusRes, err := client.ApiCallSimple("show-app-control-status", payload)
+	showAppControlStatusRes, err := apiCallSimple(client, "show-app-control-status", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
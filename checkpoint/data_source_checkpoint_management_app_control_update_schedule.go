@@ -156,7 +156,7 @@ func dataSourceManagementAppControlUpdateScheduleRead(d *schema.ResourceData, m
 
 	var payload = map[string]interface{}{}
 
-	appControlUpdateScheduleRes, err := client.ApiCallSimple("show-app-control-update-schedule", payload)
+	appControlUpdateScheduleRes, err := apiCallSimple(client, "show-app-control-update-schedule", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
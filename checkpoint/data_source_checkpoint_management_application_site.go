@@ -103,7 +103,7 @@ func dataSourceManagementApplicationSiteRead(d *schema.ResourceData, m interface
 		payload["uid"] = uid
 	}
 
-	showApplicationSiteRes, err := client.ApiCall("show-application-site", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showApplicationSiteRes, err := apiCall(client, "show-application-site", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -71,7 +71,7 @@ func dataSourceManagementApplicationSiteCategoryRead(d *schema.ResourceData, m i
 		payload["uid"] = uid
 	}
 
-	showApplicationSiteCategoryRes, err := client.ApiCall("show-application-site-category", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showApplicationSiteCategoryRes, err := apiCall(client, "show-application-site-category", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
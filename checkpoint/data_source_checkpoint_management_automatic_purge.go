@@ -80,7 +80,7 @@ func dataSourceManagementAutomaticPurgeRead(d *schema.ResourceData, m interface{
 
 	payload := make(map[string]interface{})
 
-	showAutomaticPurgeRes, err := client.ApiCall("show-automatic-purge", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAutomaticPurgeRes, err := apiCall(client, "show-automatic-purge", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
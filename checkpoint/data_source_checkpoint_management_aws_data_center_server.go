@@ -91,7 +91,7 @@ func dataSourceAwsDataCenterServerRead(d *schema.ResourceData, m interface{}) er
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showAwsDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAwsDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -74,7 +74,7 @@ func dataSourceManagementAzureAdRead(d *schema.ResourceData, m interface{}) erro
 		payload["uid"] = uid
 	}
 
-	showAzureAdRes, err := client.ApiCall("show-azure-ad", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureAdRes, err := apiCall(client, "show-azure-ad", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -222,7 +222,7 @@ func dataSourceAzureAdContentRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	showAzureAdContentRes, err := client.ApiCall("show-azure-ad-content", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureAdContentRes, err := apiCall(client, "show-azure-ad-content", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -86,7 +86,7 @@ func dataSourceAzureDataCenterServerRead(d *schema.ResourceData, m interface{})
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showAzureDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -698,7 +698,7 @@ func dataSourceManagementBestPracticeRead(d *schema.ResourceData, m interface{})
 		payload["show-regulations"] = v.(bool)
 	}
 
-	showBestPracticeRes, err := client.ApiCall("show-best-practice", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showBestPracticeRes, err := apiCall(client, "show-best-practice", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
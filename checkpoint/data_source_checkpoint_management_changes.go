@@ -0,0 +1,384 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Fields of changed objects which aren't part of the change, e.g. they change on every publish.
+var changeIgnoredFields = map[string]bool{
+	"meta-info":         true,
+	"read-only":         true,
+	"available-actions": true,
+	"icon":              true,
+	"domain":            true,
+}
+
+func changedObjectSchema(before bool, after bool, fields bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"uid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Object unique identifier.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Object name.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Object type, e.g. host or access-rule.",
+		},
+	}
+	if before {
+		s["before"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Object before the change in JSON format.",
+		}
+	}
+	if after {
+		s["after"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Object after the change in JSON format.",
+		}
+	}
+	if fields {
+		s["fields"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Changed fields of the object.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"field": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Field path in the format of the resource attributes, e.g. nat_settings.auto_rule or members.",
+					},
+					"before": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Field value before the change. Empty when the field was added.",
+					},
+					"after": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Field value after the change. Empty when the field was removed.",
+					},
+				},
+			},
+		}
+	}
+	return s
+}
+
+func dataSourceManagementChanges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementChangesRead,
+		Schema: map[string]*schema.Schema{
+			"from_revision": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Return changes published since this revision (published session UID).",
+				ConflictsWith: []string{"session_uid"},
+			},
+			"to_revision": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Return changes published up to this revision (published session UID).",
+				ConflictsWith: []string{"session_uid"},
+			},
+			"from_date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return changes published since this date, in ISO 8601 format, e.g. 2026-01-01T08:20:50.",
+			},
+			"to_date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return changes published up to this date, in ISO 8601 format.",
+			},
+			"session_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return the changes of this published session.",
+			},
+			"added": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Added objects and rules.",
+				Elem:        &schema.Resource{Schema: changedObjectSchema(false, true, false)},
+			},
+			"modified": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Modified objects and rules.",
+				Elem:        &schema.Resource{Schema: changedObjectSchema(true, true, true)},
+			},
+			"deleted": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Deleted objects and rules.",
+				Elem:        &schema.Resource{Schema: changedObjectSchema(true, false, false)},
+			},
+		},
+	}
+}
+
+func dataSourceManagementChangesRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{}
+	if v, ok := d.GetOk("from_revision"); ok {
+		payload["from-session"] = v.(string)
+	}
+	if v, ok := d.GetOk("to_revision"); ok {
+		payload["to-session"] = v.(string)
+	}
+	if v, ok := d.GetOk("session_uid"); ok {
+		payload["from-session"] = v.(string)
+		payload["to-session"] = v.(string)
+	}
+	if v, ok := d.GetOk("from_date"); ok {
+		payload["from-date"] = v.(string)
+	}
+	if v, ok := d.GetOk("to_date"); ok {
+		payload["to-date"] = v.(string)
+	}
+
+	details, err := showChanges(client, payload)
+	if err != nil {
+		return err
+	}
+
+	changes := parseChanges(details)
+	_ = d.Set("added", changes.added)
+	_ = d.Set("modified", changes.modified)
+	_ = d.Set("deleted", changes.deleted)
+
+	d.SetId("show-changes-" + acctest.RandString(10))
+	return nil
+}
+
+// changeSet is the change set of show-changes in the format of the data source schema.
+type changeSet struct {
+	added    []interface{}
+	modified []interface{}
+	deleted  []interface{}
+}
+
+// showChanges returns the task details of show-changes, or its response when it doesn't run a task.
+func showChanges(client *checkpoint.ApiClient, payload map[string]interface{}) (interface{}, error) {
+	showChangesRes, err := apiCall(client, "show-changes", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return nil, fmt.Errorf("%s", err.Error())
+	}
+	if !showChangesRes.Success {
+		return nil, fmt.Errorf("%s", showChangesRes.ErrorMsg)
+	}
+
+	if taskId, ok := resolveTaskId(showChangesRes.GetData()).(string); ok && taskId != "" {
+		taskData, err := showTaskFull(client, taskId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read show-changes task %s: %s", taskId, err)
+		}
+		return taskData, nil
+	}
+	return showChangesRes.GetData(), nil
+}
+
+// parseChanges collects the added, modified and deleted objects of the show-changes operations.
+func parseChanges(data interface{}) changeSet {
+	changes := changeSet{
+		added:    make([]interface{}, 0),
+		modified: make([]interface{}, 0),
+		deleted:  make([]interface{}, 0),
+	}
+	walkChanges(data, func(kind string, before map[string]interface{}, after map[string]interface{}) {
+		switch kind {
+		case "added":
+			changes.added = append(changes.added, changedObject(after, nil, after))
+		case "deleted":
+			changes.deleted = append(changes.deleted, changedObject(before, before, nil))
+		default:
+			current := after
+			if current == nil {
+				current = before
+			}
+			changes.modified = append(changes.modified, changedObject(current, before, after))
+		}
+	})
+	return changes
+}
+
+// walkChanges calls f with the kind (added, modified or deleted) and the object before and after each
+// change of the show-changes operations, wherever they are in the task details.
+func walkChanges(data interface{}, f func(kind string, before map[string]interface{}, after map[string]interface{})) {
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch value := v.(type) {
+		case []interface{}:
+			for _, child := range value {
+				walk(child)
+			}
+		case map[string]interface{}:
+			found := false
+			for _, object := range changeList(value["added-objects"]) {
+				found = true
+				f("added", nil, object)
+			}
+			for _, object := range changeList(value["deleted-objects"]) {
+				found = true
+				f("deleted", object, nil)
+			}
+			for _, object := range changeList(value["modified-objects"]) {
+				found = true
+				before, _ := object["old-object"].(map[string]interface{})
+				after, _ := object["new-object"].(map[string]interface{})
+				f("modified", before, after)
+			}
+			if found {
+				return
+			}
+			for _, child := range value {
+				walk(child)
+			}
+		}
+	}
+	walk(data)
+}
+
+func changedObject(object map[string]interface{}, before map[string]interface{}, after map[string]interface{}) map[string]interface{} {
+	changed := map[string]interface{}{
+		"uid":  getString(object, "uid"),
+		"name": getString(object, "name"),
+		"type": getString(object, "type"),
+	}
+	if before != nil {
+		changed["before"] = changeJSON(before)
+	}
+	if after != nil {
+		changed["after"] = changeJSON(after)
+	}
+	if before != nil && after != nil {
+		changed["fields"] = changedFields(before, after)
+	}
+	return changed
+}
+
+func changeJSON(object map[string]interface{}) string {
+	trimmed := make(map[string]interface{}, len(object))
+	for k, v := range object {
+		if !changeIgnoredFields[k] {
+			trimmed[k] = v
+		}
+	}
+	b, _ := json.Marshal(trimmed)
+	return string(b)
+}
+
+// changedFields returns the fields whose values differ between the objects, sorted by field.
+func changedFields(before map[string]interface{}, after map[string]interface{}) []interface{} {
+	beforeFields, afterFields := map[string]string{}, map[string]string{}
+	flattenChangeFields("", before, beforeFields)
+	flattenChangeFields("", after, afterFields)
+
+	keys := make([]string, 0)
+	for k, v := range beforeFields {
+		if afterValue, ok := afterFields[k]; !ok || afterValue != v {
+			keys = append(keys, k)
+		}
+	}
+	for k := range afterFields {
+		if _, ok := beforeFields[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	fields := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		fields = append(fields, map[string]interface{}{
+			"field":  k,
+			"before": beforeFields[k],
+			"after":  afterFields[k],
+		})
+	}
+	return fields
+}
+
+// flattenChangeFields flattens the object into fields paths in the format of the resource attributes.
+// Referenced objects, e.g. group members, are represented by their names, and lists of names or
+// values by the sorted list in JSON format, so reordering isn't reported as a change.
+func flattenChangeFields(prefix string, v interface{}, fields map[string]string) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if prefix != "" && value["uid"] != nil && value["name"] != nil {
+			fields[prefix] = toString(value["name"])
+			return
+		}
+		for k, child := range value {
+			if prefix == "" && (changeIgnoredFields[k] || k == "uid") {
+				continue
+			}
+			key := strings.ReplaceAll(k, "-", "_")
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			flattenChangeFields(key, child, fields)
+		}
+	case []interface{}:
+		if names, ok := changeNames(value); ok {
+			b, _ := json.Marshal(names)
+			fields[prefix] = string(b)
+			return
+		}
+		for i, child := range value {
+			flattenChangeFields(prefix+"."+strconv.Itoa(i), child, fields)
+		}
+	case nil:
+	default:
+		fields[prefix] = toString(value)
+	}
+}
+
+// changeNames returns the sorted values of a list of values or referenced objects.
+func changeNames(list []interface{}) ([]string, bool) {
+	names := make([]string, 0, len(list))
+	for _, item := range list {
+		switch value := item.(type) {
+		case map[string]interface{}:
+			if value["uid"] == nil || value["name"] == nil {
+				return nil, false
+			}
+			names = append(names, toString(value["name"]))
+		case []interface{}:
+			return nil, false
+		default:
+			names = append(names, toString(value))
+		}
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+func changeList(v interface{}) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0)
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			items = append(items, itemMap)
+		}
+	}
+	return items
+}
@@ -0,0 +1,79 @@
+package checkpoint
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDataSourceManagementChanges_offline(t *testing.T) {
+	server := newFakeServer()
+	defer server.Close()
+	server.results["show-changes"] = []interface{}{map[string]interface{}{
+		"task-details": []interface{}{map[string]interface{}{
+			"changes": []interface{}{map[string]interface{}{
+				"operations": map[string]interface{}{
+					"added-objects": []interface{}{map[string]interface{}{
+						"uid": "h1", "name": "web", "type": "host", "ipv4-address": "10.0.0.1",
+					}},
+					"modified-objects": []interface{}{map[string]interface{}{
+						"old-object": map[string]interface{}{
+							"uid": "g1", "name": "servers", "type": "group",
+							"members":   []interface{}{map[string]interface{}{"uid": "h2", "name": "db"}},
+							"meta-info": map[string]interface{}{"last-modifier": "admin"},
+						},
+						"new-object": map[string]interface{}{
+							"uid": "g1", "name": "servers", "type": "group",
+							"members": []interface{}{
+								map[string]interface{}{"uid": "h2", "name": "db"},
+								map[string]interface{}{"uid": "h1", "name": "web"},
+							},
+							"comments":  "web servers",
+							"meta-info": map[string]interface{}{"last-modifier": "pipeline"},
+						},
+					}},
+					"deleted-objects": []interface{}{map[string]interface{}{
+						"uid": "r1", "name": "old rule", "type": "access-rule",
+					}},
+				},
+			}},
+		}},
+	}}
+
+	raw := server.providerConfig("web_api", filepath.Join(t.TempDir(), DefaultSessionFilename))
+	provider := Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatal(diags)
+	}
+	ds := provider.DataSourcesMap["checkpoint_management_changes"]
+
+	d := ds.TestResourceData()
+	_ = d.Set("session_uid", "s1")
+	if err := ds.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := d.Get("added.0.name"); v != "web" || d.Get("added.#") != 1 {
+		t.Errorf("expected the added host, got %v", d.Get("added"))
+	}
+	if v := d.Get("deleted.0.type"); v != "access-rule" || d.Get("deleted.#") != 1 {
+		t.Errorf("expected the deleted rule, got %v", d.Get("deleted"))
+	}
+	fields := d.Get("modified.0.fields").([]interface{})
+	expected := []map[string]interface{}{
+		{"field": "comments", "before": "", "after": "web servers"},
+		{"field": "members", "before": `["db"]`, "after": `["db","web"]`},
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("expected the changed fields %v, got %v", expected, fields)
+	}
+	for i, field := range fields {
+		for k, v := range expected[i] {
+			if field.(map[string]interface{})[k] != v {
+				t.Errorf("expected field %d %s to be %q, got %v", i, k, v, field)
+			}
+		}
+	}
+}
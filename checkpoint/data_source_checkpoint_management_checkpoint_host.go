@@ -408,7 +408,7 @@ func dataSourceManagementCheckpointHostRead(d *schema.ResourceData, m interface{
 		payload["uid"] = uid
 	}
 
-	showCheckpointHostRes, err := client.ApiCall("show-checkpoint-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showCheckpointHostRes, err := apiCall(client, "show-checkpoint-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -80,7 +80,7 @@ func dataSourceManagementCloudServices() *schema.Resource {
 func dataSourceManagementCloudServicesRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 
-	showCloudServices, err := client.ApiCall("show-cloud-services", make(map[string]interface{}), client.GetSessionID(), true, client.IsProxyUsed())
+	showCloudServices, err := apiCall(client, "show-cloud-services", make(map[string]interface{}), client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
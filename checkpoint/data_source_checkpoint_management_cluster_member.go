@@ -169,7 +169,7 @@ func dataSourceManagementClusterMemberRead(d *schema.ResourceData, m interface{}
 		payload["limit-interfaces"] = v
 	}
 
-	showClusterMemberRes, err := client.ApiCall("show-cluster-member", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showClusterMemberRes, err := apiCall(client, "show-cluster-member", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -59,7 +59,7 @@ func dataSourceManagementCMEAccountsRead(d *schema.ResourceData, m interface{})
 	log.Println("Read cme accounts")
 
 	url := CmeApiPath + "/accounts"
-	AccountsRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AccountsRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
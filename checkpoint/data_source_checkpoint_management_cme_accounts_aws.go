@@ -161,7 +161,7 @@ func dataSourceManagementCMEAccountsAWSRead(d *schema.ResourceData, m interface{
 
 	url := CmeApiPath + "/accounts/" + name
 
-	AWSAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AWSAccountRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
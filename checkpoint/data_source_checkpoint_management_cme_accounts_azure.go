@@ -81,7 +81,7 @@ func dataSourceManagementCMEAccountsAzureRead(d *schema.ResourceData, m interfac
 	log.Println("Read cme Azure account - name = ", name)
 	url := CmeApiPath + "/accounts/" + name
 
-	AzureAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AzureAccountRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -71,7 +71,7 @@ func dataSourceManagementCMEAccountsGCPRead(d *schema.ResourceData, m interface{
 	log.Println("Read cme GCP account - name = ", name)
 	url := CmeApiPath + "/accounts/" + name
 
-	GCPAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	GCPAccountRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
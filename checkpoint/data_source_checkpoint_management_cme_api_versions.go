@@ -35,7 +35,7 @@ func dataSourceManagementCMEAPIVersionsRead(d *schema.ResourceData, m interface{
 	log.Println("Read cme api versions")
 	url := CmeApiPath + "/api-versions"
 
-	cmeVersionRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeVersionRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
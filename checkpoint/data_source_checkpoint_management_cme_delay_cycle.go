@@ -27,7 +27,7 @@ func dataSourceManagementCMEDelayCycleRead(d *schema.ResourceData, m interface{}
 	log.Println("Read cme delay cycle")
 	url := CmeApiPath + "/generalConfiguration/delayCycle"
 
-	cmeDelayCycleRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeDelayCycleRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
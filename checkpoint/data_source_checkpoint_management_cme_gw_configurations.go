@@ -221,7 +221,7 @@ func dataSourceManagementCMEGWConfigurationsRead(d *schema.ResourceData, m inter
 
 	url := CmeApiPath + "/gwConfigurations"
 
-	cmeGWConfigurationsRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeGWConfigurationsRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -222,7 +222,7 @@ func dataSourceManagementCMEGWConfigurationsAzureRead(d *schema.ResourceData, m
 
 	url := CmeApiPath + "/gwConfigurations/" + name
 
-	AzureGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AzureGWConfigurationRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
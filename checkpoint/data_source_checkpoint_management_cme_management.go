@@ -37,7 +37,7 @@ func dataSourceManagementCMEManagementRead(d *schema.ResourceData, m interface{}
 	log.Println("Read cme management")
 	url := CmeApiPath + "/management"
 
-	cmeManagementRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeManagementRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
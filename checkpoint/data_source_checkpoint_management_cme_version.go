@@ -27,7 +27,7 @@ func dataSourceManagementCMEVersionRead(d *schema.ResourceData, m interface{}) e
 	log.Println("Read cme version")
 	url := CmeApiPath + "/generalConfiguration/cmeVersion"
 
-	cmeVersionRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeVersionRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -49,7 +49,7 @@ func dataSourceManagementSetContentAwarenessAdvancedSettingsRead(d *schema.Resou
 
 	var payload = map[string]interface{}{}
 
-	contentAwarenessAdvancedSettingsRes, _ := client.ApiCall("show-content-awareness-advanced-settings", payload, client.GetSessionID(), true, false)
+	contentAwarenessAdvancedSettingsRes, _ := apiCall(client, "show-content-awareness-advanced-settings", payload, client.GetSessionID(), true, false)
 	if !contentAwarenessAdvancedSettingsRes.Success {
 		return fmt.Errorf("%s", contentAwarenessAdvancedSettingsRes.ErrorMsg)
 	}
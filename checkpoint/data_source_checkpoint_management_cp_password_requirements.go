@@ -30,7 +30,7 @@ func dataSourceManagementCpPasswordRequirementsRead(d *schema.ResourceData, m in
 
 	var payload = map[string]interface{}{}
 
-	cpPasswordRequirementsRes, err := client.ApiCallSimple("show-cp-password-requirements", payload)
+	cpPasswordRequirementsRes, err := apiCallSimple(client, "show-cp-password-requirements", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
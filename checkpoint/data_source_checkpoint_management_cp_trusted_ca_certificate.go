@@ -119,7 +119,7 @@ func dataSourceManagementCpTrustedCaCertificateRead(d *schema.ResourceData, m in
 		payload["uid"] = uid
 	}
 
-	cpTrustedCaCertificateObjRes, err := client.ApiCall("show-cp-trusted-ca-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cpTrustedCaCertificateObjRes, err := apiCall(client, "show-cp-trusted-ca-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
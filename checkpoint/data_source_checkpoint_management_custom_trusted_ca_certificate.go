@@ -108,7 +108,7 @@ func dataSourceManagementCustomTrustedCaCertificateRead(d *schema.ResourceData,
 		payload["uid"] = uid
 	}
 
-	CustomTrustedCaCertificateObjRes, err := client.ApiCall("show-custom-trusted-ca-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	CustomTrustedCaCertificateObjRes, err := apiCall(client, "show-custom-trusted-ca-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -246,7 +246,7 @@ func dataSourceManagementDataCenterContentRead(d *schema.ResourceData, m interfa
 			payload["filter"] = filterPayload
 		}
 	}
-	showDataCenterContentRes, err := client.ApiCall("show-data-center-content", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDataCenterContentRes, err := apiCall(client, "show-data-center-content", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -185,7 +185,7 @@ func dataSourceManagementDataCenterObjectRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 	payload["details-level"] = "full"
-	showDataCenterObjRes, err := client.ApiCall("show-data-center-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDataCenterObjRes, err := apiCall(client, "show-data-center-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -96,7 +96,7 @@ func dataSourceDataCenterQueryRead(d *schema.ResourceData, m interface{}) error
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showDataCenterQueryRes, err := client.ApiCall("show-data-center-query", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDataCenterQueryRes, err := apiCall(client, "show-data-center-query", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
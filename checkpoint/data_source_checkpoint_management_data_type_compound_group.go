@@ -80,7 +80,7 @@ func dataSourceManagementDataTypeCompoundGroupRead(d *schema.ResourceData, m int
 		payload["uid"] = uid
 	}
 
-	showDataTypeCompoundGroupRes, err := client.ApiCall("show-data-type-compound-group", payload, client.GetSessionID(), true, false)
+	showDataTypeCompoundGroupRes, err := apiCall(client, "show-data-type-compound-group", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
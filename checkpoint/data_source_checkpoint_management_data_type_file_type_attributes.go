@@ -98,7 +98,7 @@ func dataSourceManagementDataTypeFileAttributesRead(d *schema.ResourceData, m in
 		payload["uid"] = uid
 	}
 
-	showDataTypeFileAttributesRes, err := client.ApiCall("show-data-type-file-attributes", payload, client.GetSessionID(), true, false)
+	showDataTypeFileAttributesRes, err := apiCall(client, "show-data-type-file-attributes", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
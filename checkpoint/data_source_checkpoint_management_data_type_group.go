@@ -81,7 +81,7 @@ func dataSourceManagementDataTypeGroupRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showDataTypeGroupRes, err := client.ApiCall("show-data-type-group", payload, client.GetSessionID(), true, false)
+	showDataTypeGroupRes, err := apiCall(client, "show-data-type-group", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
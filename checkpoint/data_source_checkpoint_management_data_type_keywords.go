@@ -80,7 +80,7 @@ func dataSourceManagementDataTypeKeywordsRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showDataTypeKeywordsRes, err := client.ApiCall("show-data-type-keywords", payload, client.GetSessionID(), true, false)
+	showDataTypeKeywordsRes, err := apiCall(client, "show-data-type-keywords", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -77,7 +77,7 @@ func dataSourceManagementDataTypePatternsRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showDataTypePatternsRes, err := client.ApiCall("show-data-type-patterns", payload, client.GetSessionID(), true, false)
+	showDataTypePatternsRes, err := apiCall(client, "show-data-type-patterns", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -70,7 +70,7 @@ func dataSourceManagementDataTypeTraditionalGroupRead(d *schema.ResourceData, m
 		payload["uid"] = uid
 	}
 
-	showDataTypeTraditionalGroupRes, err := client.ApiCall("show-data-type-traditional-group", payload, client.GetSessionID(), true, false)
+	showDataTypeTraditionalGroupRes, err := apiCall(client, "show-data-type-traditional-group", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
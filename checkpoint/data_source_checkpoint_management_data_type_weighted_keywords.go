@@ -98,7 +98,7 @@ func dataSourceReadManagementDataTypeWeightedKeywords(d *schema.ResourceData, m
 		payload["uid"] = uid
 	}
 
-	showDataTypeWeightedKeywordsRes, err := client.ApiCall("show-data-type-weighted-keywords", payload, client.GetSessionID(), true, false)
+	showDataTypeWeightedKeywordsRes, err := apiCall(client, "show-data-type-weighted-keywords", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
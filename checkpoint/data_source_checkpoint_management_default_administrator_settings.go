@@ -84,7 +84,7 @@ func dataSourceManagementDefaultAdministratorSettingsRead(d *schema.ResourceData
 
 	var payload = map[string]interface{}{}
 
-	defaultAdministratorSettingsRes, err := client.ApiCallSimple("show-default-administrator-settings", payload)
+	defaultAdministratorSettingsRes, err := apiCallSimple(client, "show-default-administrator-settings", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
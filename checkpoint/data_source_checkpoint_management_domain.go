@@ -94,7 +94,7 @@ func dataSourceManagementDomainRead(d *schema.ResourceData, m interface{}) error
 		payload["uid"] = uid
 	}
 
-	showDomainRes, err := client.ApiCall("show-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDomainRes, err := apiCall(client, "show-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -499,7 +499,7 @@ func dataSourceManagementDomainPermissionsProfileRead(d *schema.ResourceData, m
 		payload["uid"] = uid
 	}
 
-	showDomainPermissionsProfileRes, err := client.ApiCall("show-domain-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDomainPermissionsProfileRes, err := apiCall(client, "show-domain-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
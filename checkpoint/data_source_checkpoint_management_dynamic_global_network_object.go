@@ -57,7 +57,7 @@ func dataSourceManagementDynamicGlobalNetworkObjectRead(d *schema.ResourceData,
 		payload["uid"] = uid
 	}
 
-	showDynamicGlobalNetworkObjectRes, err := client.ApiCall("show-dynamic-global-network-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDynamicGlobalNetworkObjectRes, err := apiCall(client, "show-dynamic-global-network-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
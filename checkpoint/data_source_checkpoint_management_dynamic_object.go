@@ -58,7 +58,7 @@ func dataSourceManagementDynamicObjectRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showDynamicObjectRes, err := client.ApiCall("show-dynamic-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDynamicObjectRes, err := apiCall(client, "show-dynamic-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
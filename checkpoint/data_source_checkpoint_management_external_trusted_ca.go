@@ -93,7 +93,7 @@ func dataSourceManagementExternalTrustedCaRead(d *schema.ResourceData, m interfa
 		payload["uid"] = uid
 	}
 
-	showExternalTrustedCaRes, err := client.ApiCall("show-external-trusted-ca", payload, client.GetSessionID(), true, false)
+	showExternalTrustedCaRes, err := apiCall(client, "show-external-trusted-ca", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -135,7 +135,7 @@ func dataSourceManagementGaiaBestPracticeRead(d *schema.ResourceData, m interfac
 		payload["best-practice-id"] = bestPracticeId
 	}
 
-	showGaiaBestPractice, err := client.ApiCall("show-gaia-best-practice", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGaiaBestPractice, err := apiCall(client, "show-gaia-best-practice", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
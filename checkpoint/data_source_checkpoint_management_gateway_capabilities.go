@@ -275,7 +275,7 @@ func dataSourceManagementSetGatewayCapabilitiesRead(d *schema.ResourceData, m in
 		payload["version"] = v
 	}
 
-	showGatewayCapabilitiesRes, err := client.ApiCall("show-gateway-capabilities", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGatewayCapabilitiesRes, err := apiCall(client, "show-gateway-capabilities", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
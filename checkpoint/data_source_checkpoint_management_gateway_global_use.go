@@ -70,7 +70,7 @@ func dataSourceManagementSetGatewayGlobalUseRead(d *schema.ResourceData, m inter
 		payload["target"] = v.(string)
 	}
 
-	ShowGatewayGlobalUseRes, _ := client.ApiCall("show-gateway-global-use", payload, client.GetSessionID(), true, false)
+	ShowGatewayGlobalUseRes, _ := apiCall(client, "show-gateway-global-use", payload, client.GetSessionID(), true, false)
 	if !ShowGatewayGlobalUseRes.Success {
 		return fmt.Errorf("%s", ShowGatewayGlobalUseRes.ErrorMsg)
 	}
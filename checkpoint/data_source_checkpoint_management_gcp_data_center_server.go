@@ -66,7 +66,7 @@ func dataSourceGcpDataCenterServerRead(d *schema.ResourceData, m interface{}) er
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showGcpDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGcpDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
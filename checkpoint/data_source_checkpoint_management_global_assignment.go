@@ -106,7 +106,7 @@ func dataSourceManagementGlobalAssignmentRead(d *schema.ResourceData, m interfac
 		payload["global-domain"] = globalDomain
 	}
 
-	showGlobalAssignmentRes, err := client.ApiCall("show-global-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGlobalAssignmentRes, err := apiCall(client, "show-global-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -264,7 +264,7 @@ func dataSourceManagementGlobalDomainRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showGlobalDomainRes, err := client.ApiCall("show-global-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGlobalDomainRes, err := apiCall(client, "show-global-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
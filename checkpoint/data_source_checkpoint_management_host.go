@@ -219,7 +219,7 @@ func dataSourceManagementHostRead(d *schema.ResourceData, m interface{}) error {
 		payload["uid"] = uid
 	}
 
-	showHostRes, err := client.ApiCall("show-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showHostRes, err := apiCall(client, "show-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -371,7 +371,7 @@ func dataSourceManagementHostRead(d *schema.ResourceData, m interface{}) error {
 				payload := map[string]interface{}{
 					"uid": v,
 				}
-				showProtectedByRes, err := client.ApiCall("show-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+				showProtectedByRes, err := apiCall(client, "show-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 				if err != nil || !showProtectedByRes.Success {
 					if showProtectedByRes.ErrorMsg != "" {
 						return fmt.Errorf("%s", showProtectedByRes.ErrorMsg)
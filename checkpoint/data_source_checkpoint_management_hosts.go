@@ -308,56 +308,48 @@ func dataSourceManagementHostsRead(d *schema.ResourceData, m interface{}) error
 
 	client := m.(*checkpoint.ApiClient)
 
-	showHostsRes := checkpoint.APIResponse{}
-	var err error
-	fetchAll, _ := d.GetOkExists("fetch_all")
+	payload := make(map[string]interface{})
 
-	if fetchAll.(bool) {
-		showHostsRes, err = client.ApiQuery("show-hosts", "full", "objects", true, map[string]interface{}{})
-	} else {
-		payload := make(map[string]interface{})
-
-		if val, ok := d.GetOk("filter"); ok {
-			payload["filter"] = val.(string)
-		}
+	if val, ok := d.GetOk("filter"); ok {
+		payload["filter"] = val.(string)
+	}
 
-		if val, ok := d.GetOk("limit"); ok {
-			payload["limit"] = val.(int)
-		}
+	if val, ok := d.GetOk("limit"); ok {
+		payload["limit"] = val.(int)
+	}
 
-		if v, ok := d.GetOk("order"); ok {
+	if v, ok := d.GetOk("order"); ok {
 
-			orderList := v.([]interface{})
-			if len(orderList) > 0 {
+		orderList := v.([]interface{})
+		if len(orderList) > 0 {
 
-				var orderPayload []map[string]interface{}
+			var orderPayload []map[string]interface{}
 
-				for i := range orderList {
+			for i := range orderList {
 
-					payload := make(map[string]interface{})
+				payload := make(map[string]interface{})
 
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
-						payload["ASC"] = v.(string)
-					}
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
-						payload["DESC"] = v.(string)
-					}
-
-					orderPayload = append(orderPayload, payload)
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
+					payload["ASC"] = v.(string)
+				}
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
+					payload["DESC"] = v.(string)
 				}
 
-				payload["order"] = orderPayload
+				orderPayload = append(orderPayload, payload)
 			}
-		}
 
-		if val, ok := d.GetOk("offset"); ok {
-			payload["offset"] = val.(int)
+			payload["order"] = orderPayload
 		}
+	}
 
-		payload["details-level"] = "full"
-		showHostsRes, err = client.ApiCallSimple("show-hosts", payload)
+	if val, ok := d.GetOk("offset"); ok {
+		payload["offset"] = val.(int)
 	}
 
+	payload["details-level"] = "full"
+	showHostsRes, err := apiCallSimple(client, "show-hosts", payload)
+
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -535,7 +527,7 @@ func dataSourceManagementHostsRead(d *schema.ResourceData, m interface{}) error
 							payload := map[string]interface{}{
 								"uid": v,
 							}
-							showProtectedByRes, err := client.ApiCall("show-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+							showProtectedByRes, err := apiCall(client, "show-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 							if err != nil || !showProtectedByRes.Success {
 								if showProtectedByRes.ErrorMsg != "" {
 									return fmt.Errorf("%s", showProtectedByRes.ErrorMsg)
@@ -132,7 +132,7 @@ func dataSourceManagementSetHttpsAdvancedSettingsRead(d *schema.ResourceData, m
 
 	payload := make(map[string]interface{})
 
-	showHttpsAdvancedSettingsRes, err := client.ApiCall("show-https-advanced-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showHttpsAdvancedSettingsRes, err := apiCall(client, "show-https-advanced-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
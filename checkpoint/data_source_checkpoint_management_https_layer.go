@@ -69,7 +69,7 @@ func dataSourceManagementHttpsLayerRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showHttpsLayerRes, err := client.ApiCall("show-https-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showHttpsLayerRes, err := apiCall(client, "show-https-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -534,7 +534,7 @@ func dataSourceManagementHttpsRuleBaseRead(d *schema.ResourceData, m interface{}
 	if v, ok := d.GetOk("show_membership"); ok {
 		payload["show-membership"] = v.(bool)
 	}
-	showRuleBaseRes, err := client.ApiCall("show-https-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRuleBaseRes, err := apiCall(client, "show-https-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -111,7 +111,7 @@ func dataSourceManagementIdentityProviderRead(d *schema.ResourceData, m interfac
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showIdentityProviderRes, err := client.ApiCallSimple("show-identity-provider", payload)
+	showIdentityProviderRes, err := apiCallSimple(client, "show-identity-provider", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
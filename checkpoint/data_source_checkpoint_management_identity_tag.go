@@ -62,7 +62,7 @@ func dataSourceManagementIdentityTagRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showIdentityTagRes, err := client.ApiCall("show-identity-tag", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIdentityTagRes, err := apiCall(client, "show-identity-tag", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -87,7 +87,7 @@ func dataSourceManagementIdpAdministratorGroupRead(d *schema.ResourceData, m int
 		payload["uid"] = uid
 	}
 
-	showIdpAdministratorGroupRes, err := client.ApiCall("show-idp-administrator-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIdpAdministratorGroupRes, err := apiCall(client, "show-idp-administrator-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -44,7 +44,7 @@ func dataSourceManagementIdpDefaultAssignmentRead(d *schema.ResourceData, m inte
 
 	payload := make(map[string]interface{})
 
-	showIdpDefaultAssignmentRes, err := client.ApiCall("show-idp-default-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIdpDefaultAssignmentRes, err := apiCall(client, "show-idp-default-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -63,7 +63,7 @@ func dataSourceManagementIdpToDomainAssignmentRead(d *schema.ResourceData, m int
 		payload["uid"] = uid
 	}
 
-	showIdpToDomainAssignmentRes, err := client.ApiCall("show-idp-to-domain-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIdpToDomainAssignmentRes, err := apiCall(client, "show-idp-to-domain-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -126,7 +126,7 @@ func dataSourceManagementIfMapServerRead(d *schema.ResourceData, m interface{})
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showIfMapServerRes, err := client.ApiCallSimple("show-if-map-server", payload)
+	showIfMapServerRes, err := apiCallSimple(client, "show-if-map-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -81,7 +81,7 @@ func dataSourceIllumioDataCenterServerRead(d *schema.ResourceData, m interface{}
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showIllumioDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIllumioDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -71,7 +71,7 @@ func dataSourceManagementDeleteInfinityIdpRead(d *schema.ResourceData, m interfa
 		payload["uid"] = uid
 	}
 
-	showInfinityIdpRes, err := client.ApiCall("show-infinity-idp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showInfinityIdpRes, err := apiCall(client, "show-infinity-idp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
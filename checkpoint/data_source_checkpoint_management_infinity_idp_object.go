@@ -84,7 +84,7 @@ func dataSourceManagementDeleteInfinityIdpObjectRead(d *schema.ResourceData, m i
 		payload["uid"] = uid
 	}
 
-	showInfinityIdpRes, err := client.ApiCall("show-infinity-idp-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showInfinityIdpRes, err := apiCall(client, "show-infinity-idp-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -356,7 +356,7 @@ func dataSourceManagementInterfaceRead(d *schema.ResourceData, m interface{}) er
 	if v, ok := d.GetOk("gateway_uid"); ok {
 		payload["gateway-uid"] = v
 	}
-	showInterfaceRes, err := client.ApiCall("show-interface", payload, client.GetSessionID(), true, false)
+	showInterfaceRes, err := apiCall(client, "show-interface", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
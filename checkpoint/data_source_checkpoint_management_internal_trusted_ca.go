@@ -94,7 +94,7 @@ func dataSourceManagementSetInternalTrustedCaRead(d *schema.ResourceData, m inte
 
 	var payload = map[string]interface{}{}
 
-	internalTrustedCaRes, _ := client.ApiCall("show-internal-trusted-ca", payload, client.GetSessionID(), true, false)
+	internalTrustedCaRes, _ := apiCall(client, "show-internal-trusted-ca", payload, client.GetSessionID(), true, false)
 	if !internalTrustedCaRes.Success {
 		return fmt.Errorf("%s", internalTrustedCaRes.ErrorMsg)
 	}
@@ -240,7 +240,7 @@ func dataSourceManagementInteroperableDeviceRead(d *schema.ResourceData, m inter
 		payload["uid"] = uid
 	}
 
-	showInteroperableDeviceRes, err := client.ApiCall("show-interoperable-device", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showInteroperableDeviceRes, err := apiCall(client, "show-interoperable-device", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
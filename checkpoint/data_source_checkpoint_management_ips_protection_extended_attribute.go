@@ -58,7 +58,7 @@ func dataSourceManagementIpsProtectionExtendedAttributeRead(d *schema.ResourceDa
 		payload["uid"] = uid
 	}
 
-	showIpsProtectionExtendedAttributeRes, err := client.ApiCall("show-ips-protection-extended-attribute", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIpsProtectionExtendedAttributeRes, err := apiCall(client, "show-ips-protection-extended-attribute", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -66,7 +66,7 @@ func dataSourceManagementIpsUpdateScheduleRead(d *schema.ResourceData, m interfa
 
 	payload := make(map[string]interface{})
 
-	showIpsUpdateScheduleRes, err := client.ApiCall("show-ips-update-schedule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIpsUpdateScheduleRes, err := apiCall(client, "show-ips-update-schedule", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
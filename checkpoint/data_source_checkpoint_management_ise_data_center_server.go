@@ -84,7 +84,7 @@ func dataSourceIseDataCenterServerRead(d *schema.ResourceData, m interface{}) er
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showIseDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIseDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
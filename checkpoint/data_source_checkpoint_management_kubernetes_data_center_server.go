@@ -81,7 +81,7 @@ func dataSourceKubernetesDataCenterServerRead(d *schema.ResourceData, m interfac
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showKubernetesDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showKubernetesDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -92,7 +92,7 @@ func dataSourceManagementLdapGroupRead(d *schema.ResourceData, m interface{}) er
 		payload["uid"] = uid
 	}
 
-	showLdapGroupRes, err := client.ApiCallSimple("show-ldap-group", payload)
+	showLdapGroupRes, err := apiCallSimple(client, "show-ldap-group", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
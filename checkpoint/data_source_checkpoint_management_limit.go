@@ -89,7 +89,7 @@ func dataSourceManagementLimitRead(d *schema.ResourceData, m interface{}) error
 		payload["uid"] = uid
 	}
 
-	showLimitRes, err := client.ApiCall("show-limit", payload, client.GetSessionID(), true, false)
+	showLimitRes, err := apiCall(client, "show-limit", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
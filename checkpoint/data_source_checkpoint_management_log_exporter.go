@@ -121,7 +121,7 @@ func dataSourceManagementLogExporterRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showLogExporterRes, err := client.ApiCallSimple("show-log-exporter", payload)
+	showLogExporterRes, err := apiCallSimple(client, "show-log-exporter", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
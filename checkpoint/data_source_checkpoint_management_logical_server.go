@@ -97,7 +97,7 @@ func dataSourceManagementLogicalServerRead(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showLogicalServerRes, err := client.ApiCall("show-logical-server", payload, client.GetSessionID(), true, false)
+	showLogicalServerRes, err := apiCall(client, "show-logical-server", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
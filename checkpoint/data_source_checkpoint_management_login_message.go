@@ -41,7 +41,7 @@ func dataSourceManagementLoginMessageRead(d *schema.ResourceData, m interface{})
 
 	payload := make(map[string]interface{})
 
-	showLoginMessageRes, err := client.ApiCall("show-login-message", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLoginMessageRes, err := apiCall(client, "show-login-message", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
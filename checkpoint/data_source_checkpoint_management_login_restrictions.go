@@ -50,7 +50,7 @@ func dataSourceManagementLoginRestrictionsRead(d *schema.ResourceData, m interfa
 
 	var payload = map[string]interface{}{}
 
-	loginRestrictionsRes, err := client.ApiCallSimple("show-login-restrictions", payload)
+	loginRestrictionsRes, err := apiCallSimple(client, "show-login-restrictions", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -299,7 +299,7 @@ func dataSourceManagementLsmClusterRead(d *schema.ResourceData, m interface{}) e
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showLsmClusterRes, err := client.ApiCall("show-lsm-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLsmClusterRes, err := apiCall(client, "show-lsm-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
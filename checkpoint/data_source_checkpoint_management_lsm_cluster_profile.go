@@ -670,7 +670,7 @@ func dataSourceManagementLsmClusterProfileRead(d *schema.ResourceData, m interfa
 		payload["uid"] = uid
 	}
 
-	showLsmClusterProfileRes, err := client.ApiCall("show-lsm-cluster-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLsmClusterProfileRes, err := apiCall(client, "show-lsm-cluster-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -237,7 +237,7 @@ func dataSourceManagementLsmGatewayRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showLsmGatewayRes, err := client.ApiCall("show-lsm-gateway", payload, client.GetSessionID(), true, false)
+	showLsmGatewayRes, err := apiCall(client, "show-lsm-gateway", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
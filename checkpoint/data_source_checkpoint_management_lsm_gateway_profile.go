@@ -554,7 +554,7 @@ func dataSourceManagementLsmGatewayProfileRead(d *schema.ResourceData, m interfa
 		payload["uid"] = uid
 	}
 
-	showLsmGatewayProfileRes, err := client.ApiCall("show-lsm-gateway-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLsmGatewayProfileRes, err := apiCall(client, "show-lsm-gateway-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
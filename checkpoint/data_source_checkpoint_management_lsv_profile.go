@@ -94,7 +94,7 @@ func dataSourceManagementLsvProfileRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showLsvProfileRes, err := client.ApiCall("show-lsv-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLsvProfileRes, err := apiCall(client, "show-lsv-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -131,7 +131,7 @@ func dataSourceManagementMdPermissionsProfileRead(d *schema.ResourceData, m inte
 		payload["uid"] = uid
 	}
 
-	showMdPermissionsProfileRes, err := client.ApiCall("show-md-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showMdPermissionsProfileRes, err := apiCall(client, "show-md-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
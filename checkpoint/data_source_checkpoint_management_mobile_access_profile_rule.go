@@ -70,7 +70,7 @@ func dataSourceManagementMobileAccessProfileRuleRead(d *schema.ResourceData, m i
 		payload["uid"] = uid
 	}
 
-	showMobileAccessProfileRuleRes, err := client.ApiCall("show-mobile-access-profile-rule", payload, client.GetSessionID(), true, false)
+	showMobileAccessProfileRuleRes, err := apiCall(client, "show-mobile-access-profile-rule", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -49,7 +49,7 @@ func dataSourceManagementMobileAccessProfileSectionRead(d *schema.ResourceData,
 		payload["uid"] = uid
 	}
 
-	showMobileAccessProfileSectionRes, err := client.ApiCall("show-mobile-access-profile-section", payload, client.GetSessionID(), true, false)
+	showMobileAccessProfileSectionRes, err := apiCall(client, "show-mobile-access-profile-section", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
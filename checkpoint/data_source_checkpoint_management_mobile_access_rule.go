@@ -83,7 +83,7 @@ func dataManagementMobileAccessRuleRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showMobileAccessRuleRes, err := client.ApiCall("show-mobile-access-rule", payload, client.GetSessionID(), true, false)
+	showMobileAccessRuleRes, err := apiCall(client, "show-mobile-access-rule", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -50,7 +50,7 @@ func dataSourceManagementMobileAccessSectionRead(d *schema.ResourceData, m inter
 		payload["uid"] = uid
 	}
 
-	showMobileAccessSectionRes, err := client.ApiCall("show-mobile-access-section", payload, client.GetSessionID(), true, false)
+	showMobileAccessSectionRes, err := apiCall(client, "show-mobile-access-section", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
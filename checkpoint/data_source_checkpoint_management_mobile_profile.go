@@ -429,7 +429,7 @@ func dataSourceManagementMobileProfileRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showMobileProfileRes, err := client.ApiCall("show-mobile-profile", payload, client.GetSessionID(), true, false)
+	showMobileProfileRes, err := apiCall(client, "show-mobile-profile", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -122,7 +122,7 @@ func dataSourceManagementMultipleKeyExchangesRead(d *schema.ResourceData, m inte
 		payload["uid"] = uid
 	}
 
-	showMultipleKeyExchangesRes, err := client.ApiCall("show-multiple-key-exchanges", payload, client.GetSessionID(), true, false)
+	showMultipleKeyExchangesRes, err := apiCall(client, "show-multiple-key-exchanges", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
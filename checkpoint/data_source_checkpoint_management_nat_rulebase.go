@@ -431,7 +431,7 @@ func dataSourceManagementNatRuleBaseRead(d *schema.ResourceData, m interface{})
 		payload["show-membership"] = v.(bool)
 	}
 
-	showRuleBaseRes, err := client.ApiCall("show-nat-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRuleBaseRes, err := apiCall(client, "show-nat-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -47,7 +47,7 @@ func dataSourceManagementNatSectionRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showNatSectionRes, err := client.ApiCall("show-nat-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNatSectionRes, err := apiCall(client, "show-nat-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -141,7 +141,7 @@ func dataSourceManagementNetworkFeedRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showNetworkFeedRes, err := client.ApiCall("show-network-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNetworkFeedRes, err := apiCall(client, "show-network-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
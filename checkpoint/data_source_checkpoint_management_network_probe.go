@@ -115,7 +115,7 @@ func dataSourceManagementNetworkProbeRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showNetworkProbeRes, err := client.ApiCall("show-network-probe", payload, client.GetSessionID(), true, false)
+	showNetworkProbeRes, err := apiCall(client, "show-network-probe", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
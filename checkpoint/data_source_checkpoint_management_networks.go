@@ -219,56 +219,48 @@ func dataSourceManagementNetworksRead(d *schema.ResourceData, m interface{}) err
 
 	client := m.(*checkpoint.ApiClient)
 
-	showNetworksRes := checkpoint.APIResponse{}
-	var err error
-	fetchAll, _ := d.GetOkExists("fetch_all")
+	payload := make(map[string]interface{})
 
-	if fetchAll.(bool) {
-		showNetworksRes, err = client.ApiQuery("show-networks", "full", "objects", true, map[string]interface{}{})
-	} else {
-		payload := make(map[string]interface{})
-
-		if val, ok := d.GetOk("filter"); ok {
-			payload["filter"] = val.(string)
-		}
+	if val, ok := d.GetOk("filter"); ok {
+		payload["filter"] = val.(string)
+	}
 
-		if val, ok := d.GetOk("limit"); ok {
-			payload["limit"] = val.(int)
-		}
+	if val, ok := d.GetOk("limit"); ok {
+		payload["limit"] = val.(int)
+	}
 
-		if v, ok := d.GetOk("order"); ok {
+	if v, ok := d.GetOk("order"); ok {
 
-			orderList := v.([]interface{})
-			if len(orderList) > 0 {
+		orderList := v.([]interface{})
+		if len(orderList) > 0 {
 
-				var orderPayload []map[string]interface{}
+			var orderPayload []map[string]interface{}
 
-				for i := range orderList {
+			for i := range orderList {
 
-					payload := make(map[string]interface{})
+				payload := make(map[string]interface{})
 
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
-						payload["ASC"] = v.(string)
-					}
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
-						payload["DESC"] = v.(string)
-					}
-
-					orderPayload = append(orderPayload, payload)
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
+					payload["ASC"] = v.(string)
+				}
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
+					payload["DESC"] = v.(string)
 				}
 
-				payload["order"] = orderPayload
+				orderPayload = append(orderPayload, payload)
 			}
-		}
 
-		if val, ok := d.GetOk("offset"); ok {
-			payload["offset"] = val.(int)
+			payload["order"] = orderPayload
 		}
+	}
 
-		payload["details-level"] = "full"
-		showNetworksRes, err = client.ApiCallSimple("show-networks", payload)
+	if val, ok := d.GetOk("offset"); ok {
+		payload["offset"] = val.(int)
 	}
 
+	payload["details-level"] = "full"
+	showNetworksRes, err := apiCallSimple(client, "show-networks", payload)
+
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -87,7 +87,7 @@ func dataSourceNuageDataCenterServerRead(d *schema.ResourceData, m interface{})
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showNuageDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNuageDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
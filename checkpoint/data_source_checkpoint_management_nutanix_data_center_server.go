@@ -87,7 +87,7 @@ func dataSourceNutanixDataCenterServerRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showNutanixDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNutanixDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -200,7 +200,7 @@ func dataSourceManagementObjectsRead(d *schema.ResourceData, m interface{}) erro
 		payload["domains-to-process"] = v.(*schema.Set).List()
 	}
 
-	showObjectsRes, err := client.ApiCall("show-objects", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showObjectsRes, err := apiCall(client, "show-objects", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -81,7 +81,7 @@ func dataSourceOpenStackDataCenterServerRead(d *schema.ResourceData, m interface
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showOpenStackDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOpenStackDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -106,7 +106,7 @@ func dataSourceManagementOpsecApplicationRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showOpsecApplicationRes, err := client.ApiCall("show-opsec-application", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOpsecApplicationRes, err := apiCall(client, "show-opsec-application", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -215,7 +215,7 @@ func dataSourceManagementOpsecTrustedCaRead(d *schema.ResourceData, m interface{
 		payload["uid"] = uid
 	}
 
-	showOpsecTrustedCaRes, err := client.ApiCall("show-opsec-trusted-ca", payload, client.GetSessionID(), true, false)
+	showOpsecTrustedCaRes, err := apiCall(client, "show-opsec-trusted-ca", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
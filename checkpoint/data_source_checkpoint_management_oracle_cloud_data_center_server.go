@@ -87,7 +87,7 @@ func dataSourceOracleCloudDataCenterServerRead(d *schema.ResourceData, m interfa
 		payload["uid"] = uid
 	}
 
-	showOracleCloudDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOracleCloudDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -88,7 +88,7 @@ func dataSourceManagementOutboundInspectionCertificateRead(d *schema.ResourceDat
 		payload["uid"] = uid
 	}
 
-	showOutboundInspectionCertificateRes, err := client.ApiCall("show-outbound-inspection-certificate", payload, client.GetSessionID(), true, false)
+	showOutboundInspectionCertificateRes, err := apiCall(client, "show-outbound-inspection-certificate", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -79,7 +79,7 @@ func dataSourceManagementOverrideCategorizationRead(d *schema.ResourceData, m in
 		payload["uid"] = d.Get("uid")
 	}
 
-	showOverrideCategorizationRes, err := client.ApiCall("show-override-categorization", payload, client.GetSessionID(), true, false)
+	showOverrideCategorizationRes, err := apiCall(client, "show-override-categorization", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -140,7 +140,7 @@ func dataSourceManagementPackageRead(d *schema.ResourceData, m interface{}) erro
 		payload["show-installation-targets"] = v.(bool)
 	}
 
-	showPackageRes, err := client.ApiCall("show-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showPackageRes, err := apiCall(client, "show-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
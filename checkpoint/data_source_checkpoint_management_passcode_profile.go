@@ -118,7 +118,7 @@ func dataSourceManagementPasscodeProfileRead(d *schema.ResourceData, m interface
 		payload["uid"] = uid
 	}
 
-	showPasscodeProfileRes, err := client.ApiCall("show-passcode-profile", payload, client.GetSessionID(), true, false)
+	showPasscodeProfileRes, err := apiCall(client, "show-passcode-profile", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -55,7 +55,7 @@ func dataSourceManagementPolicySettingsRead(d *schema.ResourceData, m interface{
 
 	payload := make(map[string]interface{})
 
-	showPolicySettingsRes, err := client.ApiCall("show-policy-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showPolicySettingsRes, err := apiCall(client, "show-policy-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -325,7 +325,7 @@ func dataSourceManagementProvisioningProfileRead(d *schema.ResourceData, m inter
 		payload["uid"] = uid
 	}
 
-	showProvisioningProfileRes, err := client.ApiCall("show-provisioning-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showProvisioningProfileRes, err := apiCall(client, "show-provisioning-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -91,7 +91,7 @@ func dataSourceProxmoxDataCenterServerRead(d *schema.ResourceData, m interface{}
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showProxmoxDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showProxmoxDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
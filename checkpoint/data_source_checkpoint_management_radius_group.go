@@ -64,7 +64,7 @@ func dataSourceManagementRadiusGroupRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showRadiusGroupRes, err := client.ApiCall("show-radius-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRadiusGroupRes, err := apiCall(client, "show-radius-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -107,7 +107,7 @@ func dataSourceManagementRadiusServerRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showRadiusServerRes, err := client.ApiCall("show-radius-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRadiusServerRes, err := apiCall(client, "show-radius-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
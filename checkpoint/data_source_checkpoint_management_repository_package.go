@@ -34,7 +34,7 @@ func dataSourceManagementRepositoryPackageRead(d *schema.ResourceData, m interfa
 
 	payload["name"] = name
 
-	showRepositoryPackageRes, err := client.ApiCall("show-repository-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRepositoryPackageRes, err := apiCall(client, "show-repository-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
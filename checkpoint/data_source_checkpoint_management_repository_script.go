@@ -68,7 +68,7 @@ func dataSourceManagementRepositoryScriptRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showRepositoryScriptRes, err := client.ApiCall("show-repository-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRepositoryScriptRes, err := apiCall(client, "show-repository-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
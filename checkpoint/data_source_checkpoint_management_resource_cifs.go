@@ -91,7 +91,7 @@ func dataSourceManagementResourceCifsRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showResourceCifsRes, err := client.ApiCall("show-resource-cifs", payload, client.GetSessionID(), true, false)
+	showResourceCifsRes, err := apiCall(client, "show-resource-cifs", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
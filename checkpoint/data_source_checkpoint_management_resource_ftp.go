@@ -103,7 +103,7 @@ func dataSourceManagementResourceFtpRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showResourceFtpRes, err := client.ApiCall("show-resource-ftp", payload, client.GetSessionID(), true, false)
+	showResourceFtpRes, err := apiCall(client, "show-resource-ftp", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -68,7 +68,7 @@ func dataSourceManagementResourceMmsRead(d *schema.ResourceData, m interface{})
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showResourceMmsRes, err := client.ApiCallSimple("show-resource-mms", payload)
+	showResourceMmsRes, err := apiCallSimple(client, "show-resource-mms", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -271,7 +271,7 @@ func dataSourceManagementResourceSmtpRead(d *schema.ResourceData, m interface{})
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showResourceSmtpRes, err := client.ApiCall("show-resource-smtp", payload, client.GetSessionID(), true, false)
+	showResourceSmtpRes, err := apiCall(client, "show-resource-smtp", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
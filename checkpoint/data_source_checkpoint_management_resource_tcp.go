@@ -126,7 +126,7 @@ func dataSourceManagementResourceTcpRead(d *schema.ResourceData, m interface{})
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showResourceTcpRes, err := client.ApiCallSimple("show-resource-tcp", payload)
+	showResourceTcpRes, err := apiCallSimple(client, "show-resource-tcp", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
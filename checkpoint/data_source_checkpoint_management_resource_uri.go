@@ -341,7 +341,7 @@ func dataSourceManagementResourceUriRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showResourceUriRes, err := client.ApiCall("show-resource-uri", payload, client.GetSessionID(), true, false)
+	showResourceUriRes, err := apiCall(client, "show-resource-uri", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
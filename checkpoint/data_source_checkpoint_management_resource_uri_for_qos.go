@@ -63,7 +63,7 @@ func dataSourceManagementResourceUriForQosRead(d *schema.ResourceData, m interfa
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showResourceUriForQosRes, err := client.ApiCallSimple("show-resource-uri-for-qos", payload)
+	showResourceUriForQosRes, err := apiCallSimple(client, "show-resource-uri-for-qos", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
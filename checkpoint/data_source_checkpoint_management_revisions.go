@@ -0,0 +1,128 @@
+package checkpoint
+
+import (
+	"sort"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceManagementRevisions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementRevisionsRead,
+		Schema: map[string]*schema.Schema{
+			"publisher": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only revisions published by this administrator.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Return only this number of the latest revisions.",
+				ValidateFunc: validateIntRange(1, 10000),
+			},
+			"revisions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Revisions list, the latest revision first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Revision unique identifier, the uid of the published session. Use it as to_session of revert_to_revision.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session name.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session description.",
+						},
+						"publisher": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Administrator who published the session.",
+						},
+						"application": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application the session was published with.",
+						},
+						"publish_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Publish time in ISO 8601 format.",
+						},
+						"changes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of changes in the revision.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementRevisionsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	sessions, err := showSessions(client, true)
+	if err != nil {
+		return err
+	}
+
+	publisher := d.Get("publisher").(string)
+	revisions := make([]map[string]interface{}, 0)
+	for _, session := range sessions {
+		if getString(session, "state") != "published" {
+			continue
+		}
+		if publisher != "" && getString(session, "user-name") != publisher {
+			continue
+		}
+		revisions = append(revisions, session)
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisionPublishTime(revisions[i]) > revisionPublishTime(revisions[j])
+	})
+	if limit := d.Get("limit").(int); limit > 0 && len(revisions) > limit {
+		revisions = revisions[:limit]
+	}
+
+	revisionsToReturn := make([]interface{}, 0, len(revisions))
+	for _, revision := range revisions {
+		publishTime := ""
+		if v, ok := revision["publish-time"].(map[string]interface{}); ok {
+			publishTime = getString(v, "iso-8601")
+		}
+		changes, _ := revision["changes"].(float64)
+		revisionsToReturn = append(revisionsToReturn, map[string]interface{}{
+			"uid":          getString(revision, "uid"),
+			"name":         getString(revision, "name"),
+			"description":  getString(revision, "description"),
+			"publisher":    getString(revision, "user-name"),
+			"application":  getString(revision, "application"),
+			"publish_time": publishTime,
+			"changes":      int(changes),
+		})
+	}
+	_ = d.Set("revisions", revisionsToReturn)
+
+	d.SetId("show-revisions-" + acctest.RandString(10))
+	return nil
+}
+
+// revisionPublishTime returns the publish time of the published session in milliseconds.
+func revisionPublishTime(session map[string]interface{}) float64 {
+	publishTime, _ := session["publish-time"].(map[string]interface{})
+	posix, _ := publishTime["posix"].(float64)
+	return posix
+}
@@ -82,7 +82,7 @@ func dataSourceManagementSecuremoteDnsServerRead(d *schema.ResourceData, m inter
 		payload["uid"] = uid
 	}
 
-	showSecuremoteDnsServerRes, err := client.ApiCallSimple("show-securemote-dns-server", payload)
+	showSecuremoteDnsServerRes, err := apiCallSimple(client, "show-securemote-dns-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -68,7 +68,7 @@ func dataSourceManagementSecuridServerRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showSecuridServerRes, err := client.ApiCallSimple("show-securid-server", payload)
+	showSecuridServerRes, err := apiCallSimple(client, "show-securid-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -59,7 +59,7 @@ func dataSourceManagementServerCertificateRead(d *schema.ResourceData, m interfa
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showServerCertificateRes, err := client.ApiCall("show-server-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServerCertificateRes, err := apiCall(client, "show-server-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
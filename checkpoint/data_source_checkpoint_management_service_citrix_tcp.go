@@ -63,7 +63,7 @@ func dataSourceManagementServiceCitrixTcpRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showServiceCitrixTcpRes, err := client.ApiCall("show-service-citrix-tcp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceCitrixTcpRes, err := apiCall(client, "show-service-citrix-tcp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -312,7 +312,7 @@ func dataSourceManagementServiceGtpRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showServiceGtpRes, err := client.ApiCall("show-service-gtp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceGtpRes, err := apiCall(client, "show-service-gtp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
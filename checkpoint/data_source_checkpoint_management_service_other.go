@@ -145,7 +145,7 @@ func dataSourceManagementServiceOtherRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showServiceOtherRes, err := client.ApiCall("show-service-other", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceOtherRes, err := apiCall(client, "show-service-other", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
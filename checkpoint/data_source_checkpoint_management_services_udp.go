@@ -234,56 +234,48 @@ func dataSourceManagementServicesUdpRead(d *schema.ResourceData, m interface{})
 
 	client := m.(*checkpoint.ApiClient)
 
-	showServicesUdpRes := checkpoint.APIResponse{}
-	var err error
-	fetchAll, _ := d.GetOkExists("fetch_all")
+	payload := make(map[string]interface{})
 
-	if fetchAll.(bool) {
-		showServicesUdpRes, err = client.ApiQuery("show-services-udp", "full", "objects", true, map[string]interface{}{})
-	} else {
-		payload := make(map[string]interface{})
-
-		if val, ok := d.GetOk("filter"); ok {
-			payload["filter"] = val.(string)
-		}
+	if val, ok := d.GetOk("filter"); ok {
+		payload["filter"] = val.(string)
+	}
 
-		if val, ok := d.GetOk("limit"); ok {
-			payload["limit"] = val.(int)
-		}
+	if val, ok := d.GetOk("limit"); ok {
+		payload["limit"] = val.(int)
+	}
 
-		if v, ok := d.GetOk("order"); ok {
+	if v, ok := d.GetOk("order"); ok {
 
-			orderList := v.([]interface{})
-			if len(orderList) > 0 {
+		orderList := v.([]interface{})
+		if len(orderList) > 0 {
 
-				var orderPayload []map[string]interface{}
+			var orderPayload []map[string]interface{}
 
-				for i := range orderList {
+			for i := range orderList {
 
-					payload := make(map[string]interface{})
+				payload := make(map[string]interface{})
 
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
-						payload["ASC"] = v.(string)
-					}
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
-						payload["DESC"] = v.(string)
-					}
-
-					orderPayload = append(orderPayload, payload)
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
+					payload["ASC"] = v.(string)
+				}
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
+					payload["DESC"] = v.(string)
 				}
 
-				payload["order"] = orderPayload
+				orderPayload = append(orderPayload, payload)
 			}
-		}
 
-		if val, ok := d.GetOk("offset"); ok {
-			payload["offset"] = val.(int)
+			payload["order"] = orderPayload
 		}
+	}
 
-		payload["details-level"] = "full"
-		showServicesUdpRes, err = client.ApiCallSimple("show-services-udp", payload)
+	if val, ok := d.GetOk("offset"); ok {
+		payload["offset"] = val.(int)
 	}
 
+	payload["details-level"] = "full"
+	showServicesUdpRes, err := apiCallSimple(client, "show-services-udp", payload)
+
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -0,0 +1,169 @@
+package checkpoint
+
+import (
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceManagementSessions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementSessionsRead,
+		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only sessions of this application, e.g. WEB_API or SmartConsole.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only sessions of this administrator.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only sessions with this name.",
+			},
+			"min_age": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Return only sessions which logged in at least this number of minutes ago.",
+			},
+			"view_published_sessions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Return published sessions as well.",
+			},
+			"sessions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sessions list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session unique identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session name.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session description.",
+						},
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Administrator of the session.",
+						},
+						"application": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Application the session was opened with.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Session state, e.g. open or published.",
+						},
+						"changes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of changes in the session.",
+						},
+						"locks": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of objects locked by the session.",
+						},
+						"in_work": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether an application is connected to the session.",
+						},
+						"expired_session": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the session expired.",
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address the session was opened from.",
+						},
+						"last_login_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last login time of the session in ISO 8601 format.",
+						},
+						"age": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minutes since the last login of the session.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementSessionsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	sessions, err := showSessions(client, d.Get("view_published_sessions").(bool))
+	if err != nil {
+		return err
+	}
+
+	application := d.Get("application").(string)
+	userName := d.Get("user_name").(string)
+	name := d.Get("name").(string)
+	minAge := d.Get("min_age").(int)
+	now := time.Now()
+
+	sessionsToReturn := make([]interface{}, 0)
+	for _, session := range sessions {
+		if (application != "" && getString(session, "application") != application) ||
+			(userName != "" && getString(session, "user-name") != userName) ||
+			(name != "" && getString(session, "name") != name) ||
+			sessionAge(session, now) < minAge {
+			continue
+		}
+		lastLoginTime := ""
+		if v, ok := session["last-login-time"].(map[string]interface{}); ok {
+			lastLoginTime = getString(v, "iso-8601")
+		}
+		changes, _ := session["changes"].(float64)
+		locks, _ := session["locks"].(float64)
+		inWork, _ := session["in-work"].(bool)
+		expired, _ := session["expired-session"].(bool)
+		sessionsToReturn = append(sessionsToReturn, map[string]interface{}{
+			"uid":             getString(session, "uid"),
+			"name":            getString(session, "name"),
+			"description":     getString(session, "description"),
+			"user_name":       getString(session, "user-name"),
+			"application":     getString(session, "application"),
+			"state":           getString(session, "state"),
+			"changes":         int(changes),
+			"locks":           int(locks),
+			"in_work":         inWork,
+			"expired_session": expired,
+			"ip_address":      getString(session, "ip-address"),
+			"last_login_time": lastLoginTime,
+			"age":             sessionAge(session, now),
+		})
+	}
+	_ = d.Set("sessions", sessionsToReturn)
+
+	d.SetId("show-sessions-" + acctest.RandString(10))
+	return nil
+}
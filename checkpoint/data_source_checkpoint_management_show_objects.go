@@ -225,7 +225,7 @@ func dataSourceManagementShowObjectsRead(d *schema.ResourceData, m interface{})
 		}
 	}
 
-	showObjectsRes, err := client.ApiCall("show-objects", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showObjectsRes, err := apiCall(client, "show-objects", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -411,7 +411,7 @@ func dataSourceManagementShowThreatRuleExceptionRuleBaseRead(d *schema.ResourceD
 		useObjDict = v.(bool)
 	}
 
-	showThreatRuleExceptionRuleBaseRes, err := client.ApiCall("show-threat-rule-exception-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatRuleExceptionRuleBaseRes, err := apiCall(client, "show-threat-rule-exception-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -227,7 +227,7 @@ func dataSourceManagementShowUpdatableObjectsRepositoryContentRead(d *schema.Res
 		}
 	}
 
-	showUpdatableObjectsRepositoryContentRes, err := client.ApiCall("show-updatable-objects-repository-content", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showUpdatableObjectsRepositoryContentRes, err := apiCall(client, "show-updatable-objects-repository-content", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
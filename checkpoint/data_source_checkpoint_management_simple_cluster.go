@@ -1905,7 +1905,7 @@ func dataSourceManagementSimpleClusterRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showClusterRes, err := client.ApiCall("show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showClusterRes, err := apiCall(client, "show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1921,7 +1921,7 @@ func dataSourceManagementSimpleClusterRead(d *schema.ResourceData, m interface{}
 			totalInterfaces := int(total.(float64))
 			if totalInterfaces > 50 {
 				payload["limit-interfaces"] = totalInterfaces
-				showClusterRes, err := client.ApiCall("show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
+				showClusterRes, err := apiCall(client, "show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
 				if err != nil {
 					return fmt.Errorf("%s", err.Error())
 				}
@@ -1868,7 +1868,7 @@ func dataSourceManagementSimpleGatewayRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showGatewayRes, err := client.ApiCall("show-simple-gateway", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGatewayRes, err := apiCall(client, "show-simple-gateway", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -35,7 +35,7 @@ func dataSourceManagementSmartConsoleIdleTimeoutRead(d *schema.ResourceData, m i
 
 	var payload = map[string]interface{}{}
 
-	smartConsoleIdleTimeoutRes, err := client.ApiCallSimple("show-smart-console-idle-timeout", payload)
+	smartConsoleIdleTimeoutRes, err := apiCallSimple(client, "show-smart-console-idle-timeout", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -260,7 +260,7 @@ func dataSourceManagementSmartTaskRead(d *schema.ResourceData, m interface{}) er
 		payload["uid"] = uid
 	}
 
-	showSmartTaskRes, err := client.ApiCall("show-smart-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showSmartTaskRes, err := apiCall(client, "show-smart-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
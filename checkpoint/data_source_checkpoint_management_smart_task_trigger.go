@@ -50,7 +50,7 @@ func dataSourceManagementSmartTaskTriggerRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showSmartTaskTriggerRes, err := client.ApiCall("show-smart-task-trigger", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showSmartTaskTriggerRes, err := apiCall(client, "show-smart-task-trigger", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
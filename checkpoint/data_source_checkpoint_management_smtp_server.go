@@ -96,7 +96,7 @@ func dataSourceManagementSmtpServerRead(d *schema.ResourceData, m interface{}) e
 		payload["uid"] = uid
 	}
 
-	showSmtpServerRes, err := client.ApiCall("show-smtp-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showSmtpServerRes, err := apiCall(client, "show-smtp-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
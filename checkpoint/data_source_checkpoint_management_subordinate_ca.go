@@ -179,7 +179,7 @@ func dataSourceManagementSubordinateCaRead(d *schema.ResourceData, m interface{}
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showSubordinateCaRes, err := client.ApiCall("show-subordinate-ca", payload, client.GetSessionID(), true, false)
+	showSubordinateCaRes, err := apiCall(client, "show-subordinate-ca", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -30,7 +30,7 @@ func dataSourceManagementSyncWIthUserCenterRead(d *schema.ResourceData, m interf
 
 	var payload = map[string]interface{}{}
 
-	syncWIthUserCenterRes, err := client.ApiCallSimple("show-sync-with-user-center", payload)
+	syncWIthUserCenterRes, err := apiCallSimple(client, "show-sync-with-user-center", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -78,7 +78,7 @@ func dataSourceManagementSyslogServerRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showSyslogServerRes, err := client.ApiCallSimple("show-syslog-server", payload)
+	showSyslogServerRes, err := apiCallSimple(client, "show-syslog-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -65,7 +65,7 @@ func dataSourceManagementTacacsGroupRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showTacacsGroupRes, err := client.ApiCall("show-tacacs-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTacacsGroupRes, err := apiCall(client, "show-tacacs-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
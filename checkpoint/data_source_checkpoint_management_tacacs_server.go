@@ -100,7 +100,7 @@ func dataSourceManagementTacacsServerRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showTacacsServerRes, err := client.ApiCall("show-tacacs-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTacacsServerRes, err := apiCall(client, "show-tacacs-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
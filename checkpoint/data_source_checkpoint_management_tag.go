@@ -57,7 +57,7 @@ func dataSourceManagementTagRead(d *schema.ResourceData, m interface{}) error {
 		payload["uid"] = uid
 	}
 
-	showTag, err := client.ApiCall("show-tag", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTag, err := apiCall(client, "show-tag", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -80,7 +80,7 @@ func dataSourceManagementTaskRead(d *schema.ResourceData, m interface{}) error {
 		payload["details-level"] = "full"
 	}
 
-	showTaskRes, err := client.ApiCall("show-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTaskRes, err := apiCall(client, "show-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
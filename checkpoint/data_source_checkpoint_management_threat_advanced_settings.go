@@ -94,7 +94,7 @@ func dataSourceManagementThreatAdvancedSettingsRead(d *schema.ResourceData, m in
 
 	payload := make(map[string]interface{})
 
-	showThreatAdvancedSettingsRes, err := client.ApiCall("show-threat-advanced-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatAdvancedSettingsRes, err := apiCall(client, "show-threat-advanced-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -175,7 +175,7 @@ func dataSourceManagementThreatExceptionRead(d *schema.ResourceData, m interface
 		payload["rule-name"] = v.(string)
 	}
 
-	showThreatRuleRes, err := client.ApiCall("show-threat-exception", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatRuleRes, err := apiCall(client, "show-threat-exception", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
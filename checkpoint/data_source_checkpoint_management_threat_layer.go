@@ -67,7 +67,7 @@ func dataSourceManagementThreatLayerRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showThreatLayerRes, err := client.ApiCall("show-threat-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatLayerRes, err := apiCall(client, "show-threat-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
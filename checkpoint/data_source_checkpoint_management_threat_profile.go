@@ -443,7 +443,7 @@ func dataSourceManagementThreatProfileRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showThreatProfileRes, err := client.ApiCall("show-threat-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatProfileRes, err := apiCall(client, "show-threat-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -434,7 +434,7 @@ func dataSourceManagementThreatRuleBaseRead(d *schema.ResourceData, m interface{
 	if v, ok := d.GetOk("show_membership"); ok {
 		payload["show-membership"] = v.(bool)
 	}
-	showRuleBaseRes, err := client.ApiCall("show-threat-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRuleBaseRes, err := apiCall(client, "show-threat-rulebase", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -26,7 +26,7 @@ func dataSourceManagementSetTrustedCaSettingsRead(d *schema.ResourceData, m inte
 
 	payload := make(map[string]interface{})
 
-	showTrustedCaSettingsRes, err := client.ApiCall("show-trusted-ca-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTrustedCaSettingsRes, err := apiCall(client, "show-trusted-ca-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
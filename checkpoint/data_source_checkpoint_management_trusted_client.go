@@ -121,7 +121,7 @@ func dataSourceManagementTrustedClientRead(d *schema.ResourceData, m interface{}
 		payload["uid"] = uid
 	}
 
-	showTrustedClientRes, err := client.ApiCall("show-trusted-client", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTrustedClientRes, err := apiCall(client, "show-trusted-client", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
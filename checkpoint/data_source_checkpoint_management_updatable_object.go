@@ -128,7 +128,7 @@ func dataSourceManagementShowUpdatableObjectRead(d *schema.ResourceData, m inter
 	} else if uid != "" {
 		payload["uid"] = uid
 	}
-	showUpdatableObjectRes, err := client.ApiCall("show-updatable-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showUpdatableObjectRes, err := apiCall(client, "show-updatable-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
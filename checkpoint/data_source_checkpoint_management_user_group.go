@@ -70,7 +70,7 @@ func dataSourceManagementUserGroupRead(d *schema.ResourceData, m interface{}) er
 		payload["uid"] = uid
 	}
 
-	showUserGroupRes, err := client.ApiCall("show-user-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showUserGroupRes, err := apiCall(client, "show-user-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -155,7 +155,7 @@ func dataSourceManagementUserTemplateRead(d *schema.ResourceData, m interface{})
 		payload["uid"] = uid
 	}
 
-	showUserTemplateRes, err := client.ApiCall("show-user-template", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showUserTemplateRes, err := apiCall(client, "show-user-template", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -96,7 +96,7 @@ func dataSourceManagementVoipDomainH323GatekeeperRead(d *schema.ResourceData, m
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showVoipDomainH323GatekeeperRes, err := client.ApiCall("show-voip-domain-h323-gatekeeper", payload, client.GetSessionID(), true, false)
+	showVoipDomainH323GatekeeperRes, err := apiCall(client, "show-voip-domain-h323-gatekeeper", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
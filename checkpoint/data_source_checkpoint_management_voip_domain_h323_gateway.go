@@ -91,7 +91,7 @@ func dataSourceManagementVoipDomainH323GatewayRead(d *schema.ResourceData, m int
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showVoipDomainH323GatewayRes, err := client.ApiCall("show-voip-domain-h323-gateway", payload, client.GetSessionID(), true, false)
+	showVoipDomainH323GatewayRes, err := apiCall(client, "show-voip-domain-h323-gateway", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -72,7 +72,7 @@ func dataSourceManagementVoipDomainMgcpCallAgentRead(d *schema.ResourceData, m i
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showVoipDomainMgcpCallAgentRes, err := client.ApiCall("show-voip-domain-mgcp-call-agent", payload, client.GetSessionID(), true, false)
+	showVoipDomainMgcpCallAgentRes, err := apiCall(client, "show-voip-domain-mgcp-call-agent", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -72,7 +72,7 @@ func dataSourceManagementVoipDomainSccpCallManagerRead(d *schema.ResourceData, m
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showVoipDomainSccpCallManagerRes, err := client.ApiCall("show-voip-domain-sccp-call-manager", payload, client.GetSessionID(), true, false)
+	showVoipDomainSccpCallManagerRes, err := apiCall(client, "show-voip-domain-sccp-call-manager", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
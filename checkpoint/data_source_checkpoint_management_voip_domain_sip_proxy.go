@@ -72,7 +72,7 @@ func dataSourceManagementVoipDomainSipProxyRead(d *schema.ResourceData, m interf
 		return fmt.Errorf("Either name or uid must be specified")
 	}
 
-	showVoipDomainSipProxyRes, err := client.ApiCall("show-voip-domain-sip-proxy", payload, client.GetSessionID(), true, false)
+	showVoipDomainSipProxyRes, err := apiCall(client, "show-voip-domain-sip-proxy", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -551,7 +551,7 @@ func dataSourceManagementVpnCommunityMeshedRead(d *schema.ResourceData, m interf
 		payload["uid"] = uid
 	}
 
-	showVpnCommunityMeshedRes, err := client.ApiCall("show-vpn-community-meshed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVpnCommunityMeshedRes, err := apiCall(client, "show-vpn-community-meshed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -93,7 +93,7 @@ func dataSourceManagementVpnCommunityRemoteAccessRead(d *schema.ResourceData, m
 		payload["uid"] = uid
 	}
 
-	showVpnCommunityRemoteAccessRes, err := client.ApiCall("show-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVpnCommunityRemoteAccessRes, err := apiCall(client, "show-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
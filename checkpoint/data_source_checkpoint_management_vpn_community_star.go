@@ -650,7 +650,7 @@ func dataSourceManagementVpnCommunityStarRead(d *schema.ResourceData, m interfac
 		payload["uid"] = uid
 	}
 
-	showVpnCommunityStarRes, err := client.ApiCall("show-vpn-community-star", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVpnCommunityStarRes, err := apiCall(client, "show-vpn-community-star", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
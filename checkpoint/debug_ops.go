@@ -2,9 +2,11 @@ package checkpoint
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -115,39 +117,191 @@ func debugLogOperation(
 		ResourceType:   resourceType,
 		Operation:      operation,
 		ApiCall:        apiCall,
-		RequestPayload: safeCopyMap(request),
-		ResponseData:   safeCopyMap(response),
+		RequestPayload: redactMap(request),
+		ResponseData:   redactMap(response),
 		Success:        success,
 		ErrorMsg:       errMsg,
 		Classification: classifyError(success, errMsg, response),
 	}
 
+	line, err := json.Marshal(&rec)
+	if err != nil {
+		return
+	}
+
+	debugFileMu.Lock()
+	defer debugFileMu.Unlock()
+
 	dir := debugDir()
-	_ = os.MkdirAll(dir, 0o755)
-	path := filepath.Join(dir, "terraform-gw-requests.jsonl")
+	_ = os.MkdirAll(dir, 0o700)
+	path := filepath.Join(dir, debugFileName)
+	rotateDebugFile(path, int64(len(line)+1))
 
-	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
 	if err != nil {
 		// never break Terraform because debug logging failed
 		return
 	}
 	defer f.Close()
+	// files created by earlier versions were readable by all users
+	_ = f.Chmod(0o600)
+
+	_, _ = f.Write(append(line, '\n'))
+}
+
+const (
+	debugFileName        = "terraform-gw-requests.jsonl"
+	defaultDebugMaxSize  = 10 // MB
+	defaultDebugMaxFiles = 3
+	redactedValue        = "<redacted>"
+)
+
+var debugFileMu sync.Mutex
+
+// rotateDebugFile renames the debug file to <file>.1, <file>.1 to <file>.2 and so on, when
+// writing size more bytes would pass TF_CP_DEBUG_MAX_SIZE (MB). TF_CP_DEBUG_MAX_FILES rotated
+// files are kept.
+func rotateDebugFile(path string, size int64) {
+	maxSize := debugEnvInt("TF_CP_DEBUG_MAX_SIZE", defaultDebugMaxSize) * 1024 * 1024
+	info, err := os.Stat(path)
+	if err != nil || maxSize <= 0 || info.Size()+size <= int64(maxSize) {
+		return
+	}
+	maxFiles := debugEnvInt("TF_CP_DEBUG_MAX_FILES", defaultDebugMaxFiles)
+	if maxFiles < 1 {
+		_ = os.Remove(path)
+		return
+	}
+	_ = os.Remove(fmt.Sprintf("%s.%d", path, maxFiles))
+	for i := maxFiles - 1; i >= 1; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", path, i), fmt.Sprintf("%s.%d", path, i+1))
+	}
+	_ = os.Rename(path, path+".1")
+}
 
-	enc := json.NewEncoder(f)
-	_ = enc.Encode(&rec)
+func debugEnvInt(name string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(name)); err == nil {
+		return v
+	}
+	return def
 }
 
-func safeCopyMap(in map[string]interface{}) map[string]interface{} {
+// Keys which are always redacted, and key fragments which mark a key as secret.
+var (
+	redactedKeys = map[string]bool{
+		"sid":   true,
+		"token": true,
+	}
+	redactedKeyFragments = []string{"password", "secret", "passphrase", "api_key", "private_key", "priv_key", "auth_key", "psk"}
+)
+
+var (
+	sensitiveKeysMu sync.RWMutex
+	sensitiveKeys   = make(map[string]bool)
+)
+
+// registerSensitiveKeys adds the fields the provider resources and data sources declare as
+// Sensitive to the keys redacted from the debug log.
+func registerSensitiveKeys(provider *schema.Provider) {
+	keys := make(map[string]bool)
+	for _, r := range provider.ResourcesMap {
+		collectSensitiveKeys(r.Schema, keys)
+	}
+	for _, r := range provider.DataSourcesMap {
+		collectSensitiveKeys(r.Schema, keys)
+	}
+
+	sensitiveKeysMu.Lock()
+	defer sensitiveKeysMu.Unlock()
+	for k := range keys {
+		sensitiveKeys[k] = true
+	}
+}
+
+func collectSensitiveKeys(s map[string]*schema.Schema, keys map[string]bool) {
+	for name, field := range s {
+		if field.Sensitive {
+			keys[normalizeDebugKey(name)] = true
+		}
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			collectSensitiveKeys(elem.Schema, keys)
+		}
+	}
+}
+
+// API payloads use dashes where the schema uses underscores.
+func normalizeDebugKey(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+}
+
+// isRedactedKey reports whether the value of the key is hidden in the debug log: Sensitive schema
+// fields, keys listed in TF_CP_DEBUG_REDACT_KEYS (separated by commas) and built-in secret keys.
+// Keys which only look like secrets, e.g. password-expiration-days, are hidden for text values only.
+func isRedactedKey(key string, value interface{}) bool {
+	key = normalizeDebugKey(key)
+	for _, k := range strings.Split(os.Getenv("TF_CP_DEBUG_REDACT_KEYS"), ",") {
+		if k = strings.TrimSpace(k); k != "" && normalizeDebugKey(k) == key {
+			return true
+		}
+	}
+
+	sensitiveKeysMu.RLock()
+	sensitive := sensitiveKeys[key]
+	sensitiveKeysMu.RUnlock()
+	if sensitive {
+		return true
+	}
+
+	if _, ok := value.(string); !ok {
+		return false
+	}
+	if redactedKeys[key] {
+		return true
+	}
+	for _, fragment := range redactedKeyFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactMap returns a deep copy of the map with the values of secret keys replaced.
+func redactMap(in map[string]interface{}) map[string]interface{} {
 	if in == nil {
 		return nil
 	}
 	out := make(map[string]interface{}, len(in))
 	for k, v := range in {
-		out[k] = v
+		if v != nil && isRedactedKey(k, v) {
+			out[k] = redactedValue
+			continue
+		}
+		out[k] = redactValue(v)
 	}
 	return out
 }
 
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		return redactMap(value)
+	case []interface{}:
+		out := make([]interface{}, len(value))
+		for i, item := range value {
+			out[i] = redactValue(item)
+		}
+		return out
+	case []map[string]interface{}:
+		out := make([]interface{}, len(value))
+		for i, item := range value {
+			out[i] = redactMap(item)
+		}
+		return out
+	}
+	return v
+}
+
 // Heuristic classifier: gateway_issue / provider_bug / schema_or_user_misuse / ok
 func classifyError(success bool, errMsg string, resp map[string]interface{}) string {
 	if success {
@@ -0,0 +1,110 @@
+package checkpoint
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRedactMap(t *testing.T) {
+	registerSensitiveKeys(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"test": {
+				Schema: map[string]*schema.Schema{
+					"radius": {
+						Type: schema.TypeList,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"shared_key": {Type: schema.TypeString, Sensitive: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	t.Setenv("TF_CP_DEBUG_REDACT_KEYS", "community, snmp-key")
+
+	payload := map[string]interface{}{
+		"name":                "admin1",
+		"password":            "secret1",
+		"min-password-length": 8,
+		"servers": []interface{}{
+			map[string]interface{}{"name": "radius1", "shared-key": "secret2"},
+		},
+		"snmp": map[string]interface{}{"community": "secret3", "snmp_key": "secret4"},
+	}
+	redacted := redactMap(payload)
+
+	if redacted["name"] != "admin1" || redacted["min-password-length"] != 8 {
+		t.Errorf("expected non secret values to be kept, got %v", redacted)
+	}
+	if redacted["password"] != redactedValue {
+		t.Errorf("expected password to be redacted, got %v", redacted["password"])
+	}
+	server := redacted["servers"].([]interface{})[0].(map[string]interface{})
+	if server["shared-key"] != redactedValue || server["name"] != "radius1" {
+		t.Errorf("expected sensitive schema field to be redacted, got %v", server)
+	}
+	snmp := redacted["snmp"].(map[string]interface{})
+	if snmp["community"] != redactedValue || snmp["snmp_key"] != redactedValue {
+		t.Errorf("expected configured keys to be redacted, got %v", snmp)
+	}
+	if payload["password"] != "secret1" {
+		t.Errorf("expected the request payload not to change")
+	}
+}
+
+func TestDebugLogOperation(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TF_CP_DEBUG_DIR", dir)
+	t.Setenv("TF_CP_DEBUG_MAX_SIZE", "1")
+	t.Setenv("TF_CP_DEBUG_MAX_FILES", "2")
+
+	payload := map[string]interface{}{"name": "admin1", "password": "secret1", "padding": strings.Repeat("x", 300*1024)}
+	for i := 0; i < 8; i++ {
+		debugLogOperation("administrator", "create", "add-administrator", payload, nil, true, "")
+	}
+
+	path := filepath.Join(dir, debugFileName)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("debug file was not written: %s", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("expected debug file mode 0600, got %o", info.Mode().Perm())
+	}
+	if info.Size() > 1024*1024 {
+		t.Errorf("expected debug file to be rotated, size is %d", info.Size())
+	}
+	for _, rotated := range []string{path + ".1", path + ".2"} {
+		if _, err := os.Stat(rotated); err != nil {
+			t.Errorf("expected rotated file %s: %s", rotated, err)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); err == nil {
+		t.Errorf("expected only 2 rotated files to be kept")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var rec debugRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("invalid record: %s", err)
+		}
+		if rec.RequestPayload["password"] != redactedValue {
+			t.Errorf("expected password to be redacted, got %v", rec.RequestPayload["password"])
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package checkpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/sessionstore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// managementLogin holds the provider settings used to open Management API sessions.
+type managementLogin struct {
+	args               checkpoint.ApiClientArgs
+	sessionFileName    string
+	username           string
+	password           string
+	apiKey             string
+	sessionName        string
+	sessionDescription string
+	sessionTimeout     int
+	retry              retryPolicy
+	objectsBatchSize   int
+	gaia               *gaiaConnection
+	trust              servercert.Trust
+	staleSessions      string // "takeover" or "discard" open sessions of earlier runs
+	staleSessionMinAge int
+}
+
+// open returns a client of a session in the given domain. A session saved in the session file is
+// continued when it's still valid. Otherwise the provider logs in to the domain, or when an MDS session
+// is given, opens the domain session from it with login-to-domain.
+func (c managementLogin) open(domain string, mds *checkpoint.ApiClient) (*checkpoint.ApiClient, error) {
+	sessions := sessionstore.New(c.sessionFileName)
+	sessionKey := sessionstore.NewKey(c.args.Server, domain, c.username, c.apiKey, c.args.CloudMgmtId)
+	s, err := sessions.Get(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	mgmt := c.client(s.Sid)
+	if ok := CheckSession(mgmt, s.Uid); !ok {
+		// session is not valid, need to perform login
+		loggedIn := false
+		if mds != nil {
+			if s, err = loginToDomain(mds, domain); err == nil {
+				mgmt = c.client(s.Sid)
+				loggedIn = true
+			} else {
+				log.Printf("Failed to login to domain %s from the MDS session, perform login: %s", domain, err)
+			}
+		}
+		if !loggedIn {
+			s, err = login(mgmt, c.username, c.password, c.apiKey, domain, c.sessionName, c.sessionDescription, c.sessionTimeout)
+			if err != nil {
+				log.Println("Failed to perform login")
+				return nil, err
+			}
+		}
+		if err := sessions.Put(sessionKey, s); err != nil {
+			return nil, err
+		}
+	}
+	if c.staleSessions != "" {
+		if err := handleStaleSessions(mgmt, c.staleSessions, s.Uid, c.sessionName, c.staleSessionMinAge); err != nil {
+			return nil, err
+		}
+	}
+	if domain != "" {
+		log.Printf("Check Point provider connected to domain [%s] with session uid [%s]", domain, s.Uid)
+	} else {
+		log.Printf("Check Point provider connected with session uid [%s]", s.Uid)
+	}
+	return mgmt, nil
+}
+
+// client returns a client of the given session id.
+func (c managementLogin) client(sid string) *checkpoint.ApiClient {
+	args := c.args
+	args.Sid = sid
+	mgmt := newApiClient(args, c.trust)
+	setRetryPolicy(mgmt, c.retry)
+	if c.objectsBatchSize > 0 {
+		setObjectBatcher(mgmt, newObjectBatcher(c.objectsBatchSize))
+	}
+	setClientConnection(mgmt, clientConnection{args: args, gaia: c.gaia, trust: c.trust})
+	return mgmt
+}
+
+// loginToDomain opens a session in the domain from a session of the Multi-Domain Server.
+func loginToDomain(mds *checkpoint.ApiClient, domain string) (Session, error) {
+	log.Printf("Perform login to domain %s", domain)
+	res, err := apiCall(mds, "login-to-domain", map[string]interface{}{"domain": domain}, mds.GetSessionID(), false, mds.IsProxyUsed())
+	if err != nil {
+		return Session{}, err
+	}
+	if !res.Success {
+		return Session{}, fmt.Errorf("%s", res.ErrorMsg)
+	}
+	sid, _ := res.GetData()["sid"].(string)
+	uid, _ := res.GetData()["uid"].(string)
+	if sid == "" {
+		return Session{}, fmt.Errorf("login-to-domain didn't return a session id")
+	}
+	return Session{Sid: sid, Uid: uid}, nil
+}
+
+// domainSessions runs management resources and data sources which set "domain" with a session in
+// that domain. Sessions are opened on first use and kept for the rest of the run, so a single
+// provider can manage objects in many domains of a Multi-Domain Server.
+type domainSessions struct {
+	mu         sync.Mutex
+	configured bool
+	login      managementLogin
+	domain     string // domain of the provider session
+	clients    map[string]*checkpoint.ApiClient
+}
+
+func (s *domainSessions) configure(login managementLogin, domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.login = login
+	s.domain = domain
+	s.clients = make(map[string]*checkpoint.ApiClient)
+	s.configured = true
+}
+
+// clientFor returns the client of the domain session. The provider client (meta) is used when no
+// domain is set or the domain is the provider domain.
+func (s *domainSessions) clientFor(meta interface{}, domain string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.configured || domain == "" || strings.EqualFold(domain, s.domain) {
+		return meta, nil
+	}
+	if client, ok := s.clients[domain]; ok {
+		return client, nil
+	}
+	// Sessions of the MDS can open sessions in the domains without logging in again.
+	var mds *checkpoint.ApiClient
+	if s.domain == "" {
+		mds, _ = meta.(*checkpoint.ApiClient)
+	}
+	client, err := s.login.open(domain, mds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session in domain %s: %s", domain, err)
+	}
+	s.clients[domain] = client
+	return client, nil
+}
+
+// Number of ";" separated parts of the import ids of resources whose import id isn't the object uid.
+var importIdParts = map[string]int{
+	"checkpoint_management_access_rule":      2,
+	"checkpoint_management_access_section":   2,
+	"checkpoint_management_nat_rule":         2,
+	"checkpoint_management_threat_rule":      2,
+	"checkpoint_management_threat_exception": 3,
+}
+
+// route adds the "domain" argument to the management resources and data sources. Resources and
+// data sources which already have a "domain" field keep it as is.
+func (s *domainSessions) route(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		if strings.HasPrefix(name, "checkpoint_management_") && r.Schema["domain"] == nil {
+			r.Schema["domain"] = &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Domain to manage the object in. Default is the provider domain.",
+			}
+			s.routeResource(r)
+			s.routeImporter(r, name)
+		}
+	}
+	for name, r := range provider.DataSourcesMap {
+		if strings.HasPrefix(name, "checkpoint_management_") && r.Schema["domain"] == nil {
+			r.Schema["domain"] = &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Domain to read the object from. Default is the provider domain.",
+			}
+			s.routeResource(r)
+		}
+	}
+}
+
+func (s *domainSessions) routeResource(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, m interface{}) error {
+			client, err := s.clientFor(m, d.Get("domain").(string))
+			if err != nil {
+				return err
+			}
+			return f(d, client)
+		}
+	}
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
+
+// routeImporter makes the resource import "<domain>;<id>" in the domain, where <id> is the import id
+// of the resource in the provider domain.
+func (s *domainSessions) routeImporter(r *schema.Resource, name string) {
+	if r.Importer == nil || r.Importer.State == nil {
+		return
+	}
+	parts := importIdParts[name]
+	if parts == 0 {
+		parts = 1
+	}
+	state := r.Importer.State
+	r.Importer.State = func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if id := strings.Split(d.Id(), ";"); len(id) == parts+1 {
+			_ = d.Set("domain", id[0])
+			d.SetId(strings.Join(id[1:], ";"))
+		}
+		client, err := s.clientFor(m, d.Get("domain").(string))
+		if err != nil {
+			return nil, err
+		}
+		return state(d, client)
+	}
+}
@@ -0,0 +1,106 @@
+package checkpoint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainSessionsRoute(t *testing.T) {
+	provider := Provider()
+	host := provider.ResourcesMap["checkpoint_management_host"].Schema["domain"]
+	if host == nil || !host.Optional || !host.ForceNew {
+		t.Fatalf("expected optional domain argument which forces a new host, got %+v", host)
+	}
+	if provider.DataSourcesMap["checkpoint_management_data_host"].Schema["domain"] == nil {
+		t.Fatalf("expected domain argument on the host data source")
+	}
+	if login := provider.ResourcesMap["checkpoint_management_command_login_to_domain"].Schema["domain"]; !login.Required {
+		t.Fatalf("existing domain argument of login-to-domain should be kept")
+	}
+	if provider.ResourcesMap["checkpoint_hostname"].Schema["domain"] != nil {
+		t.Fatalf("gaia resources should not have a domain argument")
+	}
+}
+
+func TestDomainSessionsLoginToDomain(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{"publish_when_idle": true})
+
+	state := testFakeApply(t, provider, "checkpoint_management_host", nil, map[string]interface{}{
+		"name":         "h1",
+		"ipv4_address": "10.0.0.1",
+		"domain":       "domain1",
+	})
+	testFakeApply(t, provider, "checkpoint_management_host", nil, map[string]interface{}{
+		"name":         "h2",
+		"ipv4_address": "10.0.0.2",
+	})
+
+	mds := strings.Join(server.domainCommands(""), ",")
+	if !strings.Contains(mds, "login-to-domain") || strings.Count(mds, "add-host") != 1 {
+		t.Errorf("expected the MDS session to login to domain and add one host, got %s", mds)
+	}
+	domain1 := strings.Join(server.domainCommands("domain1"), ",")
+	if !strings.Contains(domain1, "add-host") || !strings.Contains(domain1, "publish") {
+		t.Errorf("expected the host to be added and published in the domain session, got %s", domain1)
+	}
+
+	testFakeApply(t, provider, "checkpoint_management_host", state, nil)
+	if commands := strings.Join(server.domainCommands("domain1"), ","); !strings.HasSuffix(commands, "delete-host,publish,show-task") {
+		t.Errorf("expected the host to be deleted and published in the domain session, got %s", commands)
+	}
+	if strings.Count(strings.Join(server.commands(), ","), "login-to-domain") != 1 {
+		t.Errorf("expected the domain session to be reused")
+	}
+}
+
+func TestDomainSessionsLogin(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{"domain": "domain1"})
+
+	testFakeApply(t, provider, "checkpoint_management_host", nil, map[string]interface{}{
+		"name":         "h1",
+		"ipv4_address": "10.0.0.1",
+		"domain":       "domain2",
+	})
+	if commands := server.domainCommands("domain2"); len(commands) == 0 || commands[0] == "login-to-domain" {
+		t.Errorf("expected a login to domain2, got %v", commands)
+	}
+	if strings.Count(strings.Join(server.commands(), ","), "login") != 2 {
+		t.Errorf("expected a login to each domain, got %v", server.commands())
+	}
+}
+
+func TestDomainSessionsImport(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+
+	host := provider.ResourcesMap["checkpoint_management_host"]
+	d := host.TestResourceData()
+	d.SetId("domain1;host-uid")
+	if _, err := host.Importer.State(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "host-uid" || d.Get("domain") != "domain1" {
+		t.Errorf("expected host-uid in domain1, got %q in %q", d.Id(), d.Get("domain"))
+	}
+	if !strings.Contains(strings.Join(server.commands(), ","), "login-to-domain") {
+		t.Errorf("expected the import to login to domain1, got %v", server.commands())
+	}
+
+	rule := provider.ResourcesMap["checkpoint_management_access_rule"]
+	d = rule.TestResourceData()
+	d.SetId("domain1;Network;rule-uid")
+	if _, err := rule.Importer.State(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "rule-uid" || d.Get("layer") != "Network" || d.Get("domain") != "domain1" {
+		t.Errorf("expected rule-uid of Network in domain1, got %q of %q in %q", d.Id(), d.Get("layer"), d.Get("domain"))
+	}
+
+	d = rule.TestResourceData()
+	d.SetId("Network;rule-uid")
+	if _, err := rule.Importer.State(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "rule-uid" || d.Get("layer") != "Network" || d.Get("domain") != "" {
+		t.Errorf("expected rule-uid of Network in the provider domain, got %q of %q in %q", d.Id(), d.Get("layer"), d.Get("domain"))
+	}
+}
@@ -0,0 +1,1012 @@
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	fakeServerUsername   = "admin"
+	fakeServerPassword   = "password"
+	fakeServerApiKey     = "api-key"
+	fakeServerApiVersion = "1.9"
+)
+
+// Object fields which reference other objects. The fake server stores them as object references,
+// like the management server returns them.
+var fakeServerReferenceFields = map[string]bool{
+	"groups":  true,
+	"members": true,
+	"tags":    true,
+}
+
+// fakeServer is an in-memory Check Point Management and Gaia API server for offline tests. It
+// speaks the web_api login/show/add/set/delete/publish/discard protocol for any object type and
+// the gaia_api show/set protocol. Sessions can be opened in domains with login or login-to-domain,
+// all domains share the same objects.
+type fakeServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	nextId    int
+	sessions  map[string]string // sid to session uid
+	domains   map[string]string // sid to session domain
+	objects   map[string]map[string]interface{}
+	published map[string]map[string]interface{}
+	tasks     map[string]map[string]interface{}
+	gaia      map[string]map[string]interface{}
+	results   map[string][]interface{}          // task details of commands which run a task, e.g. compliance-scan
+	files     map[string][]byte                 // attachment id to the file get-attachment returns
+	version   string                            // current-version of show-api-versions
+	details   map[string]map[string]interface{} // session uid to its show-sessions object
+	changes   map[string][]interface{}          // revision uid to the objects show-changes returns as modified
+	versions  map[string]bool                   // versions in the URLs of the calls
+	failures  map[string]string                 // command to the error message it fails with
+	calls     []string
+	inDomains []string // "<domain> <command>" of the calls with a session
+}
+
+func newFakeServer() *fakeServer {
+	s := &fakeServer{
+		sessions:  make(map[string]string),
+		domains:   make(map[string]string),
+		objects:   make(map[string]map[string]interface{}),
+		published: make(map[string]map[string]interface{}),
+		tasks:     make(map[string]map[string]interface{}),
+		gaia:      make(map[string]map[string]interface{}),
+		results:   make(map[string][]interface{}),
+		files:     make(map[string][]byte),
+		version:   "1.9",
+		details:   make(map[string]map[string]interface{}),
+		changes:   make(map[string][]interface{}),
+		versions:  make(map[string]bool),
+		failures:  make(map[string]string),
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// host and port of the server, as the provider expects them.
+func (s *fakeServer) hostPort() (string, int) {
+	host, port, _ := net.SplitHostPort(s.Listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p
+}
+
+// providerConfig returns the provider configuration to connect to the server.
+func (s *fakeServer) providerConfig(context string, sessionFileName string) map[string]interface{} {
+	host, port := s.hostPort()
+	return map[string]interface{}{
+		"server":                    host,
+		"port":                      port,
+		"username":                  fakeServerUsername,
+		"password":                  fakeServerPassword,
+		"context":                   context,
+		"session_file_name":         sessionFileName,
+		"ignore_server_certificate": true,
+		"max_retries":               0,
+	}
+}
+
+// fail makes the command fail with the message.
+func (s *fakeServer) fail(command string, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[command] = message
+}
+
+func (s *fakeServer) commands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.calls...)
+}
+
+// domainCommands returns the commands which were sent in sessions of the domain.
+func (s *fakeServer) domainCommands(domain string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var commands []string
+	for _, call := range s.inDomains {
+		if strings.HasPrefix(call, domain+" ") {
+			commands = append(commands, strings.TrimPrefix(call, domain+" "))
+		}
+	}
+	return commands
+}
+
+func (s *fakeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// /<context>[/v<version>]/<command>
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 {
+		writeFakeError(w, http.StatusNotFound, "generic_err_command_not_found", "Unknown command")
+		return
+	}
+	context, command := parts[0], parts[len(parts)-1]
+
+	payload := make(map[string]interface{})
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, command)
+	if len(parts) > 2 {
+		s.versions[strings.TrimPrefix(parts[1], "v")] = true
+	}
+
+	if command == "login" {
+		s.login(w, payload, context)
+		return
+	}
+	sid := r.Header.Get("X-chkp-sid")
+	if _, ok := s.sessions[sid]; !ok {
+		writeFakeError(w, http.StatusUnauthorized, "generic_err_wrong_session_id", "Wrong session id")
+		return
+	}
+	s.inDomains = append(s.inDomains, s.domains[sid]+" "+command)
+	if message, ok := s.failures[command]; ok {
+		writeFakeError(w, http.StatusBadRequest, "generic_error", message)
+		return
+	}
+	if command == "login-to-domain" {
+		writeFakeResponse(w, http.StatusOK, s.newSession(fmt.Sprint(payload["domain"]), context))
+		return
+	}
+	if command == "switch-session" {
+		uid := fmt.Sprint(payload["uid"])
+		if _, ok := s.details[uid]; !ok {
+			writeFakeError(w, http.StatusNotFound, "generic_err_object_not_found", "Requested object ["+uid+"] not found")
+			return
+		}
+		s.sessions[sid] = uid
+		writeFakeResponse(w, http.StatusOK, s.details[uid])
+		return
+	}
+	if command == "show-session" && payload["uid"] == nil {
+		// the current session
+		if info, ok := s.details[s.sessions[sid]]; ok {
+			writeFakeResponse(w, http.StatusOK, info)
+			return
+		}
+		writeFakeResponse(w, http.StatusOK, map[string]interface{}{"uid": s.sessions[sid], "type": "session", "state": "open"})
+		return
+	}
+	if command == "get-attachment" {
+		file, ok := s.files[fmt.Sprint(payload["attachment-id"])]
+		if !ok {
+			writeFakeError(w, http.StatusNotFound, "generic_err_object_not_found", "Requested attachment not found")
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(file)
+		return
+	}
+
+	status, res := http.StatusOK, map[string]interface{}(nil)
+	switch context {
+	case "web_api":
+		status, res = s.webCommand(command, payload)
+	case "gaia_api":
+		status, res = s.gaiaCommand(command, payload)
+	default:
+		status, res = fakeError(http.StatusNotFound, "generic_err_command_not_found", "Unknown context "+context)
+	}
+	writeFakeResponse(w, status, res)
+}
+
+func (s *fakeServer) login(w http.ResponseWriter, payload map[string]interface{}, context string) {
+	if (payload["user"] != fakeServerUsername || payload["password"] != fakeServerPassword) && payload["api-key"] != fakeServerApiKey {
+		writeFakeError(w, http.StatusBadRequest, "err_login_failed", "Authentication to server failed.")
+		return
+	}
+	domain, _ := payload["domain"].(string)
+	session := s.newSession(domain, context)
+	name, _ := payload["session-name"].(string)
+	s.addSessionInfo(session["uid"].(string), name, fakeServerUsername, time.Now())
+	writeFakeResponse(w, http.StatusOK, session)
+}
+
+// addSessionInfo adds an open session to show-sessions.
+func (s *fakeServer) addSessionInfo(uid string, name string, user string, loginTime time.Time) {
+	s.details[uid] = map[string]interface{}{
+		"uid":         uid,
+		"type":        "session",
+		"name":        name,
+		"user-name":   user,
+		"application": "WEB_API",
+		"state":       "open",
+		"changes":     0,
+		"locks":       0,
+		"last-login-time": map[string]interface{}{
+			"posix":    loginTime.UnixMilli(),
+			"iso-8601": loginTime.Format("2006-01-02T15:04-0700"),
+		},
+	}
+}
+
+// addRevision adds a published session to show-sessions.
+func (s *fakeServer) addRevision(uid string, user string, publishTime time.Time) {
+	s.addSessionInfo(uid, "revision", user, publishTime)
+	s.details[uid]["state"] = "published"
+	s.details[uid]["publish-time"] = map[string]interface{}{
+		"posix":    publishTime.UnixMilli(),
+		"iso-8601": publishTime.Format("2006-01-02T15:04-0700"),
+	}
+}
+
+// installPolicy installs the policy package on the simple gateways of the targets, which then report
+// it as their installed policy in show-gateways-and-servers.
+func (s *fakeServer) installPolicy(payload map[string]interface{}) (int, map[string]interface{}) {
+	now := time.Now()
+	details := make([]interface{}, 0)
+	failed := 0
+	targets, _ := payload["targets"].([]interface{})
+	for _, target := range targets {
+		gateway := s.find("simple-gateway", map[string]interface{}{"uid": target})
+		if gateway == nil {
+			gateway = s.find("simple-gateway", map[string]interface{}{"name": target})
+		}
+		if gateway == nil {
+			failed++
+			details = append(details, map[string]interface{}{"gatewayName": target, "statusCode": "FAILED", "statusDescription": "Target not found"})
+			continue
+		}
+		gateway["policy"] = map[string]interface{}{
+			"access-policy-installed": true,
+			"access-policy-name":      payload["policy-package"],
+			"access-policy-installation-date": map[string]interface{}{
+				"posix":    now.UnixMilli(),
+				"iso-8601": now.Format("2006-01-02T15:04-0700"),
+			},
+		}
+		details = append(details, map[string]interface{}{"gatewayName": gateway["name"], "gatewayId": gateway["uid"], "statusCode": "SUCCEEDED"})
+	}
+	switch {
+	case failed == 0:
+		return s.newTask("install-policy", details)
+	case failed == len(targets):
+		return s.newTask("install-policy", details, "failed")
+	}
+	return s.newTask("install-policy", details, "partially succeeded")
+}
+
+func (s *fakeServer) newSession(domain string, context string) map[string]interface{} {
+	sid, uid := s.newId(), s.newId()
+	s.sessions[sid] = uid
+	s.domains[sid] = domain
+	return map[string]interface{}{
+		"sid":                sid,
+		"uid":                uid,
+		"api-server-version": fakeServerApiVersion,
+		"session-timeout":    600,
+		"url":                "https://" + s.Listener.Addr().String() + "/" + context,
+	}
+}
+
+func (s *fakeServer) webCommand(command string, payload map[string]interface{}) (int, map[string]interface{}) {
+	switch command {
+	case "logout", "keepalive":
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	case "show-session":
+		for _, uid := range s.sessions {
+			if uid == payload["uid"] {
+				return http.StatusOK, map[string]interface{}{"uid": uid, "type": "session", "state": "open"}
+			}
+		}
+		return fakeNotFound(payload)
+	case "publish":
+		s.published = copyFakeObjects(s.objects)
+		return s.newTask(command, nil)
+	case "install-policy":
+		if _, ok := s.results[command]; !ok {
+			return s.installPolicy(payload)
+		}
+	case "show-gateways-and-servers":
+		return s.showObjects("simple-gateway", payload)
+	case "show-access-rulebase", "show-threat-rulebase":
+		return s.rulebase(strings.TrimSuffix(strings.TrimPrefix(command, "show-"), "base"), payload)
+	case "show-changes":
+		if _, ok := s.results[command]; !ok {
+			modified := make([]interface{}, 0)
+			for _, object := range s.changes[fmt.Sprint(payload["from-session"])] {
+				modified = append(modified, map[string]interface{}{"old-object": object, "new-object": object})
+			}
+			return s.newTask(command, []interface{}{map[string]interface{}{
+				"changes": []interface{}{map[string]interface{}{"operations": map[string]interface{}{
+					"added-objects": []interface{}{}, "modified-objects": modified, "deleted-objects": []interface{}{},
+				}}},
+			}})
+		}
+	case "discard":
+		if uid, ok := payload["uid"].(string); ok {
+			info, ok := s.details[uid]
+			if !ok {
+				return fakeNotFound(payload)
+			}
+			info["state"] = "discarded"
+			return http.StatusOK, map[string]interface{}{"message": "OK", "number-of-discarded-changes": info["changes"]}
+		}
+		s.objects = copyFakeObjects(s.published)
+		return http.StatusOK, map[string]interface{}{"message": "OK", "number-of-discarded-changes": 0}
+	case "show-task":
+		ids, ok := payload["task-id"].([]interface{})
+		if !ok {
+			ids = []interface{}{payload["task-id"]}
+		}
+		tasks := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			task, ok := s.tasks[fmt.Sprint(id)]
+			if !ok {
+				return fakeNotFound(payload)
+			}
+			tasks = append(tasks, task)
+		}
+		return http.StatusOK, map[string]interface{}{"tasks": tasks}
+	case "show-object":
+		object, ok := s.objects[fmt.Sprint(payload["uid"])]
+		if !ok {
+			return fakeNotFound(payload)
+		}
+		return http.StatusOK, map[string]interface{}{"object": object}
+	case "show-objects":
+		return s.showObjects(fmt.Sprint(payload["type"]), payload)
+	case "show-sessions":
+		sessions := make([]interface{}, 0)
+		for _, info := range s.details {
+			if info["state"] == "open" || payload["view-published-sessions"] == true {
+				sessions = append(sessions, info)
+			}
+		}
+		return http.StatusOK, map[string]interface{}{"objects": sessions, "from": 1, "to": len(sessions), "total": len(sessions)}
+	case "take-over-session":
+		info, ok := s.details[fmt.Sprint(payload["uid"])]
+		if !ok {
+			return fakeNotFound(payload)
+		}
+		info["user-name"] = fakeServerUsername
+		info["in-work"] = false
+		return http.StatusOK, info
+	case "show-api-versions":
+		return http.StatusOK, map[string]interface{}{
+			"current-version":    s.version,
+			"supported-versions": []interface{}{"1.6", "1.7", "1.8", "1.9"},
+		}
+	case "add-objects-batch", "set-objects-batch", "delete-objects-batch":
+		return s.objectsBatch(strings.TrimSuffix(command, "-objects-batch"), payload)
+	}
+	if details, ok := s.results[command]; ok {
+		return s.newTask(command, details)
+	}
+
+	action, objectType := splitFakeCommand(command)
+	switch action {
+	case "add":
+		return s.add(objectType, payload)
+	case "set":
+		return s.set(objectType, payload)
+	case "delete":
+		return s.delete(objectType, payload)
+	case "show":
+		if object := s.find(objectType, payload); object != nil {
+			return http.StatusOK, object
+		}
+		if plural := fakeSingular(objectType); plural != objectType {
+			return s.showObjects(plural, payload)
+		}
+		return fakeNotFound(payload)
+	}
+	return fakeError(http.StatusNotFound, "generic_err_command_not_found", "Unknown command "+command)
+}
+
+func (s *fakeServer) add(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	name, _ := payload["name"].(string)
+	if name == "" {
+		return fakeError(http.StatusBadRequest, "generic_err_missing_required_parameters", "Missing parameter: [name]")
+	}
+	if s.find(objectType, map[string]interface{}{"name": name}) != nil {
+		return fakeError(http.StatusBadRequest, "err_validation_failed", "More than one object have the same name ["+name+"]")
+	}
+	object := map[string]interface{}{
+		"uid":       s.newId(),
+		"type":      objectType,
+		"color":     "black",
+		"comments":  "",
+		"tags":      []interface{}{},
+		"read-only": false,
+		"domain":    map[string]interface{}{"uid": "41e821a0-3720-11e3-aa6e-0800200c9fde", "name": "SMC User", "domain-type": "domain"},
+	}
+	s.update(object, payload)
+	s.objects[object["uid"].(string)] = object
+	return http.StatusOK, object
+}
+
+func (s *fakeServer) set(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	object := s.find(objectType, payload)
+	if object == nil {
+		return fakeNotFound(payload)
+	}
+	if newName, ok := payload["new-name"].(string); ok {
+		if other := s.find(objectType, map[string]interface{}{"name": newName}); other != nil && other["uid"] != object["uid"] {
+			return fakeError(http.StatusBadRequest, "err_validation_failed", "More than one object have the same name ["+newName+"]")
+		}
+	}
+	s.update(object, payload)
+	return http.StatusOK, object
+}
+
+func (s *fakeServer) delete(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	object := s.find(objectType, payload)
+	if object == nil {
+		return fakeNotFound(payload)
+	}
+	delete(s.objects, object["uid"].(string))
+	return http.StatusOK, map[string]interface{}{"message": "OK"}
+}
+
+// update applies the fields of an add or set command to the object.
+func (s *fakeServer) update(object map[string]interface{}, payload map[string]interface{}) {
+	for k, v := range payload {
+		switch k {
+		case "uid", "ignore-warnings", "ignore-errors", "details-level":
+			continue
+		case "new-name":
+			object["name"] = v
+			continue
+		}
+		if fakeServerReferenceFields[k] {
+			v = s.references(object[k], v)
+		}
+		object[k] = v
+	}
+}
+
+// references resolves a list of object names, or an add/remove of names, to object references.
+func (s *fakeServer) references(current interface{}, value interface{}) interface{} {
+	refs, _ := current.([]interface{})
+	var names []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		refs, names = nil, v
+	case string:
+		refs, names = nil, []interface{}{v}
+	case map[string]interface{}:
+		remove, _ := v["remove"].([]interface{})
+		for _, name := range remove {
+			for i, ref := range refs {
+				if r := ref.(map[string]interface{}); r["name"] == name || r["uid"] == name {
+					refs = append(refs[:i], refs[i+1:]...)
+					break
+				}
+			}
+		}
+		names, _ = v["add"].([]interface{})
+	}
+	result := append([]interface{}{}, refs...)
+	for _, name := range names {
+		ref := map[string]interface{}{"name": name, "uid": name}
+		for uid, object := range s.objects {
+			if object["name"] == name || uid == name {
+				ref = map[string]interface{}{"name": object["name"], "uid": uid, "type": object["type"]}
+			}
+		}
+		result = append(result, ref)
+	}
+	return result
+}
+
+// find returns the object of the given type with the uid or name in the payload.
+func (s *fakeServer) find(objectType string, payload map[string]interface{}) map[string]interface{} {
+	if uid, ok := payload["uid"].(string); ok {
+		if object, ok := s.objects[uid]; ok && object["type"] == objectType {
+			return object
+		}
+		return nil
+	}
+	if name, ok := payload["name"].(string); ok {
+		for _, object := range s.objects {
+			if object["type"] == objectType && object["name"] == name {
+				return object
+			}
+		}
+	}
+	return nil
+}
+
+func (s *fakeServer) showObjects(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	objects := make([]map[string]interface{}, 0)
+	for _, object := range s.objects {
+		if objectType == "" || objectType == "<nil>" || objectType == "object" || object["type"] == objectType {
+			objects = append(objects, object)
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return fmt.Sprint(objects[i]["name"]) < fmt.Sprint(objects[j]["name"])
+	})
+
+	offset, limit := fakeInt(payload["offset"], 0), fakeInt(payload["limit"], 50)
+	page := make([]interface{}, 0)
+	for i := offset; i < len(objects) && i < offset+limit; i++ {
+		page = append(page, objects[i])
+	}
+	res := map[string]interface{}{"objects": page, "total": len(objects), "from": 0, "to": 0}
+	if len(page) > 0 {
+		res["from"], res["to"] = offset+1, offset+len(page)
+	}
+	return http.StatusOK, res
+}
+
+// objectsBatch runs add/set/delete-objects-batch. Like the management server, the batch is
+// rolled back when one of its objects fails.
+// rulebase returns the rules of the given type whose layer is the uid or name in the payload, in a
+// single page, with the objects of the uids the rules refer to in the objects dictionary.
+func (s *fakeServer) rulebase(ruleType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	layer := payload["uid"]
+	if layer == nil {
+		layer = payload["name"]
+	}
+	layerType := strings.TrimSuffix(ruleType, "-rule") + "-layer"
+	if s.find(layerType, payload) == nil {
+		return fakeNotFound(payload)
+	}
+	rules := make([]interface{}, 0)
+	dictionary := make([]interface{}, 0)
+	for _, object := range s.objects {
+		if object["type"] != ruleType || object["layer"] != layer {
+			continue
+		}
+		rules = append(rules, object)
+		for _, v := range object {
+			refs, _ := v.([]interface{})
+			for _, ref := range refs {
+				if referenced, ok := s.objects[fmt.Sprint(ref)]; ok {
+					dictionary = append(dictionary, map[string]interface{}{"uid": referenced["uid"], "name": referenced["name"], "type": referenced["type"]})
+				}
+			}
+		}
+	}
+	return http.StatusOK, map[string]interface{}{"rulebase": rules, "objects-dictionary": dictionary, "from": 1, "to": len(rules), "total": len(rules)}
+}
+
+func (s *fakeServer) objectsBatch(action string, payload map[string]interface{}) (int, map[string]interface{}) {
+	backup := copyFakeObjects(s.objects)
+	done := make([]interface{}, 0)
+	objects, _ := payload["objects"].([]interface{})
+	for _, o := range objects {
+		batch, _ := o.(map[string]interface{})
+		objectType, _ := batch["type"].(string)
+		list, _ := batch["list"].([]interface{})
+		for i, item := range list {
+			itemPayload, _ := item.(map[string]interface{})
+			status, res := http.StatusOK, map[string]interface{}(nil)
+			switch action {
+			case "add":
+				status, res = s.add(objectType, itemPayload)
+			case "set":
+				status, res = s.set(objectType, itemPayload)
+			case "delete":
+				if object := s.find(objectType, itemPayload); object != nil {
+					res = map[string]interface{}{"uid": object["uid"], "name": object["name"], "type": objectType}
+				}
+				status, _ = s.delete(objectType, itemPayload)
+			}
+			if status != http.StatusOK {
+				s.objects = backup
+				task := map[string]interface{}{"fault-message": fmt.Sprintf("Object %d of type %s: %s", i+1, objectType, res["message"])}
+				return s.newTask(action+"-objects-batch", []interface{}{task}, "failed")
+			}
+			done = append(done, map[string]interface{}{"uid": res["uid"], "name": res["name"], "type": objectType})
+		}
+	}
+	return s.newTask(action+"-objects-batch", []interface{}{map[string]interface{}{"objects": done}})
+}
+
+func (s *fakeServer) newTask(name string, details []interface{}, status ...string) (int, map[string]interface{}) {
+	taskStatus := "succeeded"
+	if len(status) > 0 {
+		taskStatus = status[0]
+	}
+	if details == nil {
+		details = []interface{}{}
+	}
+	id := s.newId()
+	s.tasks[id] = map[string]interface{}{
+		"task-id":             id,
+		"task-name":           name,
+		"status":              taskStatus,
+		"progress-percentage": 100,
+		"suppressed":          false,
+		"task-details":        details,
+	}
+	return http.StatusOK, map[string]interface{}{"task-id": id}
+}
+
+// gaiaCommand runs show-<x> and set-<x> commands. Settings are kept per command, and for named
+// settings (e.g. interfaces) per command and name as well.
+func (s *fakeServer) gaiaCommand(command string, payload map[string]interface{}) (int, map[string]interface{}) {
+	switch command {
+	case "logout":
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	case "show-api-versions":
+		return http.StatusOK, map[string]interface{}{
+			"current-version":    s.version,
+			"supported-versions": []interface{}{"1.6", "1.7", "1.8"},
+		}
+	}
+	action, setting := splitFakeCommand(command)
+	keys := []string{setting}
+	if name, ok := payload["name"].(string); ok {
+		keys = []string{setting + ";" + name, setting}
+	}
+	switch action {
+	case "show":
+		if config, ok := s.gaia[keys[0]]; ok {
+			return http.StatusOK, config
+		}
+		config := make(map[string]interface{})
+		for k, v := range payload {
+			config[k] = v
+		}
+		return http.StatusOK, config
+	case "set", "add":
+		var config map[string]interface{}
+		for _, key := range keys {
+			config = s.gaia[key]
+			if config == nil {
+				config = make(map[string]interface{})
+				s.gaia[key] = config
+			}
+			for k, v := range payload {
+				config[k] = v
+			}
+		}
+		return http.StatusOK, s.gaia[keys[0]]
+	case "delete":
+		for _, key := range keys {
+			delete(s.gaia, key)
+		}
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	}
+	return fakeError(http.StatusNotFound, "generic_err_command_not_found", "Unknown command "+command)
+}
+
+func (s *fakeServer) newId() string {
+	s.nextId++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", s.nextId, s.nextId)
+}
+
+// splitFakeCommand splits a command, e.g. add-address-range, to its action and object type.
+func splitFakeCommand(command string) (string, string) {
+	i := strings.Index(command, "-")
+	if i < 0 {
+		return command, ""
+	}
+	return command[:i], command[i+1:]
+}
+
+// fakeSingular returns the object type of a show-<objects> command, e.g. address-ranges or
+// services-tcp.
+func fakeSingular(objectType string) string {
+	for _, plural := range []string{"services-", "groups-"} {
+		if strings.HasPrefix(objectType, plural) {
+			return strings.TrimSuffix(plural, "s-") + "-" + strings.TrimPrefix(objectType, plural)
+		}
+	}
+	if strings.HasSuffix(objectType, "s") {
+		return strings.TrimSuffix(objectType, "s")
+	}
+	return objectType
+}
+
+func copyFakeObjects(objects map[string]map[string]interface{}) map[string]map[string]interface{} {
+	c := make(map[string]map[string]interface{}, len(objects))
+	for uid, object := range objects {
+		o := make(map[string]interface{}, len(object))
+		for k, v := range object {
+			o[k] = v
+		}
+		c[uid] = o
+	}
+	return c
+}
+
+func fakeInt(v interface{}, def int) int {
+	if n, ok := v.(float64); ok {
+		return int(n)
+	}
+	return def
+}
+
+func fakeNotFound(payload map[string]interface{}) (int, map[string]interface{}) {
+	identifier := payload["uid"]
+	if identifier == nil {
+		identifier = payload["name"]
+	}
+	return fakeError(http.StatusNotFound, "generic_err_object_not_found", fmt.Sprintf("Requested object [%v] not found", identifier))
+}
+
+func fakeError(status int, code string, message string) (int, map[string]interface{}) {
+	return status, map[string]interface{}{"code": code, "message": message}
+}
+
+func writeFakeError(w http.ResponseWriter, status int, code string, message string) {
+	status, res := fakeError(status, code, message)
+	writeFakeResponse(w, status, res)
+}
+
+func writeFakeResponse(w http.ResponseWriter, status int, res map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// testAccFakeServer points the acceptance test to a new fake server when no CHECKPOINT_SERVER is set.
+// Acceptance tests then run by default, see testAccTest.
+func testAccFakeServer(t *testing.T) {
+	if os.Getenv("CHECKPOINT_SERVER") != "" {
+		return
+	}
+	server := newFakeServer()
+	t.Cleanup(server.Close)
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		context = "web_api"
+	}
+	for k, v := range server.providerConfig(context, filepath.Join(t.TempDir(), DefaultSessionFilename)) {
+		t.Setenv("CHECKPOINT_"+strings.ToUpper(k), fmt.Sprint(v))
+	}
+	t.Setenv(testAccFakeServerEnv, "1")
+}
+
+// testAccFakeServerEnv is set while an acceptance test runs against the fake server.
+const testAccFakeServerEnv = "CHECKPOINT_TEST_FAKE_SERVER"
+
+// testAccTest runs the acceptance test case. Against the fake server, the terraform CLI is used when
+// it's available (in PATH, TF_ACC_TERRAFORM_PATH or TF_ACC_TERRAFORM_VERSION), otherwise the steps
+// are planned and applied in-process by testAccTestInProcess.
+func testAccTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+	if os.Getenv(testAccFakeServerEnv) == "" {
+		resource.Test(t, c)
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err == nil || os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		if os.Getenv(resource.EnvTfAcc) == "" {
+			t.Setenv(resource.EnvTfAcc, "1")
+		}
+		resource.Test(t, c)
+		return
+	}
+	testAccTestInProcess(t, c)
+}
+
+// testAccTestInProcess runs the steps of the test case with testAccProvider, like terraform apply
+// does: each resource of the step configuration is planned and applied, the checks of the step run
+// on the new state and a second plan must be empty. The resources are destroyed at the end and
+// CheckDestroy runs on the last state. Only steps with a Config of resources with literal values
+// are supported.
+func testAccTestInProcess(t *testing.T, c resource.TestCase) {
+	t.Helper()
+	if c.PreCheck != nil {
+		c.PreCheck()
+	}
+	if diags := testAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+
+	state := terraform.NewState()
+	resources := state.RootModule().Resources
+	defer func() {
+		for address, rs := range resources {
+			if _, diags := testFakeApplyDiags(t, testAccProvider, rs.Type, rs.Primary, nil); diags.HasError() {
+				t.Errorf("%s destroy failed: %v", address, diags)
+			}
+		}
+		if c.CheckDestroy != nil {
+			if err := c.CheckDestroy(state); err != nil {
+				t.Errorf("check destroy failed: %s", err)
+			}
+		}
+	}()
+
+	for i, step := range c.Steps {
+		configs, err := testAccParseConfig(step.Config)
+		if err != nil {
+			t.Fatalf("step %d: %s", i+1, err)
+		}
+		for address, config := range configs {
+			resourceType := strings.SplitN(address, ".", 2)[0]
+			var current *terraform.InstanceState
+			if rs, ok := resources[address]; ok {
+				current = rs.Primary
+			}
+			newState := testFakeApply(t, testAccProvider, resourceType, current, config)
+			resources[address] = &terraform.ResourceState{Type: resourceType, Provider: "provider.checkpoint", Primary: newState}
+
+			r := testAccProvider.ResourcesMap[resourceType]
+			refreshed, diags := r.RefreshWithoutUpgrade(context.Background(), newState, testAccProvider.Meta())
+			if diags.HasError() {
+				t.Fatalf("step %d: %s refresh failed: %v", i+1, address, diags)
+			}
+			diff, err := r.Diff(context.Background(), refreshed, terraform.NewResourceConfigRaw(config), testAccProvider.Meta())
+			if err != nil {
+				t.Fatalf("step %d: %s plan failed: %s", i+1, address, err)
+			}
+			if !diff.Empty() && !step.ExpectNonEmptyPlan {
+				t.Fatalf("step %d: %s plan after apply is not empty: %v", i+1, address, diff)
+			}
+		}
+		if step.Check != nil {
+			if err := step.Check(state); err != nil {
+				t.Fatalf("step %d: check failed: %s", i+1, err)
+			}
+		}
+	}
+}
+
+// testAccParseConfig returns the raw configuration of each resource of a configuration, by address.
+func testAccParseConfig(config string) (map[string]map[string]interface{}, error) {
+	file, diags := hclsyntax.ParseConfig([]byte(config), "config.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	configs := make(map[string]map[string]interface{})
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" || len(block.Labels) != 2 {
+			return nil, fmt.Errorf("%s blocks are not supported", block.Type)
+		}
+		raw, err := testAccRawBody(block.Body)
+		if err != nil {
+			return nil, err
+		}
+		configs[block.Labels[0]+"."+block.Labels[1]] = raw
+	}
+	return configs, nil
+}
+
+// testAccRawBody returns the values of the attributes of a block, nested blocks are lists of maps.
+func testAccRawBody(body *hclsyntax.Body) (map[string]interface{}, error) {
+	raw := make(map[string]interface{})
+	for name, attr := range body.Attributes {
+		value, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		raw[name] = testAccRawValue(value)
+	}
+	for _, block := range body.Blocks {
+		nested, err := testAccRawBody(block.Body)
+		if err != nil {
+			return nil, err
+		}
+		list, _ := raw[block.Type].([]interface{})
+		raw[block.Type] = append(list, nested)
+	}
+	return raw, nil
+}
+
+func testAccRawValue(value cty.Value) interface{} {
+	switch {
+	case value.IsNull():
+		return nil
+	case value.Type() == cty.String:
+		return value.AsString()
+	case value.Type() == cty.Bool:
+		return value.True()
+	case value.Type() == cty.Number:
+		if i, accuracy := value.AsBigFloat().Int64(); accuracy == big.Exact {
+			return int(i)
+		}
+		f, _ := value.AsBigFloat().Float64()
+		return f
+	case value.CanIterateElements():
+		if value.Type().IsObjectType() || value.Type().IsMapType() {
+			m := make(map[string]interface{})
+			for k, v := range value.AsValueMap() {
+				m[k] = testAccRawValue(v)
+			}
+			return m
+		}
+		list := make([]interface{}, 0, value.LengthInt())
+		for _, v := range value.AsValueSlice() {
+			list = append(list, testAccRawValue(v))
+		}
+		return list
+	}
+	return nil
+}
+
+// testFakeProvider returns a provider which is configured to use a new fake server.
+func testFakeProvider(t *testing.T, apiContext string, config map[string]interface{}) (*fakeServer, *schema.Provider) {
+	server := newFakeServer()
+	t.Cleanup(server.Close)
+
+	raw := server.providerConfig(apiContext, filepath.Join(t.TempDir(), DefaultSessionFilename))
+	for k, v := range config {
+		raw[k] = v
+	}
+	provider := Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+	return server, provider
+}
+
+// testFakeApply plans and applies the resource configuration like terraform apply. A nil config
+// destroys the resource.
+func testFakeApply(t *testing.T, provider *schema.Provider, resourceType string, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+	newState, diags := testFakeApplyDiags(t, provider, resourceType, state, config)
+	if diags.HasError() {
+		t.Fatalf("%s apply failed: %v", resourceType, diags)
+	}
+	return newState
+}
+
+// testFakeApplyDiags is testFakeApply for applies which are expected to fail. It returns the state
+// and the diagnostics of the apply.
+func testFakeApplyDiags(t *testing.T, provider *schema.Provider, resourceType string, state *terraform.InstanceState, config map[string]interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	r := provider.ResourcesMap[resourceType]
+	diff := &terraform.InstanceDiff{Destroy: true}
+	if config != nil {
+		var err error
+		diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), provider.Meta())
+		if err != nil {
+			t.Fatalf("%s plan failed: %s", resourceType, err)
+		}
+		if diff == nil {
+			return state, nil
+		}
+	}
+	return r.Apply(context.Background(), state, diff, provider.Meta())
+}
+
+func TestFakeServerSession(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	client := provider.Meta().(*checkpoint.ApiClient)
+
+	res, err := apiCall(client, "show-host", map[string]interface{}{"name": "missing"}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || res.Success || res.GetData()["code"] != "generic_err_object_not_found" {
+		t.Fatalf("expected object not found, got %v %v", res, err)
+	}
+
+	res, err = apiCall(client, "add-host", map[string]interface{}{"name": "h1", "ipv4-address": "10.0.0.1"}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !res.Success {
+		t.Fatalf("add-host failed: %v %v", res, err)
+	}
+	if res, _ = apiCall(client, "discard", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed()); !res.Success {
+		t.Fatalf("discard failed: %v", res.ErrorMsg)
+	}
+	if res, _ = apiCall(client, "show-hosts", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed()); res.GetData()["total"] != float64(0) {
+		t.Fatalf("expected discard to remove the host, got %v", res.GetData())
+	}
+
+	commands := server.commands()
+	if commands[0] != "login" {
+		t.Fatalf("expected provider to login first, got %v", commands)
+	}
+}
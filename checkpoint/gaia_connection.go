@@ -0,0 +1,140 @@
+package checkpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Legacy Gaia resources and data sources which were added before the checkpoint_gaia_ prefix.
+var legacyGaiaResources = map[string]bool{
+	"checkpoint_hostname":           true,
+	"checkpoint_put_file":           true,
+	"checkpoint_physical_interface": true,
+}
+
+func isGaiaResource(name string) bool {
+	return strings.HasPrefix(name, "checkpoint_gaia_") || legacyGaiaResources[name]
+}
+
+// gaiaConnection holds the Gaia API connection configured by the provider "gaia" block.
+// Login to the Gaia server is done on first use, so plans which don't touch Gaia resources
+// don't require the Gaia server to be reachable.
+type gaiaConnection struct {
+	mu             sync.Mutex
+	configured     bool
+	args           checkpoint.ApiClientArgs
+	username       string
+	password       string
+	sessionTimeout int
+	retry          retryPolicy
+	trust          servercert.Trust
+	client         *checkpoint.ApiClient
+}
+
+// configure stores the Gaia connection parameters. Proxy, timeout and certificate settings are
+// taken from the provider configuration, credentials default to the provider credentials.
+func (c *gaiaConnection) configure(gaia map[string]interface{}, args checkpoint.ApiClientArgs, username string, password string, sessionTimeout int, retry retryPolicy, trust servercert.Trust) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	args.Server = gaia["server"].(string)
+	args.Port = gaia["port"].(int)
+	args.Context = checkpoint.GaiaContext
+	args.Sid = ""
+	args.ApiVersion = ""
+	args.CloudMgmtId = ""
+	args.AutoPublishBatchSize = -1
+
+	if v := gaia["username"].(string); v != "" {
+		username = v
+	}
+	if v := gaia["password"].(string); v != "" {
+		password = v
+	}
+
+	c.args = args
+	c.username = username
+	c.password = password
+	c.sessionTimeout = sessionTimeout
+	c.retry = retry
+	c.trust = trust
+	c.client = nil
+	c.configured = true
+}
+
+// clientFor returns the client Gaia resources should use. When the provider "gaia" block is not
+// configured the provider client (meta) is used as is.
+func (c *gaiaConnection) clientFor(meta interface{}) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.configured {
+		return meta, nil
+	}
+	if c.client != nil {
+		return c.client, nil
+	}
+	if c.username == "" || c.password == "" {
+		return nil, fmt.Errorf("checkpoint-provider missing parameters to initialize gaia connection (username and password)")
+	}
+
+	gaia := newApiClient(c.args, c.trust)
+	setRetryPolicy(gaia, c.retry)
+	if _, err := login(gaia, c.username, c.password, "", "", "", "", c.sessionTimeout); err != nil {
+		log.Printf("Failed to perform login to Gaia server [%s]", c.args.Server)
+		return nil, err
+	}
+	log.Printf("Check Point provider connected to Gaia server [%s]", c.args.Server)
+	c.client = gaia
+	return c.client, nil
+}
+
+// route makes Gaia resources and data sources run with the Gaia connection.
+func (c *gaiaConnection) route(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		if isGaiaResource(name) {
+			c.routeResource(r)
+		}
+	}
+	for name, r := range provider.DataSourcesMap {
+		if isGaiaResource(name) {
+			c.routeResource(r)
+		}
+	}
+}
+
+func (c *gaiaConnection) routeResource(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, m interface{}) error {
+			client, err := c.clientFor(m)
+			if err != nil {
+				return err
+			}
+			return f(d, client)
+		}
+	}
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			client, err := c.clientFor(m)
+			if err != nil {
+				return nil, err
+			}
+			return state(d, client)
+		}
+	}
+}
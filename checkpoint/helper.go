@@ -14,7 +14,7 @@ import (
 
 type TaskResult struct {
 	TaskID    string
-	Status    string // "succeeded","failed","timeout","inline","queued","unknown"
+	Status    string // "succeeded","partially succeeded","failed","timeout","inline","queued","unknown"
 	Message   string // best-effort message or pretty JSON from task-details
 	Completed bool
 	Polled    bool
@@ -114,7 +114,7 @@ func HandleTaskCreate(
 			}, ctx.Err()
 
 		case <-ticker.C:
-			showRes, err := client.ApiCall("show-task",
+			showRes, err := apiCall(client, "show-task",
 				map[string]interface{}{"task-id": tid},
 				client.GetSessionID(), false, client.IsProxyUsed())
 			if err != nil {
@@ -154,13 +154,16 @@ func HandleTaskCreate(
 					Polled:    true,
 				}, nil
 
-			case isFailedStatus(status):
+			case status == "partially succeeded" || isFailedStatus(status):
 				if strings.TrimSpace(msg) == "" {
-					msg = "task failed"
+					msg = "task " + status
+				}
+				if status != "partially succeeded" {
+					status = "failed"
 				}
 				return TaskResult{
 					TaskID:    tid,
-					Status:    "failed",
+					Status:    status,
 					Message:   msg,
 					Completed: true,
 					Polled:    true,
@@ -170,6 +173,101 @@ func HandleTaskCreate(
 	}
 }
 
+// showTaskFull returns the full details of a Management API task (show-task with details-level full).
+func showTaskFull(client *checkpoint.ApiClient, taskId string) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"task-id":       taskId,
+		"details-level": "full",
+	}
+	showTaskRes, err := apiCall(client, "show-task", payload, client.GetSessionID(), false, client.IsProxyUsed())
+	if err != nil {
+		return nil, err
+	}
+	if !showTaskRes.Success {
+		return nil, fmt.Errorf("%s", showTaskRes.ErrorMsg)
+	}
+	return normalizeData(showTaskRes.GetData()), nil
+}
+
+// taskTargetsStatus extracts the per-target status from the task-details of Management API tasks
+// such as install-policy, where each entry of task-details describes one gateway.
+func taskTargetsStatus(data map[string]interface{}) []interface{} {
+	targets := make([]interface{}, 0)
+	tasks, _ := data["tasks"].([]interface{})
+	for _, task := range tasks {
+		taskMap, ok := task.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		details, _ := taskMap["task-details"].([]interface{})
+		for _, detail := range details {
+			detailMap, ok := detail.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := getString(detailMap, "gatewayName")
+			uid := getString(detailMap, "gatewayId")
+			if name == "" && uid == "" {
+				continue
+			}
+			messages := make([]interface{}, 0)
+			stages, _ := detailMap["stagesInfo"].([]interface{})
+			for _, stage := range stages {
+				stageMap, ok := stage.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				stageMessages, _ := stageMap["messages"].([]interface{})
+				for _, message := range stageMessages {
+					if messageMap, ok := message.(map[string]interface{}); ok {
+						if v := getString(messageMap, "message"); v != "" {
+							messages = append(messages, v)
+						}
+					}
+				}
+			}
+			targets = append(targets, map[string]interface{}{
+				"target_name": name,
+				"target_uid":  uid,
+				"status":      strings.ToLower(getString(detailMap, "statusCode")),
+				"description": getString(detailMap, "statusDescription"),
+				"messages":    messages,
+			})
+		}
+	}
+	return targets
+}
+
+// taskFailureMessage builds the error of a failed Management API task, listing the targets which didn't succeed.
+// Command resources set their id before returning this error, so Terraform keeps the failed resource as
+// tainted with the task status and the output of each target in the state, and runs it again on the next apply.
+func taskFailureMessage(command string, taskRes TaskResult, targets []interface{}) string {
+	msg := fmt.Sprintf("%s task %s ended with status: %s", command, taskRes.TaskID, taskRes.Status)
+	failedTargets := 0
+	for _, target := range targets {
+		targetMap := target.(map[string]interface{})
+		if targetMap["status"] == "succeeded" {
+			continue
+		}
+		failedTargets++
+		name := targetMap["target_name"].(string)
+		if name == "" {
+			name = targetMap["target_uid"].(string)
+		}
+		msg += fmt.Sprintf("\n%s: %s", name, targetMap["status"])
+		if v := targetMap["description"].(string); v != "" {
+			msg += " - " + v
+		}
+		for _, message := range targetMap["messages"].([]interface{}) {
+			msg += "\n  " + message.(string)
+		}
+	}
+	if failedTargets == 0 && strings.TrimSpace(taskRes.Message) != "" {
+		msg += "\n" + taskRes.Message
+	}
+	return msg
+}
+
 func normalizeData(m map[string]interface{}) map[string]interface{} {
 	cur := m
 	for {
@@ -0,0 +1,66 @@
+package checkpoint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTaskTargetsStatus(t *testing.T) {
+	data := map[string]interface{}{
+		"tasks": []interface{}{
+			map[string]interface{}{
+				"task-id": "01234567-89ab-cdef-0123-456789abcdef",
+				"status":  "partially succeeded",
+				"task-details": []interface{}{
+					map[string]interface{}{
+						"gatewayName":       "gw1",
+						"gatewayId":         "uid-1",
+						"statusCode":        "succeeded",
+						"statusDescription": "Policy installed",
+					},
+					map[string]interface{}{
+						"gatewayName":       "gw2",
+						"gatewayId":         "uid-2",
+						"statusCode":        "FAILED",
+						"statusDescription": "Installation failed",
+						"stagesInfo": []interface{}{
+							map[string]interface{}{
+								"messages": []interface{}{
+									map[string]interface{}{"type": "err", "message": "Policy verification failed"},
+								},
+							},
+						},
+					},
+					map[string]interface{}{"revision": "not a target"},
+				},
+			},
+		},
+	}
+
+	targets := taskTargetsStatus(data)
+	if len(targets) != 2 {
+		t.Fatalf("expected 2 targets, got %d", len(targets))
+	}
+	gw2 := targets[1].(map[string]interface{})
+	if gw2["target_name"] != "gw2" || gw2["status"] != "failed" {
+		t.Fatalf("unexpected target %v", gw2)
+	}
+	if messages := gw2["messages"].([]interface{}); len(messages) != 1 || messages[0] != "Policy verification failed" {
+		t.Fatalf("unexpected messages %v", messages)
+	}
+
+	msg := taskFailureMessage("install-policy", TaskResult{TaskID: "tid", Status: "partially succeeded"}, targets)
+	if !strings.Contains(msg, "gw2: failed - Installation failed") || !strings.Contains(msg, "Policy verification failed") {
+		t.Fatalf("unexpected message %s", msg)
+	}
+	if strings.Contains(msg, "gw1") {
+		t.Fatalf("succeeded target should not be reported: %s", msg)
+	}
+}
+
+func TestTaskFailureMessageWithoutTargets(t *testing.T) {
+	msg := taskFailureMessage("publish", TaskResult{TaskID: "tid", Status: "timeout", Message: "task did not complete before timeout"}, nil)
+	if msg != "publish task tid ended with status: timeout\ntask did not complete before timeout" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
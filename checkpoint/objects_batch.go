@@ -0,0 +1,255 @@
+package checkpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+)
+
+// Time to wait for more objects to join a batch before it's sent.
+const objectsBatchWindow = 500 * time.Millisecond
+
+// objectBatcher groups adds, updates and deletes of objects of the same type which run at the same
+// time into add-objects-batch, set-objects-batch and delete-objects-batch calls. The number of
+// objects Terraform changes at the same time is limited by the -parallelism flag.
+type objectBatcher struct {
+	mu      sync.Mutex
+	size    int
+	window  time.Duration
+	pending map[string]*objectBatch
+	run     func(batch *objectBatch, client *checkpoint.ApiClient) // replaced by tests
+}
+
+type objectBatch struct {
+	action         string // add, set or delete
+	objectType     string
+	ignoreErrors   bool
+	ignoreWarnings bool
+	items          []*objectBatchItem
+}
+
+type objectBatchItem struct {
+	payload map[string]interface{}
+	done    chan objectBatchResult
+}
+
+type objectBatchResult struct {
+	uid string
+	err error
+}
+
+func newObjectBatcher(size int) *objectBatcher {
+	return &objectBatcher{size: size, window: objectsBatchWindow, pending: make(map[string]*objectBatch), run: (*objectBatch).run}
+}
+
+// Object batchers of the provider clients. Objects of clients without a batcher are changed one by one.
+var objectBatchers sync.Map
+
+func setObjectBatcher(client *checkpoint.ApiClient, batcher *objectBatcher) {
+	objectBatchers.Store(client, batcher)
+}
+
+// addObject adds an object of the given type and returns its uid.
+func addObject(client *checkpoint.ApiClient, objectType string, payload map[string]interface{}) (string, error) {
+	if b, ok := objectBatchers.Load(client); ok {
+		res := b.(*objectBatcher).submit(client, "add", objectType, payload)
+		return res.uid, res.err
+	}
+	return runObjectCommand(client, "add", objectType, payload)
+}
+
+// setObject updates an object of the given type.
+func setObject(client *checkpoint.ApiClient, objectType string, payload map[string]interface{}) error {
+	if b, ok := objectBatchers.Load(client); ok {
+		return b.(*objectBatcher).submit(client, "set", objectType, payload).err
+	}
+	_, err := runObjectCommand(client, "set", objectType, payload)
+	return err
+}
+
+// deleteObject deletes an object of the given type.
+func deleteObject(client *checkpoint.ApiClient, objectType string, payload map[string]interface{}) error {
+	if b, ok := objectBatchers.Load(client); ok {
+		return b.(*objectBatcher).submit(client, "delete", objectType, payload).err
+	}
+	_, err := runObjectCommand(client, "delete", objectType, payload)
+	return err
+}
+
+// runObjectCommand runs the add/set/delete command of a single object, e.g. add-host.
+func runObjectCommand(client *checkpoint.ApiClient, action string, objectType string, payload map[string]interface{}) (string, error) {
+	res, err := apiCall(client, action+"-"+objectType, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return "", fmt.Errorf("%s", err.Error())
+	}
+	if !res.Success {
+		return "", fmt.Errorf("%s", res.ErrorMsg)
+	}
+	uid, _ := res.GetData()["uid"].(string)
+	return uid, nil
+}
+
+// submit adds the object to the pending batch of its action and type and waits for the batch
+// result. The batch is sent when it's full or when the batch window passed.
+func (b *objectBatcher) submit(client *checkpoint.ApiClient, action string, objectType string, payload map[string]interface{}) objectBatchResult {
+	item := &objectBatchItem{payload: make(map[string]interface{}, len(payload)), done: make(chan objectBatchResult, 1)}
+	ignoreErrors, ignoreWarnings := false, false
+	for k, v := range payload {
+		switch k {
+		case "ignore-errors":
+			ignoreErrors, _ = v.(bool)
+		case "ignore-warnings":
+			ignoreWarnings, _ = v.(bool)
+		default:
+			item.payload[k] = v
+		}
+	}
+	key := fmt.Sprintf("%s;%s;%t;%t", action, objectType, ignoreErrors, ignoreWarnings)
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &objectBatch{action: action, objectType: objectType, ignoreErrors: ignoreErrors, ignoreWarnings: ignoreWarnings}
+		b.pending[key] = batch
+		time.AfterFunc(b.window, func() {
+			if b.take(key, batch) {
+				b.run(batch, client)
+			}
+		})
+	}
+	batch.items = append(batch.items, item)
+	full := len(batch.items) >= b.size && b.takeLocked(key, batch)
+	b.mu.Unlock()
+
+	if full {
+		b.run(batch, client)
+	}
+	return <-item.done
+}
+
+// take removes the batch from the pending batches, unless it was already taken to be sent.
+func (b *objectBatcher) take(key string, batch *objectBatch) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.takeLocked(key, batch)
+}
+
+func (b *objectBatcher) takeLocked(key string, batch *objectBatch) bool {
+	if b.pending[key] != batch {
+		return false
+	}
+	delete(b.pending, key)
+	return true
+}
+
+// run sends the batch and reports the result of each object. Objects the batch didn't report as
+// done are sent again one by one, so each object gets its own error.
+func (batch *objectBatch) run(client *checkpoint.ApiClient) {
+	command := batch.action + "-objects-batch"
+	list := make([]interface{}, len(batch.items))
+	for i, item := range batch.items {
+		list[i] = item.payload
+	}
+	payload := map[string]interface{}{
+		"objects": []interface{}{
+			map[string]interface{}{"type": batch.objectType, "list": list},
+		},
+	}
+	if batch.ignoreErrors {
+		payload["ignore-errors"] = true
+	}
+	if batch.ignoreWarnings {
+		payload["ignore-warnings"] = true
+	}
+
+	log.Printf("[DEBUG] %s of %d %s objects", command, len(batch.items), batch.objectType)
+	res, err := apiCall(client, command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	var done map[string]string
+	if err == nil {
+		done = batchObjects(res.GetData(), batch.objectType)
+	}
+	success := err == nil && res.Success
+	if !success {
+		errMsg := res.ErrorMsg
+		if err != nil {
+			errMsg = err.Error()
+		}
+		log.Printf("[WARN] %s failed, changing the %s objects one by one: %s", command, batch.objectType, errMsg)
+	}
+
+	for _, item := range batch.items {
+		item.done <- batch.result(client, item, success, done)
+	}
+}
+
+func (batch *objectBatch) result(client *checkpoint.ApiClient, item *objectBatchItem, success bool, done map[string]string) objectBatchResult {
+	name, _ := item.payload["name"].(string)
+	uid, _ := item.payload["uid"].(string)
+	if batch.action == "add" {
+		if uid, ok := done[name]; ok {
+			return objectBatchResult{uid: uid}
+		}
+		if success {
+			uid, err := showObjectUid(client, batch.objectType, name)
+			return objectBatchResult{uid: uid, err: err}
+		}
+	} else if success || (uid != "" && done[uid] != "") {
+		return objectBatchResult{uid: uid}
+	}
+
+	payload := make(map[string]interface{}, len(item.payload)+2)
+	for k, v := range item.payload {
+		payload[k] = v
+	}
+	if batch.ignoreErrors {
+		payload["ignore-errors"] = true
+	}
+	if batch.ignoreWarnings {
+		payload["ignore-warnings"] = true
+	}
+	uid, err := runObjectCommand(client, batch.action, batch.objectType, payload)
+	return objectBatchResult{uid: uid, err: err}
+}
+
+// batchObjects returns the objects of the given type in a batch task result, by name and by uid.
+func batchObjects(data interface{}, objectType string) map[string]string {
+	objects := make(map[string]string)
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch value := v.(type) {
+		case map[string]interface{}:
+			uid, _ := value["uid"].(string)
+			if t, _ := value["type"].(string); uid != "" && strings.EqualFold(t, objectType) {
+				if name, _ := value["name"].(string); name != "" {
+					objects[name] = uid
+				}
+				objects[uid] = uid
+			}
+			for _, child := range value {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range value {
+				walk(child)
+			}
+		}
+	}
+	walk(data)
+	return objects
+}
+
+func showObjectUid(client *checkpoint.ApiClient, objectType string, name string) (string, error) {
+	res, err := apiCall(client, "show-"+objectType, map[string]interface{}{"name": name}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return "", fmt.Errorf("%s", err.Error())
+	}
+	if !res.Success {
+		return "", fmt.Errorf("%s", res.ErrorMsg)
+	}
+	uid, _ := res.GetData()["uid"].(string)
+	return uid, nil
+}
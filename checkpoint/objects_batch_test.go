@@ -0,0 +1,114 @@
+package checkpoint
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+)
+
+func TestObjectBatcherGroupsObjects(t *testing.T) {
+	var mu sync.Mutex
+	var batches []*objectBatch
+	b := newObjectBatcher(3)
+	b.window = 50 * time.Millisecond
+	b.run = func(batch *objectBatch, client *checkpoint.ApiClient) {
+		mu.Lock()
+		batches = append(batches, batch)
+		mu.Unlock()
+		for _, item := range batch.items {
+			item.done <- objectBatchResult{uid: "uid-" + item.payload["name"].(string)}
+		}
+	}
+
+	var wg sync.WaitGroup
+	results := make([]objectBatchResult, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			payload := map[string]interface{}{"name": fmt.Sprintf("host%d", i), "ignore-warnings": true}
+			results[i] = b.submit(nil, "add", "host", payload)
+		}(i)
+	}
+	wg.Wait()
+
+	if len(batches) != 2 || len(batches[0].items)+len(batches[1].items) != 5 {
+		t.Fatalf("expected a full batch of 3 and a batch of 2, got %d batches", len(batches))
+	}
+	for _, batch := range batches {
+		if !batch.ignoreWarnings || batch.objectType != "host" {
+			t.Errorf("unexpected batch %+v", batch)
+		}
+		for _, item := range batch.items {
+			if _, ok := item.payload["ignore-warnings"]; ok {
+				t.Errorf("ignore-warnings should be set on the batch, not on the object")
+			}
+		}
+	}
+	for i, res := range results {
+		if res.uid != fmt.Sprintf("uid-host%d", i) || res.err != nil {
+			t.Errorf("object %d: unexpected result %+v", i, res)
+		}
+	}
+}
+
+func TestObjectBatcherSeparatesActions(t *testing.T) {
+	var mu sync.Mutex
+	actions := make(map[string]int)
+	b := newObjectBatcher(10)
+	b.window = 20 * time.Millisecond
+	b.run = func(batch *objectBatch, client *checkpoint.ApiClient) {
+		mu.Lock()
+		actions[batch.action+"-"+batch.objectType] += len(batch.items)
+		mu.Unlock()
+		for _, item := range batch.items {
+			item.done <- objectBatchResult{}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, action := range []string{"add", "set", "delete"} {
+		for _, objectType := range []string{"host", "network"} {
+			wg.Add(1)
+			go func(action string, objectType string) {
+				defer wg.Done()
+				b.submit(nil, action, objectType, map[string]interface{}{"name": "a"})
+			}(action, objectType)
+		}
+	}
+	wg.Wait()
+
+	if len(actions) != 6 {
+		t.Fatalf("expected 6 batches, got %v", actions)
+	}
+}
+
+func TestBatchObjects(t *testing.T) {
+	data := map[string]interface{}{
+		"tasks": []interface{}{
+			map[string]interface{}{
+				"task-id": "t1",
+				"status":  "succeeded",
+				"task-details": []interface{}{
+					map[string]interface{}{
+						"objects": []interface{}{
+							map[string]interface{}{"uid": "u1", "name": "host1", "type": "host"},
+							map[string]interface{}{"uid": "u2", "name": "host2", "type": "host"},
+							map[string]interface{}{"uid": "u3", "name": "net1", "type": "network"},
+						},
+					},
+				},
+			},
+		},
+	}
+	objects := batchObjects(data, "host")
+	if objects["host1"] != "u1" || objects["host2"] != "u2" || objects["u2"] != "u2" {
+		t.Errorf("unexpected objects %v", objects)
+	}
+	if _, ok := objects["net1"]; ok {
+		t.Errorf("objects of other types should be ignored")
+	}
+}
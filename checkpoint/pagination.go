@@ -0,0 +1,242 @@
+package checkpoint
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultPageSize = 50
+
+// listPagination describes where a list data source keeps its results. Rulebase data sources keep
+// the results and the from/to/total counters inside the first element of "rulebase".
+type listPagination struct {
+	nested        bool   // results are in rulebase.0
+	listKey       string // objects or rulebase
+	dictionaryKey string // objects_dictionary, when the results reference it
+}
+
+// paginateListDataSources adds automatic pagination to every data source which supports
+// limit/offset paging. With "fetch_all" set the data source reads page after page of "page_size"
+// results until all results, or "max_results" results, were read.
+func paginateListDataSources(provider *schema.Provider) {
+	for _, r := range provider.DataSourcesMap {
+		p, ok := newListPagination(r)
+		if !ok {
+			continue
+		}
+		addPaginationSchema(r)
+		read := r.Read
+		r.Read = func(d *schema.ResourceData, m interface{}) error {
+			if !d.Get("fetch_all").(bool) {
+				return read(d, m)
+			}
+			return p.readAll(d, m, read)
+		}
+	}
+}
+
+func newListPagination(r *schema.Resource) (listPagination, bool) {
+	if r.Read == nil || r.Schema["limit"] == nil || r.Schema["offset"] == nil {
+		return listPagination{}, false
+	}
+	container := r.Schema
+	p := listPagination{}
+	if container["to"] == nil || container["total"] == nil {
+		rulebase, ok := container["rulebase"]
+		if !ok {
+			return listPagination{}, false
+		}
+		elem, ok := rulebase.Elem.(*schema.Resource)
+		if !ok || elem.Schema["to"] == nil || elem.Schema["total"] == nil {
+			return listPagination{}, false
+		}
+		container = elem.Schema
+		p.nested = true
+	}
+	if _, ok := container["objects"]; ok {
+		p.listKey = "objects"
+	} else if _, ok := container["rulebase"]; ok {
+		p.listKey = "rulebase"
+	} else {
+		return listPagination{}, false
+	}
+	if _, ok := container["objects_dictionary"]; ok {
+		p.dictionaryKey = "objects_dictionary"
+	}
+	return p, true
+}
+
+func addPaginationSchema(r *schema.Resource) {
+	if _, ok := r.Schema["fetch_all"]; !ok {
+		r.Schema["fetch_all"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If true, fetches all results.",
+			Default:     false,
+		}
+	}
+	r.Schema["page_size"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "The number of results to fetch in each request when fetch_all is true.",
+		Default:      defaultPageSize,
+		ValidateFunc: validateIntRange(1, 500),
+	}
+	r.Schema["max_results"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "The maximal number of results to fetch when fetch_all is true. By default all results are fetched.",
+		ValidateFunc: validateIntRange(1, 1000000),
+	}
+}
+
+// readAll runs the data source read for each page and reports the merged results.
+func (p listPagination) readAll(d *schema.ResourceData, m interface{}, read schema.ReadFunc) error {
+	limit, limitSet := d.GetOk("limit")
+	startOffset := d.Get("offset").(int)
+	offset := startOffset
+	pageSize := d.Get("page_size").(int)
+	maxResults := d.Get("max_results").(int)
+
+	var merged map[string]interface{}
+	fetched := 0
+	for {
+		pageLimit := pageSize
+		if maxResults > 0 && maxResults-fetched < pageLimit {
+			pageLimit = maxResults - fetched
+		}
+		_ = d.Set("limit", pageLimit)
+		_ = d.Set("offset", offset)
+
+		if err := read(d, m); err != nil {
+			return err
+		}
+		page := p.page(d)
+		from, to, total := pageInt(page["from"]), pageInt(page["to"]), pageInt(page["total"])
+		results := pageList(page[p.listKey])
+
+		if merged == nil {
+			merged = page
+		} else {
+			merged[p.listKey] = mergePageResults(pageList(merged[p.listKey]), results)
+			if p.dictionaryKey != "" {
+				merged[p.dictionaryKey] = mergeObjectsDictionary(merged[p.dictionaryKey], page[p.dictionaryKey])
+			}
+			merged["to"] = page["to"]
+			merged["total"] = page["total"]
+		}
+
+		count := to - from + 1
+		if to == 0 || count <= 0 {
+			count = len(results)
+		}
+		fetched += count
+		log.Printf("[DEBUG] fetched results %d-%d of %d", from, to, total)
+
+		if count <= 0 || offset+count >= total || (maxResults > 0 && fetched >= maxResults) {
+			break
+		}
+		offset += count
+	}
+
+	if limitSet {
+		_ = d.Set("limit", limit)
+	} else {
+		_ = d.Set("limit", nil)
+	}
+	_ = d.Set("offset", startOffset)
+	return p.setPage(d, merged)
+}
+
+func (p listPagination) page(d *schema.ResourceData) map[string]interface{} {
+	if p.nested {
+		if rulebase, ok := d.Get("rulebase").([]interface{}); ok && len(rulebase) > 0 {
+			if page, ok := rulebase[0].(map[string]interface{}); ok {
+				return page
+			}
+		}
+		return map[string]interface{}{}
+	}
+	page := map[string]interface{}{
+		"from":    d.Get("from"),
+		"to":      d.Get("to"),
+		"total":   d.Get("total"),
+		p.listKey: d.Get(p.listKey),
+	}
+	if p.dictionaryKey != "" {
+		page[p.dictionaryKey] = d.Get(p.dictionaryKey)
+	}
+	return page
+}
+
+func (p listPagination) setPage(d *schema.ResourceData, page map[string]interface{}) error {
+	if p.nested {
+		return d.Set("rulebase", []interface{}{page})
+	}
+	for k, v := range page {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("failed to set %s: %s", k, err)
+		}
+	}
+	return nil
+}
+
+func pageInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	}
+	return 0
+}
+
+// pageList returns the results of a list or set attribute.
+func pageList(v interface{}) []interface{} {
+	if set, ok := v.(*schema.Set); ok {
+		return set.List()
+	}
+	list, _ := v.([]interface{})
+	return list
+}
+
+// mergePageResults appends a page of results. A rulebase section which continues from the previous
+// page is merged with its first part.
+func mergePageResults(results []interface{}, page []interface{}) []interface{} {
+	if len(results) > 0 && len(page) > 0 {
+		last, lastOk := results[len(results)-1].(map[string]interface{})
+		first, firstOk := page[0].(map[string]interface{})
+		if lastOk && firstOk && last["uid"] != nil && last["uid"] != "" && last["uid"] == first["uid"] {
+			lastRules, lastIsSection := last["rulebase"].([]interface{})
+			firstRules, firstIsSection := first["rulebase"].([]interface{})
+			if lastIsSection && firstIsSection {
+				last["rulebase"] = append(lastRules, firstRules...)
+				if v, ok := first["to"]; ok {
+					last["to"] = v
+				}
+				page = page[1:]
+			}
+		}
+	}
+	return append(results, page...)
+}
+
+func mergeObjectsDictionary(dictionary interface{}, page interface{}) interface{} {
+	objects := pageList(dictionary)
+	seen := make(map[interface{}]bool)
+	for _, object := range objects {
+		if objectMap, ok := object.(map[string]interface{}); ok {
+			seen[objectMap["uid"]] = true
+		}
+	}
+	pageObjects := pageList(page)
+	for _, object := range pageObjects {
+		if objectMap, ok := object.(map[string]interface{}); ok && !seen[objectMap["uid"]] {
+			seen[objectMap["uid"]] = true
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
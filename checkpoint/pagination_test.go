@@ -0,0 +1,147 @@
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testPagedDataSource returns a data source which serves total objects named by their index.
+func testPagedDataSource(total int, requests *[]int) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			offset := d.Get("offset").(int)
+			limit := d.Get("limit").(int)
+			*requests = append(*requests, limit)
+			objects := make([]interface{}, 0)
+			for i := offset; i < offset+limit && i < total; i++ {
+				objects = append(objects, map[string]interface{}{"name": string(rune('a' + i))})
+			}
+			d.SetId("test")
+			_ = d.Set("objects", objects)
+			_ = d.Set("from", offset+1)
+			_ = d.Set("to", offset+len(objects))
+			_ = d.Set("total", total)
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"limit":  {Type: schema.TypeInt, Optional: true},
+			"offset": {Type: schema.TypeInt, Optional: true},
+			"from":   {Type: schema.TypeInt, Computed: true},
+			"to":     {Type: schema.TypeInt, Computed: true},
+			"total":  {Type: schema.TypeInt, Computed: true},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPaginateListDataSources(t *testing.T) {
+	var requests []int
+	provider := &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"test_objects": testPagedDataSource(7, &requests),
+		},
+	}
+	paginateListDataSources(provider)
+	r := provider.DataSourcesMap["test_objects"]
+
+	d := r.TestResourceData()
+	_ = d.Set("fetch_all", true)
+	_ = d.Set("page_size", 3)
+	if err := r.Read(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n := len(d.Get("objects").([]interface{})); n != 7 {
+		t.Fatalf("expected 7 objects, got %d", n)
+	}
+	if d.Get("from").(int) != 1 || d.Get("to").(int) != 7 || d.Get("total").(int) != 7 {
+		t.Fatalf("unexpected from/to/total %v/%v/%v", d.Get("from"), d.Get("to"), d.Get("total"))
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %v", requests)
+	}
+	if d.Get("limit").(int) != 0 || d.Get("offset").(int) != 0 {
+		t.Fatalf("limit and offset should be restored")
+	}
+
+	requests = nil
+	d = r.TestResourceData()
+	_ = d.Set("fetch_all", true)
+	_ = d.Set("page_size", 3)
+	_ = d.Set("max_results", 4)
+	if err := r.Read(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n := len(d.Get("objects").([]interface{})); n != 4 {
+		t.Fatalf("expected 4 objects, got %d", n)
+	}
+	if len(requests) != 2 || requests[1] != 1 {
+		t.Fatalf("expected last page to be capped, got %v", requests)
+	}
+}
+
+func TestMergePageResults(t *testing.T) {
+	results := []interface{}{
+		map[string]interface{}{"uid": "s1", "rulebase": []interface{}{"r1"}, "to": 1},
+	}
+	page := []interface{}{
+		map[string]interface{}{"uid": "s1", "rulebase": []interface{}{"r2"}, "to": 2},
+		map[string]interface{}{"uid": "r3"},
+	}
+	merged := mergePageResults(results, page)
+	if len(merged) != 2 {
+		t.Fatalf("expected section to be merged, got %v", merged)
+	}
+	section := merged[0].(map[string]interface{})
+	if len(section["rulebase"].([]interface{})) != 2 || section["to"] != 2 {
+		t.Fatalf("unexpected section %v", section)
+	}
+}
+
+func TestListDataSourcesPaginated(t *testing.T) {
+	for _, name := range []string{
+		"checkpoint_management_show_objects",
+		"checkpoint_management_access_rulebase",
+		"checkpoint_management_show_updatable_objects_repository_content",
+		"checkpoint_gaia_show_routes_static",
+	} {
+		r := Provider().DataSourcesMap[name]
+		if r.Schema["fetch_all"] == nil || r.Schema["page_size"] == nil || r.Schema["max_results"] == nil {
+			t.Errorf("%s does not support pagination", name)
+		}
+	}
+}
+
+func TestPaginateSetDataSource(t *testing.T) {
+	var requests []int
+	source := testPagedDataSource(5, &requests)
+	source.Schema["objects"].Type = schema.TypeSet
+	provider := &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"test_objects": source,
+		},
+	}
+	paginateListDataSources(provider)
+	r := provider.DataSourcesMap["test_objects"]
+
+	d := r.TestResourceData()
+	_ = d.Set("fetch_all", true)
+	_ = d.Set("page_size", 2)
+	if err := r.Read(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n := d.Get("objects").(*schema.Set).Len(); n != 5 {
+		t.Fatalf("expected 5 objects, got %d", n)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %v", requests)
+	}
+}
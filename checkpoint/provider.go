@@ -7,11 +7,17 @@ import (
 	"time"
 
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	gaiaConn := &gaiaConnection{}
+	lifecycle := &sessionLifecycle{}
+	rulePositions := &rulebaseCache{}
+	domains := &domainSessions{}
+	versions := &apiVersionGate{gaia: gaiaConn}
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"server": {
 				Type:        schema.TypeString,
@@ -97,6 +103,26 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SESSION_TIMEOUT", -1),
 				Description: "Timeout for the Check Point session in seconds. Can be 10-3600",
 			},
+			"stale_sessions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_STALE_SESSIONS", ""),
+				ValidateFunc: validateStringValue(staleSessionsTakeover, staleSessionsDiscard),
+				Description:  "Take over (takeover) or discard (discard) open sessions with the provider session_name which earlier runs left, before work starts. Requires session_name",
+			},
+			"stale_session_min_age": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_STALE_SESSION_MIN_AGE", 60),
+				ValidateFunc: validateIntRange(0, 10080),
+				Description:  "Minutes since the last login of a session before it's stale. Default is 60, so sessions of runs in progress are kept",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_API_VERSION", ""),
+				Description: "API version to send the API calls with, e.g. 1.8. Default is the latest version of the server. Resources and attributes which require a later version fail the plan",
+			},
 			"cloud_mgmt_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -109,12 +135,93 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE", -1),
 				Description: "Number of batch size to automatically run publish",
 			},
+			"objects_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_OBJECTS_BATCH_SIZE", 0),
+				Description:  "Maximum number of hosts, networks and address ranges to add, update or delete in one objects batch API call. 0 disables batching",
+				ValidateFunc: validateIntRange(0, 1000),
+			},
+			"publish_when_idle": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_PUBLISH_WHEN_IDLE", false),
+				Description: "Publish the session each time no change is running and the changes since the last publish completed successfully, and discard it when a change failed. Changes of an apply are published in one or more publishes",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_MAX_RETRIES", defaultMaxRetries),
+				Description:  "Maximum number of times to retry an API call which failed on a locked object or a transient server error",
+				ValidateFunc: validateIntRange(0, 100),
+			},
+			"retry_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_RETRY_BACKOFF", defaultRetryBackoff),
+				Description:  "Time in seconds to wait before the first retry of an API call. The time is doubled on each retry",
+				ValidateFunc: validateIntRange(0, 60),
+			},
+			"retryable_errors": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "API error codes or error message fragments of API calls to retry. Defaults to errors of locked objects and busy servers",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"ignore_server_certificate": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_IGNORE_SERVER_CERTIFICATE", false),
 				Description: "Indicates that the client should not check the server's certificate",
 			},
+			"server_certificate_fingerprint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CHECKPOINT_SERVER_CERTIFICATE_FINGERPRINT", ""),
+				ConflictsWith: []string{"ignore_server_certificate"},
+				Description:   "SHA-256 (or SHA-1) fingerprint of the server certificate, in hex with or without colons. The provider fails to connect to a server which presents another certificate",
+			},
+			"ca_certificate_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("CHECKPOINT_CA_CERTIFICATE_PEM", ""),
+				ConflictsWith: []string{"ignore_server_certificate"},
+				Description:   "PEM encoded certificates of the CA which issued the server certificate, e.g. the internal CA of the Management Server. The provider fails to connect to a server whose certificate isn't issued by this CA",
+			},
+			"gaia": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Gaia API connection used by Gaia resources and data sources alongside the Management API session",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Check Point Gaia server IP",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Check Point Gaia admin name. Default is the provider username",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Check Point Gaia admin password. Default is the provider password",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     checkpoint.DefaultPort,
+							Description: "Port used for connection to the Gaia API server",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"checkpoint_management_outbound_inspection_certificate":                resourceManagementOutboundInspectionCertificate(),
@@ -187,6 +294,7 @@ func Provider() *schema.Provider {
 			"checkpoint_management_service_dce_rpc":                                resourceManagementServiceDceRpc(),
 			"checkpoint_management_service_rpc":                                    resourceManagementServiceRpc(),
 			"checkpoint_management_access_rule":                                    resourceManagementAccessRule(),
+			"checkpoint_management_access_rulebase":                                resourceManagementAccessRulebase(),
 			"checkpoint_management_access_section":                                 resourceManagementAccessSection(),
 			"checkpoint_management_access_layer":                                   resourceManagementAccessLayer(),
 			"checkpoint_management_vpn_community_meshed":                           resourceManagementVpnCommunityMeshed(),
@@ -239,6 +347,7 @@ func Provider() *schema.Provider {
 			"checkpoint_management_logout":                                         resourceManagementLogout(),
 			"checkpoint_management_publish":                                        resourceManagementPublish(),
 			"checkpoint_management_install_policy":                                 resourceManagementInstallPolicy(),
+			"checkpoint_management_policy_installation":                            resourceManagementPolicyInstallation(),
 			"checkpoint_management_run_ips_update":                                 resourceManagementRunIpsUpdate(),
 			"checkpoint_management_access_point_name":                              resourceManagementAccessPointName(),
 			"checkpoint_management_gsn_handover_group":                             resourceManagementGsnHandoverGroup(),
@@ -633,6 +742,9 @@ func Provider() *schema.Provider {
 			"checkpoint_management_ips_update_schedule":                       dataSourceManagementIpsUpdateSchedule(),
 			"checkpoint_management_task":                                      dataSourceManagementTask(),
 			"checkpoint_management_objects":                                   dataSourceManagementObjects(),
+			"checkpoint_management_sessions":                                  dataSourceManagementSessions(),
+			"checkpoint_management_changes":                                   dataSourceManagementChanges(),
+			"checkpoint_management_revisions":                                 dataSourceManagementRevisions(),
 			"checkpoint_management_login_message":                             dataSourceManagementLoginMessage(),
 			"checkpoint_management_policy_settings":                           dataSourceManagementPolicySettings(),
 			"checkpoint_management_threat_advanced_settings":                  dataSourceManagementThreatAdvancedSettings(),
@@ -853,11 +965,21 @@ func Provider() *schema.Provider {
 			"checkpoint_gaia_show_vlan_interfaces": dataGaiaShowVlanInterfaces(),
 			"checkpoint_gaia_show_vsnext_state": dataGaiaShowVsnextState(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureFunc: func(data *schema.ResourceData) (interface{}, error) {
+			return providerConfigure(data, gaiaConn, lifecycle, domains, versions)
+		},
 	}
+	paginateListDataSources(provider)
+	gaiaConn.route(provider)
+	rulePositions.route(provider)
+	lifecycle.route(provider)
+	domains.route(provider)
+	versions.route(provider)
+	registerSensitiveKeys(provider)
+	return provider
 }
 
-func providerConfigure(data *schema.ResourceData) (interface{}, error) {
+func providerConfigure(data *schema.ResourceData, gaiaConn *gaiaConnection, lifecycle *sessionLifecycle, domains *domainSessions, versions *apiVersionGate) (interface{}, error) {
 	server := data.Get("server").(string)
 	username := data.Get("username").(string)
 	password := data.Get("password").(string)
@@ -872,13 +994,31 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 	sessionName := data.Get("session_name").(string)
 	sessionDescription := data.Get("session_description").(string)
 	sessionTimeout := data.Get("session_timeout").(int)
+	apiVersion := data.Get("api_version").(string)
+	staleSessions := data.Get("stale_sessions").(string)
 	cloudMgmtId := data.Get("cloud_mgmt_id").(string)
 	autoPublishBatchSize := data.Get("auto_publish_batch_size").(int)
+	objectsBatchSize := data.Get("objects_batch_size").(int)
+	publishWhenIdle := data.Get("publish_when_idle").(bool)
 	ignoreServerCertificate := data.Get("ignore_server_certificate").(bool)
+	trust, err := servercert.New(data.Get("server_certificate_fingerprint").(string), data.Get("ca_certificate_pem").(string))
+	if err != nil {
+		return nil, err
+	}
+	var retryableErrors []string
+	for _, v := range data.Get("retryable_errors").([]interface{}) {
+		if e, ok := v.(string); ok {
+			retryableErrors = append(retryableErrors, e)
+		}
+	}
+	retry := newRetryPolicy(data.Get("max_retries").(int), data.Get("retry_backoff").(int), retryableErrors)
 
 	if server == "" || ((username == "" || password == "") && apiKey == "") {
 		return nil, fmt.Errorf("checkpoint-provider missing parameters to initialize (server, (username and password) OR api_key)")
 	}
+	if staleSessions != "" && sessionName == "" {
+		return nil, fmt.Errorf("checkpoint-provider stale_sessions requires session_name to find the sessions of earlier runs")
+	}
 
 	args := checkpoint.ApiClientArgs{
 		Port:                    port,
@@ -887,7 +1027,7 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 		Server:                  server,
 		ProxyHost:               proxyHost,
 		ProxyPort:               proxyPort,
-		ApiVersion:              "",
+		ApiVersion:              apiVersion,
 		IgnoreServerCertificate: ignoreServerCertificate,
 		AcceptServerCertificate: false,
 		DebugFile:               "deb.txt",
@@ -899,38 +1039,47 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 		AutoPublishBatchSize:    autoPublishBatchSize,
 	}
 
+	if v, ok := data.GetOk("gaia"); ok {
+		gaiaConn.configure(v.([]interface{})[0].(map[string]interface{}), args, username, password, sessionTimeout, retry, trust)
+	}
+
 	switch context {
 	case checkpoint.WebContext:
-		var s Session
-		var err error
-		s, err = GetSession(sessionFileName)
-		if err != nil {
-			return nil, err
+		login := managementLogin{
+			args:               args,
+			sessionFileName:    sessionFileName,
+			username:           username,
+			password:           password,
+			apiKey:             apiKey,
+			sessionName:        sessionName,
+			sessionDescription: sessionDescription,
+			sessionTimeout:     sessionTimeout,
+			retry:              retry,
+			objectsBatchSize:   objectsBatchSize,
+			trust:              trust,
+			staleSessions:      staleSessions,
+			staleSessionMinAge: data.Get("stale_session_min_age").(int),
 		}
-		if s.Sid != "" {
-			args.Sid = s.Sid
+		if _, ok := data.GetOk("gaia"); ok {
+			login.gaia = gaiaConn
 		}
-		mgmt := checkpoint.APIClient(args)
-		if ok := CheckSession(mgmt, s.Uid); !ok {
-			// session is not valid, need to perform login
-			s, err = login(mgmt, username, password, apiKey, domain, sessionName, sessionDescription, sessionTimeout)
-			if err != nil {
-				log.Println("Failed to perform login")
-				return nil, err
-			}
-			if err := s.Save(sessionFileName); err != nil {
-				return nil, err
-			}
+		mgmt, err := login.open(domain, nil)
+		if err != nil {
+			return nil, err
 		}
-		log.Printf("Check Point provider connected with session uid [%s]", s.Uid)
+		domains.configure(login, domain)
+		lifecycle.configure(publishWhenIdle)
+		versions.configure(apiVersion)
 		return mgmt, nil
 	case checkpoint.GaiaContext:
-		gaia := checkpoint.APIClient(args)
-		_, err := login(gaia, username, password, "", "", "", "", sessionTimeout)
+		gaia := newApiClient(args, trust)
+		setRetryPolicy(gaia, retry)
+		_, err = login(gaia, username, password, "", "", "", "", sessionTimeout)
 		if err != nil {
 			log.Println("Failed to perform login")
 			return nil, err
 		}
+		versions.configure(apiVersion)
 		return gaia, nil
 	default:
 		return nil, fmt.Errorf("Invalid access context. Use 'web_api' or 'gaia_api'")
@@ -1,10 +1,26 @@
 package checkpoint
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProvider *schema.Provider
@@ -20,17 +36,29 @@ func init() {
 		"checkpoint": testAccProvider,
 	}
 	testAccProviderFactories = map[string]func() (*schema.Provider, error){
-		"checkpoint": func() (*schema.Provider, error) { return Provider(), nil },
+		"checkpoint": func() (*schema.Provider, error) {
+			return Provider(), nil
+		},
 	}
 }
 
+// testAccContext returns the API context of an acceptance test, which runs against a new fake server
+// when no CHECKPOINT_SERVER is set.
+func testAccContext(t *testing.T) string {
+	testAccFakeServer(t)
+	return os.Getenv("CHECKPOINT_CONTEXT")
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
+// testAccPreCheck runs the acceptance tests against the in-memory fake server when no
+// CHECKPOINT_SERVER is set.
 func testAccPreCheck(t *testing.T) {
+	testAccFakeServer(t)
 	if os.Getenv("CHECKPOINT_SERVER") == "" {
 		t.Fatal("CHECKPOINT_SERVER must be set for acceptance tests")
 	}
@@ -44,3 +72,133 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("CHECKPOINT_CONTEXT must be set for acceptance tests")
 	}
 }
+
+func TestGaiaConnectionRoute(t *testing.T) {
+	var got interface{}
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			got = m
+			return nil
+		},
+	}
+	conn := &gaiaConnection{}
+	conn.routeResource(r)
+
+	if err := r.Read(r.TestResourceData(), "provider-client"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "provider-client" {
+		t.Fatalf("expected provider client to be used when gaia block is not configured, got %v", got)
+	}
+	if r.Create != nil || r.Update != nil || r.Delete != nil {
+		t.Fatalf("expected undefined operations to stay undefined")
+	}
+
+	conn.configured = true
+	if err := r.Read(r.TestResourceData(), "provider-client"); err == nil {
+		t.Fatalf("expected error when gaia credentials are missing")
+	}
+}
+
+func TestIsGaiaResource(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"checkpoint_gaia_static_route":      true,
+		"checkpoint_physical_interface":     true,
+		"checkpoint_management_host":        false,
+		"checkpoint_generic_api":            false,
+		"checkpoint_gaia_show_api_versions": true,
+	} {
+		if isGaiaResource(name) != expected {
+			t.Errorf("isGaiaResource(%s) expected %v", name, expected)
+		}
+	}
+}
+
+func TestProviderServerCertificate_offline(t *testing.T) {
+	// the SDK records the certificates it sees in the fingerprints file of the working directory
+	t.Chdir(t.TempDir())
+	server := newFakeServer()
+	defer server.Close()
+	fingerprint := servercert.Fingerprint(server.Certificate())
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	for _, tc := range []struct {
+		name    string
+		trust   map[string]interface{}
+		wantErr string
+	}{
+		{"fingerprint", map[string]interface{}{"server_certificate_fingerprint": strings.ToUpper(fingerprint)}, ""},
+		{"ca", map[string]interface{}{"ca_certificate_pem": caPEM}, ""},
+		{"mismatch", map[string]interface{}{"server_certificate_fingerprint": strings.Repeat("00", 32)}, "the server presented " + fingerprint},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := server.providerConfig("web_api", filepath.Join(t.TempDir(), DefaultSessionFilename))
+			delete(raw, "ignore_server_certificate")
+			for k, v := range tc.trust {
+				raw[k] = v
+			}
+			logins := len(server.commands())
+			diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+			if tc.wantErr == "" && diags.HasError() {
+				t.Fatalf("expected the server certificate to be trusted, got %v", diags)
+			}
+			if tc.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, diags)
+				}
+				if len(server.commands()) != logins {
+					t.Errorf("expected no login to an untrusted server")
+				}
+			}
+		})
+	}
+}
+
+func TestProviderServerCertificateEachRequest_offline(t *testing.T) {
+	t.Chdir(t.TempDir())
+	server := newFakeServer()
+	defer server.Close()
+	raw := server.providerConfig("web_api", filepath.Join(t.TempDir(), DefaultSessionFilename))
+	delete(raw, "ignore_server_certificate")
+	raw["server_certificate_fingerprint"] = servercert.Fingerprint(server.Certificate())
+	provider := Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatal(diags)
+	}
+	client := provider.Meta().(*checkpoint.ApiClient)
+	if _, err := apiCall(client, "show-hosts", map[string]interface{}{}, client.GetSessionID(), false, false); err != nil {
+		t.Fatalf("expected the request to be sent, got %s", err)
+	}
+
+	// the server presents another certificate on the next connection of the provider
+	server.TLS.Certificates = []tls.Certificate{testTLSCertificate(t)}
+	server.CloseClientConnections()
+	calls := len(server.commands())
+	_, err := apiCall(client, "show-hosts", map[string]interface{}{}, client.GetSessionID(), false, false)
+	if err == nil || !strings.Contains(err.Error(), "doesn't match") {
+		t.Errorf("expected the certificate to be verified again, got %v", err)
+	}
+	if len(server.commands()) != calls {
+		t.Errorf("expected no request to the untrusted server")
+	}
+}
+
+// testTLSCertificate returns a self-signed certificate and its key.
+func testTLSCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "other"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
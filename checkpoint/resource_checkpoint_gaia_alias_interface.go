@@ -14,6 +14,7 @@ func resourceGaiaAliasInterface() *schema.Resource {
         Create: createGaiaAliasInterface,
         Read:   readGaiaAliasInterface,
         Delete: deleteGaiaAliasInterface,
+        Importer: importGaiaResource("alias-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -84,7 +85,7 @@ func createGaiaAliasInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create AliasInterface - Map = ", payload)
 
-    addAliasInterfaceRes, err := client.ApiCallSimple("add-alias-interface", payload)
+    addAliasInterfaceRes, err := apiCallSimple(client, "add-alias-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addAliasInterfaceRes.Success
@@ -153,7 +154,7 @@ func readGaiaAliasInterface(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    showAliasInterfaceRes, err := client.ApiCallSimple("show-alias-interface", payload)
+    showAliasInterfaceRes, err := apiCallSimple(client, "show-alias-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showAliasInterfaceRes.Success
@@ -232,7 +233,7 @@ func deleteGaiaAliasInterface(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteAliasInterfaceRes, err := client.ApiCallSimple("delete-alias-interface", payload)
+    deleteAliasInterfaceRes, err := apiCallSimple(client, "delete-alias-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteAliasInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaAllowedClients() *schema.Resource {
         Read:   readGaiaAllowedClients,
         Update: updateGaiaAllowedClients,
         Delete: deleteGaiaAllowedClients,
+        Importer: importGaiaResource("allowed-clients-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -98,7 +99,7 @@ func createGaiaAllowedClients(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create AllowedClients - Map = ", payload)
 
-    addAllowedClientsRes, err := client.ApiCallSimple("set-allowed-clients", payload)
+    addAllowedClientsRes, err := apiCallSimple(client, "set-allowed-clients", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addAllowedClientsRes.Success
@@ -149,7 +150,7 @@ func readGaiaAllowedClients(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showAllowedClientsRes, err := client.ApiCallSimple("show-allowed-clients", payload)
+    showAllowedClientsRes, err := apiCallSimple(client, "show-allowed-clients", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showAllowedClientsRes.Success
@@ -260,7 +261,7 @@ func updateGaiaAllowedClients(d *schema.ResourceData, m interface{}) error {
         payload["allowed-any-host"] = v.(bool)
     }
 
-    setAllowedClientsRes, err := client.ApiCallSimple("set-allowed-clients", payload)
+    setAllowedClientsRes, err := apiCallSimple(client, "set-allowed-clients", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setAllowedClientsRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaArp() *schema.Resource {
         Read:   readGaiaArp,
         Update: updateGaiaArp,
         Delete: deleteGaiaArp,
+        Importer: importGaiaResource("arp-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -182,7 +183,7 @@ func createGaiaArp(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Arp - Map = ", payload)
 
-    addArpRes, err := client.ApiCallSimple("set-arp", payload)
+    addArpRes, err := apiCallSimple(client, "set-arp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addArpRes.Success
@@ -233,7 +234,7 @@ func readGaiaArp(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showArpRes, err := client.ApiCallSimple("show-arp", payload)
+    showArpRes, err := apiCallSimple(client, "show-arp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showArpRes.Success
@@ -424,7 +425,7 @@ func updateGaiaArp(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setArpRes, err := client.ApiCallSimple("set-arp", payload)
+    setArpRes, err := apiCallSimple(client, "set-arp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setArpRes.Success
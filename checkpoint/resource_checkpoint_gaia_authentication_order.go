@@ -15,6 +15,7 @@ func resourceGaiaAuthenticationOrder() *schema.Resource {
         Read:   readGaiaAuthenticationOrder,
         Update: updateGaiaAuthenticationOrder,
         Delete: deleteGaiaAuthenticationOrder,
+        Importer: importGaiaResource("authentication-order-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -132,7 +133,7 @@ func createGaiaAuthenticationOrder(d *schema.ResourceData, m interface{}) error
 
     log.Println("Create AuthenticationOrder - Map = ", payload)
 
-    addAuthenticationOrderRes, err := client.ApiCallSimple("set-authentication-order", payload)
+    addAuthenticationOrderRes, err := apiCallSimple(client, "set-authentication-order", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addAuthenticationOrderRes.Success
@@ -183,7 +184,7 @@ func readGaiaAuthenticationOrder(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showAuthenticationOrderRes, err := client.ApiCallSimple("show-authentication-order", payload)
+    showAuthenticationOrderRes, err := apiCallSimple(client, "show-authentication-order", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showAuthenticationOrderRes.Success
@@ -290,7 +291,7 @@ func updateGaiaAuthenticationOrder(d *schema.ResourceData, m interface{}) error
         }
     }
 
-    setAuthenticationOrderRes, err := client.ApiCallSimple("set-authentication-order", payload)
+    setAuthenticationOrderRes, err := apiCallSimple(client, "set-authentication-order", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setAuthenticationOrderRes.Success
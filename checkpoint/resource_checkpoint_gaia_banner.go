@@ -15,6 +15,7 @@ func resourceGaiaBanner() *schema.Resource {
         Read:   readGaiaBanner,
         Update: updateGaiaBanner,
         Delete: deleteGaiaBanner,
+        Importer: importGaiaResource("banner-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -56,7 +57,7 @@ func createGaiaBanner(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Banner - Map = ", payload)
 
-    addBannerRes, err := client.ApiCallSimple("set-banner", payload)
+    addBannerRes, err := apiCallSimple(client, "set-banner", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addBannerRes.Success
@@ -107,7 +108,7 @@ func readGaiaBanner(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showBannerRes, err := client.ApiCallSimple("show-banner", payload)
+    showBannerRes, err := apiCallSimple(client, "show-banner", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showBannerRes.Success
@@ -184,7 +185,7 @@ func updateGaiaBanner(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setBannerRes, err := client.ApiCallSimple("set-banner", payload)
+    setBannerRes, err := apiCallSimple(client, "set-banner", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setBannerRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaBgpConfederationPeer() *schema.Resource {
         Read:   readGaiaBgpConfederationPeer,
         Update: updateGaiaBgpConfederationPeer,
         Delete: deleteGaiaBgpConfederationPeer,
+        Importer: importGaiaResource("bgp-confederation-peer-", "peer", "member_as"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -280,7 +281,7 @@ func createGaiaBgpConfederationPeer(d *schema.ResourceData, m interface{}) error
 
     log.Println("Create BgpConfederationPeer - Map = ", payload)
 
-    addBgpConfederationPeerRes, err := client.ApiCallSimple("add-bgp-confederation-peer", payload)
+    addBgpConfederationPeerRes, err := apiCallSimple(client, "add-bgp-confederation-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addBgpConfederationPeerRes.Success
@@ -334,7 +335,7 @@ func readGaiaBgpConfederationPeer(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showBgpConfederationPeerRes, err := client.ApiCallSimple("show-configuration-bgp-confederation-peer", payload)
+    showBgpConfederationPeerRes, err := apiCallSimple(client, "show-configuration-bgp-confederation-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showBgpConfederationPeerRes.Success
@@ -611,7 +612,7 @@ func updateGaiaBgpConfederationPeer(d *schema.ResourceData, m interface{}) error
         payload["weight"] = v.(int)
     }
 
-    setBgpConfederationPeerRes, err := client.ApiCallSimple("set-bgp-confederation-peer", payload)
+    setBgpConfederationPeerRes, err := apiCallSimple(client, "set-bgp-confederation-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setBgpConfederationPeerRes.Success
@@ -662,7 +663,7 @@ func deleteGaiaBgpConfederationPeer(d *schema.ResourceData, m interface{}) error
         payload["member-as"] = v.(string)
     }
 
-    deleteBgpConfederationPeerRes, err := client.ApiCallSimple("delete-bgp-confederation-peer", payload)
+    deleteBgpConfederationPeerRes, err := apiCallSimple(client, "delete-bgp-confederation-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteBgpConfederationPeerRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaBgpExternalPeer() *schema.Resource {
         Read:   readGaiaBgpExternalPeer,
         Update: updateGaiaBgpExternalPeer,
         Delete: deleteGaiaBgpExternalPeer,
+        Importer: importGaiaResource("bgp-external-peer-", "peer", "remote_as"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -654,7 +655,7 @@ func createGaiaBgpExternalPeer(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create BgpExternalPeer - Map = ", payload)
 
-    addBgpExternalPeerRes, err := client.ApiCallSimple("add-bgp-external-peer", payload)
+    addBgpExternalPeerRes, err := apiCallSimple(client, "add-bgp-external-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addBgpExternalPeerRes.Success
@@ -708,7 +709,7 @@ func readGaiaBgpExternalPeer(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showBgpExternalPeerRes, err := client.ApiCallSimple("show-configuration-bgp-external-peer", payload)
+    showBgpExternalPeerRes, err := apiCallSimple(client, "show-configuration-bgp-external-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showBgpExternalPeerRes.Success
@@ -1212,7 +1213,7 @@ func updateGaiaBgpExternalPeer(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setBgpExternalPeerRes, err := client.ApiCallSimple("set-bgp-external-peer", payload)
+    setBgpExternalPeerRes, err := apiCallSimple(client, "set-bgp-external-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setBgpExternalPeerRes.Success
@@ -1263,7 +1264,7 @@ func deleteGaiaBgpExternalPeer(d *schema.ResourceData, m interface{}) error {
         payload["remote-as"] = v.(string)
     }
 
-    deleteBgpExternalPeerRes, err := client.ApiCallSimple("delete-bgp-external-peer", payload)
+    deleteBgpExternalPeerRes, err := apiCallSimple(client, "delete-bgp-external-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteBgpExternalPeerRes.Success
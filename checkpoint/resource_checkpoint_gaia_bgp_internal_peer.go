@@ -15,6 +15,7 @@ func resourceGaiaBgpInternalPeer() *schema.Resource {
         Read:   readGaiaBgpInternalPeer,
         Update: updateGaiaBgpInternalPeer,
         Delete: deleteGaiaBgpInternalPeer,
+        Importer: importGaiaResource("bgp-internal-peer-", "peer"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -405,7 +406,7 @@ func createGaiaBgpInternalPeer(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create BgpInternalPeer - Map = ", payload)
 
-    addBgpInternalPeerRes, err := client.ApiCallSimple("add-bgp-internal-peer", payload)
+    addBgpInternalPeerRes, err := apiCallSimple(client, "add-bgp-internal-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addBgpInternalPeerRes.Success
@@ -458,7 +459,7 @@ func readGaiaBgpInternalPeer(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showBgpInternalPeerRes, err := client.ApiCallSimple("show-configuration-bgp-internal-peer", payload)
+    showBgpInternalPeerRes, err := apiCallSimple(client, "show-configuration-bgp-internal-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showBgpInternalPeerRes.Success
@@ -797,7 +798,7 @@ func updateGaiaBgpInternalPeer(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setBgpInternalPeerRes, err := client.ApiCallSimple("set-bgp-internal-peer", payload)
+    setBgpInternalPeerRes, err := apiCallSimple(client, "set-bgp-internal-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setBgpInternalPeerRes.Success
@@ -844,7 +845,7 @@ func deleteGaiaBgpInternalPeer(d *schema.ResourceData, m interface{}) error {
         payload["peer"] = v.(string)
     }
 
-    deleteBgpInternalPeerRes, err := client.ApiCallSimple("delete-bgp-internal-peer", payload)
+    deleteBgpInternalPeerRes, err := apiCallSimple(client, "delete-bgp-internal-peer", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteBgpInternalPeerRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaBondInterface() *schema.Resource {
         Read:   readGaiaBondInterface,
         Update: updateGaiaBondInterface,
         Delete: deleteGaiaBondInterface,
+        Importer: importGaiaResource("bond-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -537,7 +538,7 @@ func createGaiaBondInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create BondInterface - Map = ", payload)
 
-    addBondInterfaceRes, err := client.ApiCallSimple("add-bond-interface", payload)
+    addBondInterfaceRes, err := apiCallSimple(client, "add-bond-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addBondInterfaceRes.Success
@@ -593,7 +594,7 @@ func createGaiaBondInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setBondInterfaceRes, err := client.ApiCallSimple("set-bond-interface", updatePayload)
+        setBondInterfaceRes, err := apiCallSimple(client, "set-bond-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for bond-interface: %v", err)
         }
@@ -622,7 +623,7 @@ func readGaiaBondInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showBondInterfaceRes, err := client.ApiCallSimple("show-bond-interface", payload)
+    showBondInterfaceRes, err := apiCallSimple(client, "show-bond-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showBondInterfaceRes.Success
@@ -1037,7 +1038,7 @@ func updateGaiaBondInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    setBondInterfaceRes, err := client.ApiCallSimple("set-bond-interface", payload)
+    setBondInterfaceRes, err := apiCallSimple(client, "set-bond-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setBondInterfaceRes.Success
@@ -1085,7 +1086,7 @@ func deleteGaiaBondInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    deleteBondInterfaceRes, err := client.ApiCallSimple("delete-bond-interface", payload)
+    deleteBondInterfaceRes, err := apiCallSimple(client, "delete-bond-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteBondInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaBridgeInterface() *schema.Resource {
         Read:   readGaiaBridgeInterface,
         Update: updateGaiaBridgeInterface,
         Delete: deleteGaiaBridgeInterface,
+        Importer: importGaiaResource("bridge-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -316,7 +317,7 @@ func createGaiaBridgeInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create BridgeInterface - Map = ", payload)
 
-    addBridgeInterfaceRes, err := client.ApiCallSimple("add-bridge-interface", payload)
+    addBridgeInterfaceRes, err := apiCallSimple(client, "add-bridge-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addBridgeInterfaceRes.Success
@@ -372,7 +373,7 @@ func createGaiaBridgeInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setBridgeInterfaceRes, err := client.ApiCallSimple("set-bridge-interface", updatePayload)
+        setBridgeInterfaceRes, err := apiCallSimple(client, "set-bridge-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for bridge-interface: %v", err)
         }
@@ -401,7 +402,7 @@ func readGaiaBridgeInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showBridgeInterfaceRes, err := client.ApiCallSimple("show-bridge-interface", payload)
+    showBridgeInterfaceRes, err := apiCallSimple(client, "show-bridge-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showBridgeInterfaceRes.Success
@@ -658,7 +659,7 @@ func updateGaiaBridgeInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    setBridgeInterfaceRes, err := client.ApiCallSimple("set-bridge-interface", payload)
+    setBridgeInterfaceRes, err := apiCallSimple(client, "set-bridge-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setBridgeInterfaceRes.Success
@@ -706,7 +707,7 @@ func deleteGaiaBridgeInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    deleteBridgeInterfaceRes, err := client.ApiCallSimple("delete-bridge-interface", payload)
+    deleteBridgeInterfaceRes, err := apiCallSimple(client, "delete-bridge-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteBridgeInterfaceRes.Success
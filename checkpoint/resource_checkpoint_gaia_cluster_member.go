@@ -14,6 +14,7 @@ func resourceGaiaClusterMember() *schema.Resource {
         Create: createGaiaClusterMember,
         Read:   readGaiaClusterMember,
         Delete: deleteGaiaClusterMember,
+        Importer: importGaiaResource("cluster-member-", "method", "identifier"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -127,7 +128,7 @@ func createGaiaClusterMember(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create ClusterMember - Map = ", payload)
 
-    addClusterMemberRes, err := client.ApiCallSimple("add-cluster-member", payload)
+    addClusterMemberRes, err := apiCallSimple(client, "add-cluster-member", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addClusterMemberRes.Success
@@ -174,7 +175,7 @@ func readGaiaClusterMember(d *schema.ResourceData, m interface{}) error {
 
     payload := map[string]interface{}{}
 
-    showClusterMemberRes, err := client.ApiCallSimple("show-cluster-members", payload)
+    showClusterMemberRes, err := apiCallSimple(client, "show-cluster-members", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showClusterMemberRes.Success
@@ -250,6 +251,7 @@ func readGaiaClusterMember(d *schema.ResourceData, m interface{}) error {
                         "installed_jumbo_take": func() int { if f, ok := item["installed-jumbo-take"].(float64); ok { return int(f) }; return 0 }(),
                     }
                     d.Set("member", []interface{}{memberMap})
+                    d.Set("site_id", memberMap["site_id"])
                     found = true
                     break
                 }
@@ -279,7 +281,7 @@ func deleteGaiaClusterMember(d *schema.ResourceData, m interface{}) error {
         payload["identifier"] = v.(string)
     }
 
-    deleteClusterMemberRes, err := client.ApiCallSimple("delete-cluster-member", payload)
+    deleteClusterMemberRes, err := apiCallSimple(client, "delete-cluster-member", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteClusterMemberRes.Success
@@ -272,7 +272,7 @@ func createGaiaApplyMaestroSecurityGroupsChanges(d *schema.ResourceData, m inter
 
     log.Println("Execute apply-maestro-security-groups-changes - Payload = ", payload)
 
-    GaiaApplyMaestroSecurityGroupsChangesRes, err := client.ApiCallSimple("apply-maestro-security-groups-changes", payload)
+    GaiaApplyMaestroSecurityGroupsChangesRes, err := apiCallSimple(client, "apply-maestro-security-groups-changes", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaApplyMaestroSecurityGroupsChangesRes.Success
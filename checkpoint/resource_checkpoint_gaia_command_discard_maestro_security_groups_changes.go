@@ -36,7 +36,7 @@ func createGaiaDiscardMaestroSecurityGroupsChanges(d *schema.ResourceData, m int
 
     log.Println("Execute discard-maestro-security-groups-changes - Payload = ", payload)
 
-    GaiaDiscardMaestroSecurityGroupsChangesRes, err := client.ApiCallSimple("discard-maestro-security-groups-changes", payload)
+    GaiaDiscardMaestroSecurityGroupsChangesRes, err := apiCallSimple(client, "discard-maestro-security-groups-changes", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaDiscardMaestroSecurityGroupsChangesRes.Success
@@ -61,7 +61,7 @@ func createGaiaGetFile(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute get-file - Payload = ", payload)
 
-    GaiaGetFileRes, err := client.ApiCallSimple("get-file", payload)
+    GaiaGetFileRes, err := apiCallSimple(client, "get-file", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaGetFileRes.Success
@@ -130,7 +130,7 @@ func readGaiaGetFile(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute get-file - Payload = ", payload)
 
-    GaiaGetFileRes, err := client.ApiCallSimple("get-file", payload)
+    GaiaGetFileRes, err := apiCallSimple(client, "get-file", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaGetFileRes.Success
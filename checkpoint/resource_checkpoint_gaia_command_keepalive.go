@@ -36,7 +36,7 @@ func createGaiaKeepalive(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute keepalive - Payload = ", payload)
 
-    GaiaKeepaliveRes, err := client.ApiCallSimple("keepalive", payload)
+    GaiaKeepaliveRes, err := apiCallSimple(client, "keepalive", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaKeepaliveRes.Success
@@ -90,7 +90,7 @@ func readGaiaKeepalive(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute keepalive - Payload = ", payload)
 
-    GaiaKeepaliveRes, err := client.ApiCallSimple("keepalive", payload)
+    GaiaKeepaliveRes, err := apiCallSimple(client, "keepalive", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaKeepaliveRes.Success
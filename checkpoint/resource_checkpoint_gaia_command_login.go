@@ -131,7 +131,7 @@ func createGaiaLogin(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute login - Payload = ", payload)
 
-    GaiaLoginRes, err := client.ApiCallSimple("login", payload)
+    GaiaLoginRes, err := apiCallSimple(client, "login", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaLoginRes.Success
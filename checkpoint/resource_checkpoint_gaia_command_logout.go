@@ -36,7 +36,7 @@ func createGaiaLogout(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute logout - Payload = ", payload)
 
-    GaiaLogoutRes, err := client.ApiCallSimple("logout", payload)
+    GaiaLogoutRes, err := apiCallSimple(client, "logout", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaLogoutRes.Success
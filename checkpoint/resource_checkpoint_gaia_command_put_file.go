@@ -96,7 +96,7 @@ func createGaiaPutFile(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute put-file - Payload = ", payload)
 
-    GaiaPutFileRes, err := client.ApiCallSimple("put-file", payload)
+    GaiaPutFileRes, err := apiCallSimple(client, "put-file", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaPutFileRes.Success
@@ -174,7 +174,7 @@ func readGaiaPutFile(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute put-file - Payload = ", payload)
 
-    GaiaPutFileRes, err := client.ApiCallSimple("put-file", payload)
+    GaiaPutFileRes, err := apiCallSimple(client, "put-file", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaPutFileRes.Success
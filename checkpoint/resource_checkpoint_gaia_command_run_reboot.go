@@ -36,7 +36,7 @@ func createGaiaRunReboot(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute run-reboot - Payload = ", payload)
 
-    GaiaRunRebootRes, err := client.ApiCallSimple("run-reboot", payload)
+    GaiaRunRebootRes, err := apiCallSimple(client, "run-reboot", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaRunRebootRes.Success
@@ -7,6 +7,7 @@ import (
     "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
     "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
     "log"
+    "strings"
 )
 func dataGaiaRunScript() *schema.Resource {   
     return &schema.Resource{
@@ -60,6 +61,13 @@ func dataGaiaRunScript() *schema.Resource {
                     },
                 },
             },
+            "fail_on_error": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                ForceNew:    true,
+                Default:     false,
+                Description: `Fail when the script exited with a non-zero exit code. The resource is kept in the state with the script output.`,
+            },
             "return_value": {
                 Type:        schema.TypeInt,
                 Computed:    true,
@@ -75,6 +83,21 @@ func dataGaiaRunScript() *schema.Resource {
                 Computed:    true,
                 Description: `N/A`,
             },
+            "exit_code": {
+                Type:        schema.TypeInt,
+                Computed:    true,
+                Description: `Script exit code.`,
+            },
+            "stdout": {
+                Type:        schema.TypeString,
+                Computed:    true,
+                Description: `Script standard output, decoded.`,
+            },
+            "stderr": {
+                Type:        schema.TypeString,
+                Computed:    true,
+                Description: `Script standard error, decoded.`,
+            },
         },
     }
 }
@@ -119,7 +142,7 @@ func createGaiaRunScript(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute run-script - Payload = ", payload)
 
-    GaiaRunScriptRes, err := client.ApiCallSimple("run-script", payload)
+    GaiaRunScriptRes, err := apiCallSimple(client, "run-script", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaRunScriptRes.Success
@@ -167,7 +190,7 @@ func createGaiaRunScript(d *schema.ResourceData, m interface{}) error {
         return fmt.Errorf(msg)
     }
 
-    _taskDetailsRes, _tdErr := client.ApiCallSimple("show-task", map[string]interface{}{"task-id": taskRes.TaskID})
+    _taskDetailsRes, _tdErr := apiCallSimple(client, "show-task", map[string]interface{}{"task-id": taskRes.TaskID})
     var _respData map[string]interface{}
     if _tdErr == nil && _taskDetailsRes.Success {
         _td := _taskDetailsRes.GetData()
@@ -184,9 +207,11 @@ func createGaiaRunScript(d *schema.ResourceData, m interface{}) error {
     if _respData == nil {
         _respData = GaiaRunScriptRes.GetData()
     }
+    exitCode := 0
     if v, exists := _respData["return-value"]; exists {
         if f, ok := v.(float64); ok {
             d.Set("return_value", int(f))
+            exitCode = int(f)
         }
     }
     if v, exists := _respData["output"]; exists {
@@ -195,9 +220,15 @@ func createGaiaRunScript(d *schema.ResourceData, m interface{}) error {
     if v, exists := _respData["error"]; exists {
         d.Set("error", toString(v))
     }
-
+    stderr := decodeScriptOutput(toString(_respData["error"]))
+    d.Set("exit_code", exitCode)
+    d.Set("stdout", decodeScriptOutput(toString(_respData["output"])))
+    d.Set("stderr", stderr)
 
     d.SetId(fmt.Sprintf("run-script-" + acctest.RandString(10)))
+    if d.Get("fail_on_error").(bool) && exitCode != 0 {
+        return fmt.Errorf("run-script exited with exit code %d: %s", exitCode, strings.TrimSpace(stderr))
+    }
     return nil
 }
 
@@ -332,13 +332,13 @@ func createGaiaSetBgp(d *schema.ResourceData, m interface{}) error {
     log.Println("Execute set-bgp - Payload = ", payload)
 
     // Pre-create cleanup: ensure no stale BGP state from a prior failed run.
-    client.ApiCallSimple("set-bgp", map[string]interface{}{
+    apiCallSimple(client, "set-bgp", map[string]interface{}{
         "as": "off",
     })
-    client.ApiCallSimple("set-bgp", map[string]interface{}{
+    apiCallSimple(client, "set-bgp", map[string]interface{}{
         "confederation": map[string]interface{}{"identifier": "off"},
     })
-    GaiaSetBgpRes, err := client.ApiCallSimple("set-bgp", payload)
+    GaiaSetBgpRes, err := apiCallSimple(client, "set-bgp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetBgpRes.Success
@@ -377,7 +377,7 @@ func createGaiaSetBgp(d *schema.ResourceData, m interface{}) error {
 
 
     // Flush configuration so subsequent sessions see this change immediately.
-    if _, saveErr := client.ApiCallSimple("save-to-network", map[string]interface{}{}); saveErr != nil {
+    if _, saveErr := apiCallSimple(client, "save-to-network", map[string]interface{}{}); saveErr != nil {
         log.Printf("[WARN] save-to-network after successful create failed: %v", saveErr)
     }
 
@@ -393,11 +393,11 @@ func deleteGaiaSetBgp(d *schema.ResourceData, m interface{}) error {
     client := m.(*checkpoint.ApiClient)
     ensureDebugServerFromClient(client)
     // Clear confederation identifier before clearing AS (device enforces ordering).
-    client.ApiCallSimple("set-bgp", map[string]interface{}{
+    apiCallSimple(client, "set-bgp", map[string]interface{}{
         "confederation": map[string]interface{}{"identifier": "off"},
     })
     // Clear AS.
-    client.ApiCallSimple("set-bgp", map[string]interface{}{
+    apiCallSimple(client, "set-bgp", map[string]interface{}{
         "as": "off",
     })
     d.SetId("")
@@ -145,7 +145,7 @@ func createGaiaSetBgpConfederation(d *schema.ResourceData, m interface{}) error
 
     log.Println("Execute set-bgp-confederation - Payload = ", payload)
 
-    GaiaSetBgpConfederationRes, err := client.ApiCallSimple("set-bgp-confederation", payload)
+    GaiaSetBgpConfederationRes, err := apiCallSimple(client, "set-bgp-confederation", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetBgpConfederationRes.Success
@@ -184,7 +184,7 @@ func createGaiaSetBgpConfederation(d *schema.ResourceData, m interface{}) error
 
 
     // Flush configuration so subsequent sessions see this change immediately.
-    if _, saveErr := client.ApiCallSimple("save-to-network", map[string]interface{}{}); saveErr != nil {
+    if _, saveErr := apiCallSimple(client, "save-to-network", map[string]interface{}{}); saveErr != nil {
         log.Printf("[WARN] save-to-network after successful create failed: %v", saveErr)
     }
 
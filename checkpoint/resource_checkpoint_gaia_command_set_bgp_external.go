@@ -281,7 +281,7 @@ func createGaiaSetBgpExternal(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-bgp-external - Payload = ", payload)
 
-    GaiaSetBgpExternalRes, err := client.ApiCallSimple("set-bgp-external", payload)
+    GaiaSetBgpExternalRes, err := apiCallSimple(client, "set-bgp-external", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetBgpExternalRes.Success
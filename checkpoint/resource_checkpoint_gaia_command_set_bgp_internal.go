@@ -280,7 +280,7 @@ func createGaiaSetBgpInternal(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-bgp-internal - Payload = ", payload)
 
-    GaiaSetBgpInternalRes, err := client.ApiCallSimple("set-bgp-internal", payload)
+    GaiaSetBgpInternalRes, err := apiCallSimple(client, "set-bgp-internal", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetBgpInternalRes.Success
@@ -1963,7 +1963,7 @@ func createGaiaSetDynamicContent(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-dynamic-content - Payload = ", payload)
 
-    GaiaSetDynamicContentRes, err := client.ApiCallSimple("set-dynamic-content", payload)
+    GaiaSetDynamicContentRes, err := apiCallSimple(client, "set-dynamic-content", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetDynamicContentRes.Success
@@ -2011,7 +2011,7 @@ func createGaiaSetDynamicContent(d *schema.ResourceData, m interface{}) error {
         return fmt.Errorf(msg)
     }
 
-    _taskDetailsRes, _tdErr := client.ApiCallSimple("show-task", map[string]interface{}{"task-id": taskRes.TaskID})
+    _taskDetailsRes, _tdErr := apiCallSimple(client, "show-task", map[string]interface{}{"task-id": taskRes.TaskID})
     var _respData map[string]interface{}
     if _tdErr == nil && _taskDetailsRes.Success {
         _td := _taskDetailsRes.GetData()
@@ -277,7 +277,7 @@ func createGaiaSetInitialSetup(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-initial-setup - Payload = ", payload)
 
-    GaiaSetInitialSetupRes, err := client.ApiCallSimple("set-initial-setup", payload)
+    GaiaSetInitialSetupRes, err := apiCallSimple(client, "set-initial-setup", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetInitialSetupRes.Success
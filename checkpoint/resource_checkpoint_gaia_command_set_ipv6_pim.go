@@ -398,7 +398,7 @@ func createGaiaSetIpv6Pim(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-ipv6-pim - Payload = ", payload)
 
-    GaiaSetIpv6PimRes, err := client.ApiCallSimple("set-ipv6-pim", payload)
+    GaiaSetIpv6PimRes, err := apiCallSimple(client, "set-ipv6-pim", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetIpv6PimRes.Success
@@ -893,7 +893,7 @@ func createGaiaSetIsis(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-isis - Payload = ", payload)
 
-    GaiaSetIsisRes, err := client.ApiCallSimple("set-isis", payload)
+    GaiaSetIsisRes, err := apiCallSimple(client, "set-isis", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetIsisRes.Success
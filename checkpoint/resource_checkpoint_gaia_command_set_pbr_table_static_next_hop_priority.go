@@ -97,7 +97,7 @@ func createGaiaSetPbrTableStaticNextHopPriority(d *schema.ResourceData, m interf
 
     log.Println("Execute set-pbr-table-static-next-hop-priority - Payload = ", payload)
 
-    GaiaSetPbrTableStaticNextHopPriorityRes, err := client.ApiCallSimple("set-pbr-table-static-next-hop-priority", payload)
+    GaiaSetPbrTableStaticNextHopPriorityRes, err := apiCallSimple(client, "set-pbr-table-static-next-hop-priority", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetPbrTableStaticNextHopPriorityRes.Success
@@ -158,7 +158,7 @@ func readGaiaSetPbrTableStaticNextHopPriority(d *schema.ResourceData, m interfac
 
     log.Println("Read set-pbr-table-static-next-hop-priority - show-pbr-table payload = ", payload)
 
-    showRes, err := client.ApiCallSimple("show-pbr-table", payload)
+    showRes, err := apiCallSimple(client, "show-pbr-table", payload)
     if err != nil {
         return fmt.Errorf("Failed to show pbr-table: %v", err)
     }
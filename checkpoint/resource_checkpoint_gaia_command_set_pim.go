@@ -440,7 +440,7 @@ func createGaiaSetPim(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-pim - Payload = ", payload)
 
-    GaiaSetPimRes, err := client.ApiCallSimple("set-pim", payload)
+    GaiaSetPimRes, err := apiCallSimple(client, "set-pim", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetPimRes.Success
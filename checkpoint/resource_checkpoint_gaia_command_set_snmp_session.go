@@ -130,7 +130,7 @@ func createGaiaSetSnmpSession(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute set-snmp-session - Payload = ", payload)
 
-    GaiaSetSnmpSessionRes, err := client.ApiCallSimple("set-snmp-session", payload)
+    GaiaSetSnmpSessionRes, err := apiCallSimple(client, "set-snmp-session", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetSnmpSessionRes.Success
@@ -106,7 +106,7 @@ func createGaiaSetStaticMrouteNextHopPriority(d *schema.ResourceData, m interfac
 
     log.Println("Execute set-static-mroute-next-hop-priority - Payload = ", payload)
 
-    GaiaSetStaticMrouteNextHopPriorityRes, err := client.ApiCallSimple("set-static-mroute-next-hop-priority", payload)
+    GaiaSetStaticMrouteNextHopPriorityRes, err := apiCallSimple(client, "set-static-mroute-next-hop-priority", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetStaticMrouteNextHopPriorityRes.Success
@@ -186,7 +186,7 @@ func readGaiaSetStaticMrouteNextHopPriority(d *schema.ResourceData, m interface{
 
     log.Println("Read set-static-mroute-next-hop-priority - show-static-mroute payload = ", payload)
 
-    showRes, err := client.ApiCallSimple("show-static-mroute", payload)
+    showRes, err := apiCallSimple(client, "show-static-mroute", payload)
     if err != nil {
         return fmt.Errorf("Failed to show static-mroute: %v", err)
     }
@@ -130,7 +130,7 @@ func createGaiaSetStaticRouteNextHopPriority(d *schema.ResourceData, m interface
 
     log.Println("Execute set-static-route-next-hop-priority - Payload = ", payload)
 
-    GaiaSetStaticRouteNextHopPriorityRes, err := client.ApiCallSimple("set-static-route-next-hop-priority", payload)
+    GaiaSetStaticRouteNextHopPriorityRes, err := apiCallSimple(client, "set-static-route-next-hop-priority", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSetStaticRouteNextHopPriorityRes.Success
@@ -227,7 +227,7 @@ func readGaiaSetStaticRouteNextHopPriority(d *schema.ResourceData, m interface{}
 
     log.Println("Read set-static-route-next-hop-priority - show-static-route payload = ", payload)
 
-    showRes, err := client.ApiCallSimple("show-static-route", payload)
+    showRes, err := apiCallSimple(client, "show-static-route", payload)
     if err != nil {
         return fmt.Errorf("Failed to show static-route: %v", err)
     }
@@ -806,7 +806,7 @@ func createGaiaSimulatePacket(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Execute simulate-packet - Payload = ", payload)
 
-    GaiaSimulatePacketRes, err := client.ApiCallSimple("simulate-packet", payload)
+    GaiaSimulatePacketRes, err := apiCallSimple(client, "simulate-packet", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && GaiaSimulatePacketRes.Success
@@ -854,7 +854,7 @@ func createGaiaSimulatePacket(d *schema.ResourceData, m interface{}) error {
         return fmt.Errorf(msg)
     }
 
-    _taskDetailsRes, _tdErr := client.ApiCallSimple("show-task", map[string]interface{}{"task-id": taskRes.TaskID})
+    _taskDetailsRes, _tdErr := apiCallSimple(client, "show-task", map[string]interface{}{"task-id": taskRes.TaskID})
     var _respData map[string]interface{}
     if _tdErr == nil && _taskDetailsRes.Success {
         _td := _taskDetailsRes.GetData()
@@ -16,6 +16,7 @@ func resourceGaiaCustomIntelligenceFeed() *schema.Resource {
         Read:   readGaiaCustomIntelligenceFeed,
         Update: updateGaiaCustomIntelligenceFeed,
         Delete: deleteGaiaCustomIntelligenceFeed,
+        Importer: importGaiaResource("custom-intelligence-feed-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -211,7 +212,7 @@ func createGaiaCustomIntelligenceFeed(d *schema.ResourceData, m interface{}) err
 
     log.Println("Create CustomIntelligenceFeed - Map = ", payload)
 
-    addCustomIntelligenceFeedRes, err := client.ApiCallSimple("add-custom-intelligence-feed", payload)
+    addCustomIntelligenceFeedRes, err := apiCallSimple(client, "add-custom-intelligence-feed", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addCustomIntelligenceFeedRes.Success
@@ -278,7 +279,7 @@ func readGaiaCustomIntelligenceFeed(d *schema.ResourceData, m interface{}) error
         payload["member-id"] = v.(string)
     }
 
-    showCustomIntelligenceFeedRes, err := client.ApiCallSimple("show-custom-intelligence-feed", payload)
+    showCustomIntelligenceFeedRes, err := apiCallSimple(client, "show-custom-intelligence-feed", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showCustomIntelligenceFeedRes.Success
@@ -452,7 +453,7 @@ func updateGaiaCustomIntelligenceFeed(d *schema.ResourceData, m interface{}) err
         payload["https-sha256-fingerprint"] = v.(string)
     }
 
-    setCustomIntelligenceFeedRes, err := client.ApiCallSimple("set-custom-intelligence-feed", payload)
+    setCustomIntelligenceFeedRes, err := apiCallSimple(client, "set-custom-intelligence-feed", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setCustomIntelligenceFeedRes.Success
@@ -499,7 +500,7 @@ func deleteGaiaCustomIntelligenceFeed(d *schema.ResourceData, m interface{}) err
         payload["name"] = v.(string)
     }
 
-    deleteCustomIntelligenceFeedRes, err := client.ApiCallSimple("delete-custom-intelligence-feed", payload)
+    deleteCustomIntelligenceFeedRes, err := apiCallSimple(client, "delete-custom-intelligence-feed", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteCustomIntelligenceFeedRes.Success
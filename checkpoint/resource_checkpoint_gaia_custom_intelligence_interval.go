@@ -15,6 +15,7 @@ func resourceGaiaCustomIntelligenceInterval() *schema.Resource {
         Read:   readGaiaCustomIntelligenceInterval,
         Update: updateGaiaCustomIntelligenceInterval,
         Delete: deleteGaiaCustomIntelligenceInterval,
+        Importer: importGaiaResource("custom-intelligence-interval-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaCustomIntelligenceInterval(d *schema.ResourceData, m interface{})
 
     log.Println("Create CustomIntelligenceInterval - Map = ", payload)
 
-    addCustomIntelligenceIntervalRes, err := client.ApiCallSimple("set-custom-intelligence-interval", payload)
+    addCustomIntelligenceIntervalRes, err := apiCallSimple(client, "set-custom-intelligence-interval", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addCustomIntelligenceIntervalRes.Success
@@ -98,7 +99,7 @@ func readGaiaCustomIntelligenceInterval(d *schema.ResourceData, m interface{}) e
         payload["member-id"] = v.(string)
     }
 
-    showCustomIntelligenceIntervalRes, err := client.ApiCallSimple("show-custom-intelligence-interval", payload)
+    showCustomIntelligenceIntervalRes, err := apiCallSimple(client, "show-custom-intelligence-interval", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showCustomIntelligenceIntervalRes.Success
@@ -171,7 +172,7 @@ func updateGaiaCustomIntelligenceInterval(d *schema.ResourceData, m interface{})
         payload["interval"] = v.(int)
     }
 
-    setCustomIntelligenceIntervalRes, err := client.ApiCallSimple("set-custom-intelligence-interval", payload)
+    setCustomIntelligenceIntervalRes, err := apiCallSimple(client, "set-custom-intelligence-interval", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setCustomIntelligenceIntervalRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaDhcp6Config() *schema.Resource {
         Read:   readGaiaDhcp6Config,
         Update: updateGaiaDhcp6Config,
         Delete: deleteGaiaDhcp6Config,
+        Importer: importGaiaResource("dhcp6-config-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -127,7 +128,7 @@ func createGaiaDhcp6Config(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Dhcp6Config - Map = ", payload)
 
-    addDhcp6ConfigRes, err := client.ApiCallSimple("set-dhcp6-config", payload)
+    addDhcp6ConfigRes, err := apiCallSimple(client, "set-dhcp6-config", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addDhcp6ConfigRes.Success
@@ -178,7 +179,7 @@ func readGaiaDhcp6Config(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showDhcp6ConfigRes, err := client.ApiCallSimple("show-dhcp6-config", payload)
+    showDhcp6ConfigRes, err := apiCallSimple(client, "show-dhcp6-config", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showDhcp6ConfigRes.Success
@@ -283,7 +284,7 @@ func updateGaiaDhcp6Config(d *schema.ResourceData, m interface{}) error {
         payload["client-mode"] = v.(string)
     }
 
-    setDhcp6ConfigRes, err := client.ApiCallSimple("set-dhcp6-config", payload)
+    setDhcp6ConfigRes, err := apiCallSimple(client, "set-dhcp6-config", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setDhcp6ConfigRes.Success
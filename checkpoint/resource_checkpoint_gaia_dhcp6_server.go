@@ -15,6 +15,7 @@ func resourceGaiaDhcp6Server() *schema.Resource {
         Read:   readGaiaDhcp6Server,
         Update: updateGaiaDhcp6Server,
         Delete: deleteGaiaDhcp6Server,
+        Importer: importGaiaResource("dhcp6-server-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -215,7 +216,7 @@ func createGaiaDhcp6Server(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Dhcp6Server - Map = ", payload)
 
-    addDhcp6ServerRes, err := client.ApiCallSimple("set-dhcp6-server", payload)
+    addDhcp6ServerRes, err := apiCallSimple(client, "set-dhcp6-server", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addDhcp6ServerRes.Success
@@ -266,7 +267,7 @@ func readGaiaDhcp6Server(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showDhcp6ServerRes, err := client.ApiCallSimple("show-dhcp6-server", payload)
+    showDhcp6ServerRes, err := apiCallSimple(client, "show-dhcp6-server", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showDhcp6ServerRes.Success
@@ -459,7 +460,7 @@ func updateGaiaDhcp6Server(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setDhcp6ServerRes, err := client.ApiCallSimple("set-dhcp6-server", payload)
+    setDhcp6ServerRes, err := apiCallSimple(client, "set-dhcp6-server", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setDhcp6ServerRes.Success
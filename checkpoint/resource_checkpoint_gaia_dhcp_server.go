@@ -15,6 +15,7 @@ func resourceGaiaDhcpServer() *schema.Resource {
         Read:   readGaiaDhcpServer,
         Update: updateGaiaDhcpServer,
         Delete: deleteGaiaDhcpServer,
+        Importer: importGaiaResource("dhcp-server-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -232,7 +233,7 @@ func createGaiaDhcpServer(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create DhcpServer - Map = ", payload)
 
-    addDhcpServerRes, err := client.ApiCallSimple("set-dhcp-server", payload)
+    addDhcpServerRes, err := apiCallSimple(client, "set-dhcp-server", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addDhcpServerRes.Success
@@ -287,7 +288,7 @@ func readGaiaDhcpServer(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showDhcpServerRes, err := client.ApiCallSimple("show-dhcp-server", payload)
+    showDhcpServerRes, err := apiCallSimple(client, "show-dhcp-server", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showDhcpServerRes.Success
@@ -482,7 +483,7 @@ func updateGaiaDhcpServer(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setDhcpServerRes, err := client.ApiCallSimple("set-dhcp-server", payload)
+    setDhcpServerRes, err := apiCallSimple(client, "set-dhcp-server", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setDhcpServerRes.Success
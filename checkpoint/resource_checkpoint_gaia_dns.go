@@ -15,6 +15,7 @@ func resourceGaiaDns() *schema.Resource {
         Read:   readGaiaDns,
         Update: updateGaiaDns,
         Delete: deleteGaiaDns,
+        Importer: importGaiaResource("dns-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -139,7 +140,7 @@ func createGaiaDns(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Dns - Map = ", payload)
 
-    addDnsRes, err := client.ApiCallSimple("set-dns", payload)
+    addDnsRes, err := apiCallSimple(client, "set-dns", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addDnsRes.Success
@@ -194,7 +195,7 @@ func readGaiaDns(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showDnsRes, err := client.ApiCallSimple("show-dns", payload)
+    showDnsRes, err := apiCallSimple(client, "show-dns", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showDnsRes.Success
@@ -330,7 +331,7 @@ func updateGaiaDns(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setDnsRes, err := client.ApiCallSimple("set-dns", payload)
+    setDnsRes, err := apiCallSimple(client, "set-dns", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setDnsRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaExpertPassword() *schema.Resource {
         Read:   readGaiaExpertPassword,
         Update: updateGaiaExpertPassword,
         Delete: deleteGaiaExpertPassword,
+        Importer: importGaiaResource("expert-password-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -58,7 +59,7 @@ func createGaiaExpertPassword(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create ExpertPassword - Map = ", payload)
 
-    addExpertPasswordRes, err := client.ApiCallSimple("set-expert-password", payload)
+    addExpertPasswordRes, err := apiCallSimple(client, "set-expert-password", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addExpertPasswordRes.Success
@@ -109,7 +110,7 @@ func readGaiaExpertPassword(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showExpertPasswordRes, err := client.ApiCallSimple("show-expert-password", payload)
+    showExpertPasswordRes, err := apiCallSimple(client, "show-expert-password", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showExpertPasswordRes.Success
@@ -179,7 +180,7 @@ func updateGaiaExpertPassword(d *schema.ResourceData, m interface{}) error {
         payload["password-hash"] = v.(string)
     }
 
-    setExpertPasswordRes, err := client.ApiCallSimple("set-expert-password", payload)
+    setExpertPasswordRes, err := apiCallSimple(client, "set-expert-password", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setExpertPasswordRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaFips() *schema.Resource {
         Read:   readGaiaFips,
         Update: updateGaiaFips,
         Delete: deleteGaiaFips,
+        Importer: importGaiaResource("fips-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -53,7 +54,7 @@ func createGaiaFips(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Fips - Map = ", payload)
 
-    addFipsRes, err := client.ApiCallSimple("set-fips", payload)
+    addFipsRes, err := apiCallSimple(client, "set-fips", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addFipsRes.Success
@@ -116,7 +117,7 @@ func readGaiaFips(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showFipsRes, err := client.ApiCallSimple("show-fips", payload)
+    showFipsRes, err := apiCallSimple(client, "show-fips", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showFipsRes.Success
@@ -186,7 +187,7 @@ func updateGaiaFips(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setFipsRes, err := client.ApiCallSimple("set-fips", payload)
+    setFipsRes, err := apiCallSimple(client, "set-fips", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setFipsRes.Success
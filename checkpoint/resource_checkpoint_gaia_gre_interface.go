@@ -15,6 +15,7 @@ func resourceGaiaGreInterface() *schema.Resource {
         Read:   readGaiaGreInterface,
         Update: updateGaiaGreInterface,
         Delete: deleteGaiaGreInterface,
+        Importer: importGaiaResource("gre-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -198,7 +199,7 @@ func createGaiaGreInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create GreInterface - Map = ", payload)
 
-    addGreInterfaceRes, err := client.ApiCallSimple("add-gre-interface", payload)
+    addGreInterfaceRes, err := apiCallSimple(client, "add-gre-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addGreInterfaceRes.Success
@@ -264,7 +265,7 @@ func createGaiaGreInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setGreInterfaceRes, err := client.ApiCallSimple("set-gre-interface", updatePayload)
+        setGreInterfaceRes, err := apiCallSimple(client, "set-gre-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for gre-interface: %v", err)
         }
@@ -293,7 +294,7 @@ func readGaiaGreInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showGreInterfaceRes, err := client.ApiCallSimple("show-gre-interface", payload)
+    showGreInterfaceRes, err := apiCallSimple(client, "show-gre-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showGreInterfaceRes.Success
@@ -489,7 +490,7 @@ func updateGaiaGreInterface(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setGreInterfaceRes, err := client.ApiCallSimple("set-gre-interface", payload)
+    setGreInterfaceRes, err := apiCallSimple(client, "set-gre-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setGreInterfaceRes.Success
@@ -537,7 +538,7 @@ func deleteGaiaGreInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    deleteGreInterfaceRes, err := client.ApiCallSimple("delete-gre-interface", payload)
+    deleteGreInterfaceRes, err := apiCallSimple(client, "delete-gre-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteGreInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaGrubPassword() *schema.Resource {
         Read:   readGaiaGrubPassword,
         Update: updateGaiaGrubPassword,
         Delete: deleteGaiaGrubPassword,
+        Importer: importGaiaResource("grub-password-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -58,7 +59,7 @@ func createGaiaGrubPassword(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create GrubPassword - Map = ", payload)
 
-    addGrubPasswordRes, err := client.ApiCallSimple("set-grub-password", payload)
+    addGrubPasswordRes, err := apiCallSimple(client, "set-grub-password", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addGrubPasswordRes.Success
@@ -109,7 +110,7 @@ func readGaiaGrubPassword(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showGrubPasswordRes, err := client.ApiCallSimple("show-grub-password", payload)
+    showGrubPasswordRes, err := apiCallSimple(client, "show-grub-password", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showGrubPasswordRes.Success
@@ -179,7 +180,7 @@ func updateGaiaGrubPassword(d *schema.ResourceData, m interface{}) error {
         payload["password-hash"] = v.(string)
     }
 
-    setGrubPasswordRes, err := client.ApiCallSimple("set-grub-password", payload)
+    setGrubPasswordRes, err := apiCallSimple(client, "set-grub-password", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setGrubPasswordRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaHostname() *schema.Resource {
         Read:   readGaiaHostname,
         Update: updateGaiaHostname,
         Delete: deleteGaiaHostname,
+        Importer: importGaiaResource("hostname-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaHostname(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Hostname - Map = ", payload)
 
-    addHostnameRes, err := client.ApiCallSimple("set-hostname", payload)
+    addHostnameRes, err := apiCallSimple(client, "set-hostname", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addHostnameRes.Success
@@ -98,7 +99,7 @@ func readGaiaHostname(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showHostnameRes, err := client.ApiCallSimple("show-hostname", payload)
+    showHostnameRes, err := apiCallSimple(client, "show-hostname", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showHostnameRes.Success
@@ -161,7 +162,7 @@ func updateGaiaHostname(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    setHostnameRes, err := client.ApiCallSimple("set-hostname", payload)
+    setHostnameRes, err := apiCallSimple(client, "set-hostname", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setHostnameRes.Success
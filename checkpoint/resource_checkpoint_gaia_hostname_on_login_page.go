@@ -15,6 +15,7 @@ func resourceGaiaHostnameOnLoginPage() *schema.Resource {
         Read:   readGaiaHostnameOnLoginPage,
         Update: updateGaiaHostnameOnLoginPage,
         Delete: deleteGaiaHostnameOnLoginPage,
+        Importer: importGaiaResource("hostname-on-login-page-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaHostnameOnLoginPage(d *schema.ResourceData, m interface{}) error
 
     log.Println("Create HostnameOnLoginPage - Map = ", payload)
 
-    addHostnameOnLoginPageRes, err := client.ApiCallSimple("set-hostname-on-login-page", payload)
+    addHostnameOnLoginPageRes, err := apiCallSimple(client, "set-hostname-on-login-page", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addHostnameOnLoginPageRes.Success
@@ -98,7 +99,7 @@ func readGaiaHostnameOnLoginPage(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showHostnameOnLoginPageRes, err := client.ApiCallSimple("show-hostname-on-login-page", payload)
+    showHostnameOnLoginPageRes, err := apiCallSimple(client, "show-hostname-on-login-page", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showHostnameOnLoginPageRes.Success
@@ -165,7 +166,7 @@ func updateGaiaHostnameOnLoginPage(d *schema.ResourceData, m interface{}) error
         payload["enabled"] = v.(bool)
     }
 
-    setHostnameOnLoginPageRes, err := client.ApiCallSimple("set-hostname-on-login-page", payload)
+    setHostnameOnLoginPageRes, err := apiCallSimple(client, "set-hostname-on-login-page", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setHostnameOnLoginPageRes.Success
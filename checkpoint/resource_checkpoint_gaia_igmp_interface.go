@@ -15,6 +15,7 @@ func resourceGaiaIgmpInterface() *schema.Resource {
         Read:   readGaiaIgmpInterface,
         Update: updateGaiaIgmpInterface,
         Delete: deleteGaiaIgmpInterface,
+        Importer: importGaiaResource("igmp-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -110,7 +111,7 @@ func createGaiaIgmpInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create IgmpInterface - Map = ", payload)
 
-    addIgmpInterfaceRes, err := client.ApiCallSimple("set-igmp-interface", payload)
+    addIgmpInterfaceRes, err := apiCallSimple(client, "set-igmp-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addIgmpInterfaceRes.Success
@@ -165,7 +166,7 @@ func readGaiaIgmpInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showIgmpInterfaceRes, err := client.ApiCallSimple("show-igmp-interface", payload)
+    showIgmpInterfaceRes, err := apiCallSimple(client, "show-igmp-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showIgmpInterfaceRes.Success
@@ -366,7 +367,7 @@ func updateGaiaIgmpInterface(d *schema.ResourceData, m interface{}) error {
         payload["reset"] = v.(bool)
     }
 
-    setIgmpInterfaceRes, err := client.ApiCallSimple("set-igmp-interface", payload)
+    setIgmpInterfaceRes, err := apiCallSimple(client, "set-igmp-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setIgmpInterfaceRes.Success
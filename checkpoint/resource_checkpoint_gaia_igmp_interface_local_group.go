@@ -52,7 +52,7 @@ func createGaiaIgmpInterfaceLocalGroup(d *schema.ResourceData, m interface{}) er
 
     log.Println("Create IgmpInterfaceLocalGroup - Map = ", payload)
 
-    addIgmpInterfaceLocalGroupRes, err := client.ApiCallSimple("add-igmp-interface-local-group", payload)
+    addIgmpInterfaceLocalGroupRes, err := apiCallSimple(client, "add-igmp-interface-local-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addIgmpInterfaceLocalGroupRes.Success
@@ -114,7 +114,7 @@ func deleteGaiaIgmpInterfaceLocalGroup(d *schema.ResourceData, m interface{}) er
         payload["local-group"] = v.(string)
     }
 
-    deleteIgmpInterfaceLocalGroupRes, err := client.ApiCallSimple("delete-igmp-interface-local-group", payload)
+    deleteIgmpInterfaceLocalGroupRes, err := apiCallSimple(client, "delete-igmp-interface-local-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteIgmpInterfaceLocalGroupRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaIgmpInterfaceStaticGroup() *schema.Resource {
         Read:   readGaiaIgmpInterfaceStaticGroup,
         Update: updateGaiaIgmpInterfaceStaticGroup,
         Delete: deleteGaiaIgmpInterfaceStaticGroup,
+        Importer: importGaiaResource("igmp-interface-static-group-", "interface", "static_group"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -127,7 +128,7 @@ func createGaiaIgmpInterfaceStaticGroup(d *schema.ResourceData, m interface{}) e
 
     log.Println("Create IgmpInterfaceStaticGroup - Map = ", payload)
 
-    addIgmpInterfaceStaticGroupRes, err := client.ApiCallSimple("add-igmp-interface-static-group", payload)
+    addIgmpInterfaceStaticGroupRes, err := apiCallSimple(client, "add-igmp-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addIgmpInterfaceStaticGroupRes.Success
@@ -178,7 +179,7 @@ func createGaiaIgmpInterfaceStaticGroup(d *schema.ResourceData, m interface{}) e
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setIgmpInterfaceStaticGroupRes, err := client.ApiCallSimple("set-igmp-interface-static-group", updatePayload)
+        setIgmpInterfaceStaticGroupRes, err := apiCallSimple(client, "set-igmp-interface-static-group", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for igmp-interface-static-group: %v", err)
         }
@@ -210,7 +211,7 @@ func readGaiaIgmpInterfaceStaticGroup(d *schema.ResourceData, m interface{}) err
         payload["member-id"] = v.(string)
     }
 
-    showIgmpInterfaceStaticGroupRes, err := client.ApiCallSimple("show-igmp-interface-static-group", payload)
+    showIgmpInterfaceStaticGroupRes, err := apiCallSimple(client, "show-igmp-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showIgmpInterfaceStaticGroupRes.Success
@@ -324,7 +325,7 @@ func updateGaiaIgmpInterfaceStaticGroup(d *schema.ResourceData, m interface{}) e
         payload["source-all-off"] = v.(string)
     }
 
-    setIgmpInterfaceStaticGroupRes, err := client.ApiCallSimple("set-igmp-interface-static-group", payload)
+    setIgmpInterfaceStaticGroupRes, err := apiCallSimple(client, "set-igmp-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setIgmpInterfaceStaticGroupRes.Success
@@ -375,7 +376,7 @@ func deleteGaiaIgmpInterfaceStaticGroup(d *schema.ResourceData, m interface{}) e
         payload["static-group"] = v.(string)
     }
 
-    deleteIgmpInterfaceStaticGroupRes, err := client.ApiCallSimple("delete-igmp-interface-static-group", payload)
+    deleteIgmpInterfaceStaticGroupRes, err := apiCallSimple(client, "delete-igmp-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteIgmpInterfaceStaticGroupRes.Success
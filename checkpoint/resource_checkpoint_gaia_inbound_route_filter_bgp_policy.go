@@ -15,6 +15,7 @@ func resourceGaiaInboundRouteFilterBgpPolicy() *schema.Resource {
         Read:   readGaiaInboundRouteFilterBgpPolicy,
         Update: updateGaiaInboundRouteFilterBgpPolicy,
         Delete: deleteGaiaInboundRouteFilterBgpPolicy,
+        Importer: importGaiaResource("inbound-route-filter-bgp-policy-", "policy_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -321,7 +322,7 @@ func createGaiaInboundRouteFilterBgpPolicy(d *schema.ResourceData, m interface{}
 
     // Check if inbound-route-filter-bgp-policy already exists on the device.
     _checkPayload := map[string]interface{}{"policy-id": d.Get("policy_id")}
-    _existsRes, _existsErr := client.ApiCallSimple("show-inbound-route-filter-bgp-policy", _checkPayload)
+    _existsRes, _existsErr := apiCallSimple(client, "show-inbound-route-filter-bgp-policy", _checkPayload)
     if _existsErr == nil && _existsRes.Success && func() bool {
             _d := _existsRes.GetData()
             if _bgp, ok := _d["bgp"]; ok {
@@ -335,7 +336,7 @@ func createGaiaInboundRouteFilterBgpPolicy(d *schema.ResourceData, m interface{}
         delete(payload, "based-on-as")
     }
 
-    addInboundRouteFilterBgpPolicyRes, err := client.ApiCallSimple("set-inbound-route-filter-bgp-policy", payload)
+    addInboundRouteFilterBgpPolicyRes, err := apiCallSimple(client, "set-inbound-route-filter-bgp-policy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addInboundRouteFilterBgpPolicyRes.Success
@@ -390,7 +391,7 @@ func readGaiaInboundRouteFilterBgpPolicy(d *schema.ResourceData, m interface{})
         payload["member-id"] = v.(string)
     }
 
-    showInboundRouteFilterBgpPolicyRes, err := client.ApiCallSimple("show-inbound-route-filter-bgp-policy", payload)
+    showInboundRouteFilterBgpPolicyRes, err := apiCallSimple(client, "show-inbound-route-filter-bgp-policy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showInboundRouteFilterBgpPolicyRes.Success
@@ -651,7 +652,7 @@ func updateGaiaInboundRouteFilterBgpPolicy(d *schema.ResourceData, m interface{}
         payload["reset"] = v.(bool)
     }
 
-    setInboundRouteFilterBgpPolicyRes, err := client.ApiCallSimple("set-inbound-route-filter-bgp-policy", payload)
+    setInboundRouteFilterBgpPolicyRes, err := apiCallSimple(client, "set-inbound-route-filter-bgp-policy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setInboundRouteFilterBgpPolicyRes.Success
@@ -691,7 +692,7 @@ func deleteGaiaInboundRouteFilterBgpPolicy(d *schema.ResourceData, m interface{}
     client := m.(*checkpoint.ApiClient)
     ensureDebugServerFromClient(client)
     payload := map[string]interface{}{"policy-id": d.Get("policy_id"), "reset": true}
-    res, err := client.ApiCallSimple("set-inbound-route-filter-bgp-policy", payload)
+    res, err := apiCallSimple(client, "set-inbound-route-filter-bgp-policy", payload)
     if err != nil {
         return fmt.Errorf("Failed to reset inbound-route-filter-bgp-policy: %v", err)
     }
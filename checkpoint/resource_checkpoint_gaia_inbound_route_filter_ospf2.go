@@ -15,6 +15,7 @@ func resourceGaiaInboundRouteFilterOspf2() *schema.Resource {
         Read:   readGaiaInboundRouteFilterOspf2,
         Update: updateGaiaInboundRouteFilterOspf2,
         Delete: deleteGaiaInboundRouteFilterOspf2,
+        Importer: importGaiaResource("inbound-route-filter-ospf2-", "instance"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -164,7 +165,7 @@ func createGaiaInboundRouteFilterOspf2(d *schema.ResourceData, m interface{}) er
 
     log.Println("Create InboundRouteFilterOspf2 - Map = ", payload)
 
-    addInboundRouteFilterOspf2Res, err := client.ApiCallSimple("set-inbound-route-filter-ospf2", payload)
+    addInboundRouteFilterOspf2Res, err := apiCallSimple(client, "set-inbound-route-filter-ospf2", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addInboundRouteFilterOspf2Res.Success
@@ -219,7 +220,7 @@ func readGaiaInboundRouteFilterOspf2(d *schema.ResourceData, m interface{}) erro
         payload["member-id"] = v.(string)
     }
 
-    showInboundRouteFilterOspf2Res, err := client.ApiCallSimple("show-inbound-route-filter-ospf2", payload)
+    showInboundRouteFilterOspf2Res, err := apiCallSimple(client, "show-inbound-route-filter-ospf2", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showInboundRouteFilterOspf2Res.Success
@@ -364,7 +365,7 @@ func updateGaiaInboundRouteFilterOspf2(d *schema.ResourceData, m interface{}) er
         payload["reset"] = v.(bool)
     }
 
-    setInboundRouteFilterOspf2Res, err := client.ApiCallSimple("set-inbound-route-filter-ospf2", payload)
+    setInboundRouteFilterOspf2Res, err := apiCallSimple(client, "set-inbound-route-filter-ospf2", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setInboundRouteFilterOspf2Res.Success
@@ -15,6 +15,7 @@ func resourceGaiaInboundRouteFilterOspf3() *schema.Resource {
         Read:   readGaiaInboundRouteFilterOspf3,
         Update: updateGaiaInboundRouteFilterOspf3,
         Delete: deleteGaiaInboundRouteFilterOspf3,
+        Importer: importGaiaResource("inbound-route-filter-ospf3-", "instance"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -129,7 +130,7 @@ func createGaiaInboundRouteFilterOspf3(d *schema.ResourceData, m interface{}) er
 
     log.Println("Create InboundRouteFilterOspf3 - Map = ", payload)
 
-    addInboundRouteFilterOspf3Res, err := client.ApiCallSimple("set-inbound-route-filter-ospf3", payload)
+    addInboundRouteFilterOspf3Res, err := apiCallSimple(client, "set-inbound-route-filter-ospf3", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addInboundRouteFilterOspf3Res.Success
@@ -184,7 +185,7 @@ func readGaiaInboundRouteFilterOspf3(d *schema.ResourceData, m interface{}) erro
         payload["member-id"] = v.(string)
     }
 
-    showInboundRouteFilterOspf3Res, err := client.ApiCallSimple("show-inbound-route-filter-ospf3", payload)
+    showInboundRouteFilterOspf3Res, err := apiCallSimple(client, "show-inbound-route-filter-ospf3", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showInboundRouteFilterOspf3Res.Success
@@ -306,7 +307,7 @@ func updateGaiaInboundRouteFilterOspf3(d *schema.ResourceData, m interface{}) er
         payload["reset"] = v.(bool)
     }
 
-    setInboundRouteFilterOspf3Res, err := client.ApiCallSimple("set-inbound-route-filter-ospf3", payload)
+    setInboundRouteFilterOspf3Res, err := apiCallSimple(client, "set-inbound-route-filter-ospf3", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setInboundRouteFilterOspf3Res.Success
@@ -15,6 +15,7 @@ func resourceGaiaInboundRouteFilterRip() *schema.Resource {
         Read:   readGaiaInboundRouteFilterRip,
         Update: updateGaiaInboundRouteFilterRip,
         Delete: deleteGaiaInboundRouteFilterRip,
+        Importer: importGaiaResource("inbound-route-filter-rip-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -155,7 +156,7 @@ func createGaiaInboundRouteFilterRip(d *schema.ResourceData, m interface{}) erro
 
     log.Println("Create InboundRouteFilterRip - Map = ", payload)
 
-    addInboundRouteFilterRipRes, err := client.ApiCallSimple("set-inbound-route-filter-rip", payload)
+    addInboundRouteFilterRipRes, err := apiCallSimple(client, "set-inbound-route-filter-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addInboundRouteFilterRipRes.Success
@@ -206,7 +207,7 @@ func readGaiaInboundRouteFilterRip(d *schema.ResourceData, m interface{}) error
         payload["member-id"] = v.(string)
     }
 
-    showInboundRouteFilterRipRes, err := client.ApiCallSimple("show-inbound-route-filter-rip", payload)
+    showInboundRouteFilterRipRes, err := apiCallSimple(client, "show-inbound-route-filter-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showInboundRouteFilterRipRes.Success
@@ -350,7 +351,7 @@ func updateGaiaInboundRouteFilterRip(d *schema.ResourceData, m interface{}) erro
         payload["reset"] = v.(bool)
     }
 
-    setInboundRouteFilterRipRes, err := client.ApiCallSimple("set-inbound-route-filter-rip", payload)
+    setInboundRouteFilterRipRes, err := apiCallSimple(client, "set-inbound-route-filter-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setInboundRouteFilterRipRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaIpv6() *schema.Resource {
         Read:   readGaiaIpv6,
         Update: updateGaiaIpv6,
         Delete: deleteGaiaIpv6,
+        Importer: importGaiaResource("ipv6-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaIpv6(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Ipv6 - Map = ", payload)
 
-    addIpv6Res, err := client.ApiCallSimple("set-ipv6", payload)
+    addIpv6Res, err := apiCallSimple(client, "set-ipv6", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addIpv6Res.Success
@@ -98,7 +99,7 @@ func readGaiaIpv6(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showIpv6Res, err := client.ApiCallSimple("show-ipv6", payload)
+    showIpv6Res, err := apiCallSimple(client, "show-ipv6", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showIpv6Res.Success
@@ -172,7 +173,7 @@ func updateGaiaIpv6(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setIpv6Res, err := client.ApiCallSimple("set-ipv6", payload)
+    setIpv6Res, err := apiCallSimple(client, "set-ipv6", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setIpv6Res.Success
@@ -15,6 +15,7 @@ func resourceGaiaIpv6PimInterface() *schema.Resource {
         Read:   readGaiaIpv6PimInterface,
         Update: updateGaiaIpv6PimInterface,
         Delete: deleteGaiaIpv6PimInterface,
+        Importer: importGaiaResource("ipv6-pim-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -104,7 +105,7 @@ func createGaiaIpv6PimInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Ipv6PimInterface - Map = ", payload)
 
-    addIpv6PimInterfaceRes, err := client.ApiCallSimple("add-ipv6-pim-interface", payload)
+    addIpv6PimInterfaceRes, err := apiCallSimple(client, "add-ipv6-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addIpv6PimInterfaceRes.Success
@@ -169,7 +170,7 @@ func readGaiaIpv6PimInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showIpv6PimInterfaceRes, err := client.ApiCallSimple("show-ipv6-pim-interface", payload)
+    showIpv6PimInterfaceRes, err := apiCallSimple(client, "show-ipv6-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showIpv6PimInterfaceRes.Success
@@ -271,7 +272,7 @@ func updateGaiaIpv6PimInterface(d *schema.ResourceData, m interface{}) error {
         payload["enable-virtual-address"] = v.(bool)
     }
 
-    setIpv6PimInterfaceRes, err := client.ApiCallSimple("set-ipv6-pim-interface", payload)
+    setIpv6PimInterfaceRes, err := apiCallSimple(client, "set-ipv6-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setIpv6PimInterfaceRes.Success
@@ -328,7 +329,7 @@ func deleteGaiaIpv6PimInterface(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteIpv6PimInterfaceRes, err := client.ApiCallSimple("delete-ipv6-pim-interface", payload)
+    deleteIpv6PimInterfaceRes, err := apiCallSimple(client, "delete-ipv6-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteIpv6PimInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaIsisInterface() *schema.Resource {
         Read:   readGaiaIsisInterface,
         Update: updateGaiaIsisInterface,
         Delete: deleteGaiaIsisInterface,
+        Importer: importGaiaResource("isis-interface-", "interface"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -423,7 +424,7 @@ func createGaiaIsisInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create IsisInterface - Map = ", payload)
 
-    addIsisInterfaceRes, err := client.ApiCallSimple("add-isis-interface", payload)
+    addIsisInterfaceRes, err := apiCallSimple(client, "add-isis-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addIsisInterfaceRes.Success
@@ -483,7 +484,7 @@ func readGaiaIsisInterface(d *schema.ResourceData, m interface{}) error {
     // build_payload may have added it from the field_set; remove it unconditionally.
     delete(payload, "name")
 
-    showIsisInterfaceRes, err := client.ApiCallSimple("show-isis-interfaces", payload)
+    showIsisInterfaceRes, err := apiCallSimple(client, "show-isis-interfaces", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showIsisInterfaceRes.Success
@@ -833,7 +834,7 @@ func updateGaiaIsisInterface(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setIsisInterfaceRes, err := client.ApiCallSimple("set-isis-interface", payload)
+    setIsisInterfaceRes, err := apiCallSimple(client, "set-isis-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setIsisInterfaceRes.Success
@@ -880,7 +881,7 @@ func deleteGaiaIsisInterface(d *schema.ResourceData, m interface{}) error {
         payload["interface"] = v.(string)
     }
 
-    deleteIsisInterfaceRes, err := client.ApiCallSimple("delete-isis-interface", payload)
+    deleteIsisInterfaceRes, err := apiCallSimple(client, "delete-isis-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteIsisInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaKeyboardLayout() *schema.Resource {
         Read:   readGaiaKeyboardLayout,
         Update: updateGaiaKeyboardLayout,
         Delete: deleteGaiaKeyboardLayout,
+        Importer: importGaiaResource("keyboard-layout-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaKeyboardLayout(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create KeyboardLayout - Map = ", payload)
 
-    addKeyboardLayoutRes, err := client.ApiCallSimple("set-keyboard-layout", payload)
+    addKeyboardLayoutRes, err := apiCallSimple(client, "set-keyboard-layout", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addKeyboardLayoutRes.Success
@@ -98,7 +99,7 @@ func readGaiaKeyboardLayout(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showKeyboardLayoutRes, err := client.ApiCallSimple("show-keyboard-layout", payload)
+    showKeyboardLayoutRes, err := apiCallSimple(client, "show-keyboard-layout", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showKeyboardLayoutRes.Success
@@ -164,7 +165,7 @@ func updateGaiaKeyboardLayout(d *schema.ResourceData, m interface{}) error {
         payload["keyboard-layout"] = v.(string)
     }
 
-    setKeyboardLayoutRes, err := client.ApiCallSimple("set-keyboard-layout", payload)
+    setKeyboardLayoutRes, err := apiCallSimple(client, "set-keyboard-layout", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setKeyboardLayoutRes.Success
@@ -14,6 +14,7 @@ func resourceGaiaLicense() *schema.Resource {
         Create: createGaiaLicense,
         Read:   readGaiaLicense,
         Delete: deleteGaiaLicense,
+        Importer: importGaiaResource("license-", "signature"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -90,7 +91,7 @@ func createGaiaLicense(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create License - Map = ", payload)
 
-    addLicenseRes, err := client.ApiCallSimple("add-license", payload)
+    addLicenseRes, err := apiCallSimple(client, "add-license", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addLicenseRes.Success
@@ -152,7 +153,7 @@ func readGaiaLicense(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showLicenseRes, err := client.ApiCallSimple("show-license", payload)
+    showLicenseRes, err := apiCallSimple(client, "show-license", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showLicenseRes.Success
@@ -244,7 +245,7 @@ func deleteGaiaLicense(d *schema.ResourceData, m interface{}) error {
         payload["signature"] = v.(string)
     }
 
-    deleteLicenseRes, err := client.ApiCallSimple("delete-license", payload)
+    deleteLicenseRes, err := apiCallSimple(client, "delete-license", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteLicenseRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaLightshot() *schema.Resource {
         Create: createGaiaLightshot,
         Read:   readGaiaLightshot,
         Delete: deleteGaiaLightshot,
+        Importer: importGaiaResource("lightshot-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -84,7 +85,7 @@ func createGaiaLightshot(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Lightshot - Map = ", payload)
 
-    addLightshotRes, err := client.ApiCall("add-lightshot", payload, client.GetSessionID(), false, client.IsProxyUsed())
+    addLightshotRes, err := apiCall(client, "add-lightshot", payload, client.GetSessionID(), false, client.IsProxyUsed())
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addLightshotRes.Success
@@ -149,7 +150,7 @@ func readGaiaLightshot(d *schema.ResourceData, m interface{}) error {
 
 	payload := map[string]interface{}{}
 
-	showLightshotRes, err := client.ApiCallSimple("show-lightshots", payload)
+	showLightshotRes, err := apiCallSimple(client, "show-lightshots", payload)
 	// DEBUG: generic logger
 	if resourceDebugEnabled(d) {
 		success := err == nil && showLightshotRes.Success
@@ -231,7 +232,7 @@ func deleteGaiaLightshot(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteLightshotRes, err := client.ApiCallSimple("delete-lightshot", payload)
+    deleteLightshotRes, err := apiCallSimple(client, "delete-lightshot", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteLightshotRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaLightshotPartition() *schema.Resource {
         Read:   readGaiaLightshotPartition,
         Update: updateGaiaLightshotPartition,
         Delete: deleteGaiaLightshotPartition,
+        Importer: importGaiaResource("lightshot-partition-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -60,7 +61,7 @@ func createGaiaLightshotPartition(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create LightshotPartition - Map = ", payload)
 
-    addLightshotPartitionRes, err := client.ApiCallSimple("set-lightshot-partition", payload)
+    addLightshotPartitionRes, err := apiCallSimple(client, "set-lightshot-partition", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addLightshotPartitionRes.Success
@@ -123,7 +124,7 @@ func readGaiaLightshotPartition(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showLightshotPartitionRes, err := client.ApiCallSimple("show-lightshot-partition", payload)
+    showLightshotPartitionRes, err := apiCallSimple(client, "show-lightshot-partition", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showLightshotPartitionRes.Success
@@ -201,7 +202,7 @@ func updateGaiaLightshotPartition(d *schema.ResourceData, m interface{}) error {
         payload["size"] = v.(int)
     }
 
-    setLightshotPartitionRes, err := client.ApiCallSimple("set-lightshot-partition", payload)
+    setLightshotPartitionRes, err := apiCallSimple(client, "set-lightshot-partition", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setLightshotPartitionRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaLldp() *schema.Resource {
         Read:   readGaiaLldp,
         Update: updateGaiaLldp,
         Delete: deleteGaiaLldp,
+        Importer: importGaiaResource("lldp-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -198,7 +199,7 @@ func createGaiaLldp(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Lldp - Map = ", payload)
 
-    addLldpRes, err := client.ApiCallSimple("set-lldp", payload)
+    addLldpRes, err := apiCallSimple(client, "set-lldp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addLldpRes.Success
@@ -249,7 +250,7 @@ func readGaiaLldp(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showLldpRes, err := client.ApiCallSimple("show-lldp", payload)
+    showLldpRes, err := apiCallSimple(client, "show-lldp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showLldpRes.Success
@@ -403,7 +404,7 @@ func updateGaiaLldp(d *schema.ResourceData, m interface{}) error {
         payload["interfaces"] = ifaceArray
     }
 
-    setLldpRes, err := client.ApiCallSimple("set-lldp", payload)
+    setLldpRes, err := apiCallSimple(client, "set-lldp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setLldpRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaLoopbackInterface() *schema.Resource {
         Read:   readGaiaLoopbackInterface,
         Update: updateGaiaLoopbackInterface,
         Delete: deleteGaiaLoopbackInterface,
+        Importer: importGaiaResource("loopback-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -163,7 +164,7 @@ func createGaiaLoopbackInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create LoopbackInterface - Map = ", payload)
 
-    addLoopbackInterfaceRes, err := client.ApiCallSimple("add-loopback-interface", payload)
+    addLoopbackInterfaceRes, err := apiCallSimple(client, "add-loopback-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addLoopbackInterfaceRes.Success
@@ -219,7 +220,7 @@ func createGaiaLoopbackInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setLoopbackInterfaceRes, err := client.ApiCallSimple("set-loopback-interface", updatePayload)
+        setLoopbackInterfaceRes, err := apiCallSimple(client, "set-loopback-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for loopback-interface: %v", err)
         }
@@ -248,7 +249,7 @@ func readGaiaLoopbackInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showLoopbackInterfaceRes, err := client.ApiCallSimple("show-loopback-interface", payload)
+    showLoopbackInterfaceRes, err := apiCallSimple(client, "show-loopback-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showLoopbackInterfaceRes.Success
@@ -438,7 +439,7 @@ func updateGaiaLoopbackInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    setLoopbackInterfaceRes, err := client.ApiCallSimple("set-loopback-interface", payload)
+    setLoopbackInterfaceRes, err := apiCallSimple(client, "set-loopback-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setLoopbackInterfaceRes.Success
@@ -486,7 +487,7 @@ func deleteGaiaLoopbackInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    deleteLoopbackInterfaceRes, err := client.ApiCallSimple("delete-loopback-interface", payload)
+    deleteLoopbackInterfaceRes, err := apiCallSimple(client, "delete-loopback-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteLoopbackInterfaceRes.Success
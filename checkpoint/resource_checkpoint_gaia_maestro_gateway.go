@@ -15,6 +15,7 @@ func resourceGaiaMaestroGateway() *schema.Resource {
         Read:   readGaiaMaestroGateway,
         Update: updateGaiaMaestroGateway,
         Delete: deleteGaiaMaestroGateway,
+        Importer: importGaiaResource("maestro-gateway-", "resource_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -113,7 +114,7 @@ func createGaiaMaestroGateway(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create MaestroGateway - Map = ", payload)
 
-    addMaestroGatewayRes, err := client.ApiCallSimple("set-maestro-gateway", payload)
+    addMaestroGatewayRes, err := apiCallSimple(client, "set-maestro-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMaestroGatewayRes.Success
@@ -168,7 +169,7 @@ func readGaiaMaestroGateway(d *schema.ResourceData, m interface{}) error {
         payload["include-pending-changes"] = v.(bool)
     }
 
-    showMaestroGatewayRes, err := client.ApiCallSimple("show-maestro-gateway", payload)
+    showMaestroGatewayRes, err := apiCallSimple(client, "show-maestro-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMaestroGatewayRes.Success
@@ -294,7 +295,7 @@ func updateGaiaMaestroGateway(d *schema.ResourceData, m interface{}) error {
         payload["security-group"] = v.(int)
     }
 
-    setMaestroGatewayRes, err := client.ApiCallSimple("set-maestro-gateway", payload)
+    setMaestroGatewayRes, err := apiCallSimple(client, "set-maestro-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMaestroGatewayRes.Success
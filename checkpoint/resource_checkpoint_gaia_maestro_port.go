@@ -15,6 +15,7 @@ func resourceGaiaMaestroPort() *schema.Resource {
         Read:   readGaiaMaestroPort,
         Update: updateGaiaMaestroPort,
         Delete: deleteGaiaMaestroPort,
+        Importer: importGaiaResource("maestro-port-", "resource_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -125,7 +126,7 @@ func createGaiaMaestroPort(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create MaestroPort - Map = ", payload)
 
-    addMaestroPortRes, err := client.ApiCallSimple("set-maestro-port", payload)
+    addMaestroPortRes, err := apiCallSimple(client, "set-maestro-port", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMaestroPortRes.Success
@@ -180,7 +181,7 @@ func readGaiaMaestroPort(d *schema.ResourceData, m interface{}) error {
         payload["interface-name"] = v.(string)
     }
 
-    showMaestroPortRes, err := client.ApiCallSimple("show-maestro-port", payload)
+    showMaestroPortRes, err := apiCallSimple(client, "show-maestro-port", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMaestroPortRes.Success
@@ -341,7 +342,7 @@ func updateGaiaMaestroPort(d *schema.ResourceData, m interface{}) error {
         payload["type"] = v.(string)
     }
 
-    setMaestroPortRes, err := client.ApiCallSimple("set-maestro-port", payload)
+    setMaestroPortRes, err := apiCallSimple(client, "set-maestro-port", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMaestroPortRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaMaestroSecurityGroup() *schema.Resource {
         Read:   readGaiaMaestroSecurityGroup,
         Update: updateGaiaMaestroSecurityGroup,
         Delete: deleteGaiaMaestroSecurityGroup,
+        Importer: importGaiaResource("maestro-security-group-", "resource_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -330,7 +331,7 @@ func createGaiaMaestroSecurityGroup(d *schema.ResourceData, m interface{}) error
 
     log.Println("Create MaestroSecurityGroup - Map = ", payload)
 
-    addMaestroSecurityGroupRes, err := client.ApiCallSimple("add-maestro-security-group", payload)
+    addMaestroSecurityGroupRes, err := apiCallSimple(client, "add-maestro-security-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMaestroSecurityGroupRes.Success
@@ -394,7 +395,7 @@ func readGaiaMaestroSecurityGroup(d *schema.ResourceData, m interface{}) error {
         payload["include-pending-changes"] = v.(bool)
     }
 
-    showMaestroSecurityGroupRes, err := client.ApiCallSimple("show-maestro-security-group", payload)
+    showMaestroSecurityGroupRes, err := apiCallSimple(client, "show-maestro-security-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMaestroSecurityGroupRes.Success
@@ -653,7 +654,7 @@ func updateGaiaMaestroSecurityGroup(d *schema.ResourceData, m interface{}) error
         payload["id"] = v.(int)
     }
 
-    setMaestroSecurityGroupRes, err := client.ApiCallSimple("set-maestro-security-group", payload)
+    setMaestroSecurityGroupRes, err := apiCallSimple(client, "set-maestro-security-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMaestroSecurityGroupRes.Success
@@ -700,7 +701,7 @@ func deleteGaiaMaestroSecurityGroup(d *schema.ResourceData, m interface{}) error
         payload["id"] = v.(int)
     }
 
-    deleteMaestroSecurityGroupRes, err := client.ApiCallSimple("delete-maestro-security-group", payload)
+    deleteMaestroSecurityGroupRes, err := apiCallSimple(client, "delete-maestro-security-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteMaestroSecurityGroupRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaMaestroSite() *schema.Resource {
         Read:   readGaiaMaestroSite,
         Update: updateGaiaMaestroSite,
         Delete: deleteGaiaMaestroSite,
+        Importer: importGaiaResource("maestro-site-", "site_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -98,7 +99,7 @@ func createGaiaMaestroSite(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create MaestroSite - Map = ", payload)
 
-    addMaestroSiteRes, err := client.ApiCallSimple("set-maestro-site", payload)
+    addMaestroSiteRes, err := apiCallSimple(client, "set-maestro-site", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMaestroSiteRes.Success
@@ -153,7 +154,7 @@ func readGaiaMaestroSite(d *schema.ResourceData, m interface{}) error {
         payload["include-pending-changes"] = v.(bool)
     }
 
-    showMaestroSiteRes, err := client.ApiCallSimple("show-maestro-site", payload)
+    showMaestroSiteRes, err := apiCallSimple(client, "show-maestro-site", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMaestroSiteRes.Success
@@ -274,7 +275,7 @@ func updateGaiaMaestroSite(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setMaestroSiteRes, err := client.ApiCallSimple("set-maestro-site", payload)
+    setMaestroSiteRes, err := apiCallSimple(client, "set-maestro-site", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMaestroSiteRes.Success
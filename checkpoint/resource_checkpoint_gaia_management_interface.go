@@ -15,6 +15,7 @@ func resourceGaiaManagementInterface() *schema.Resource {
         Read:   readGaiaManagementInterface,
         Update: updateGaiaManagementInterface,
         Delete: deleteGaiaManagementInterface,
+        Importer: importGaiaResource("management-interface-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -56,7 +57,7 @@ func createGaiaManagementInterface(d *schema.ResourceData, m interface{}) error
 
     log.Println("Create ManagementInterface - Map = ", payload)
 
-    addManagementInterfaceRes, err := client.ApiCallSimple("set-management-interface", payload)
+    addManagementInterfaceRes, err := apiCallSimple(client, "set-management-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addManagementInterfaceRes.Success
@@ -111,7 +112,7 @@ func readGaiaManagementInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showManagementInterfaceRes, err := client.ApiCallSimple("show-management-interface", payload)
+    showManagementInterfaceRes, err := apiCallSimple(client, "show-management-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showManagementInterfaceRes.Success
@@ -181,7 +182,7 @@ func updateGaiaManagementInterface(d *schema.ResourceData, m interface{}) error
         payload["virtual-system-id"] = v.(int)
     }
 
-    setManagementInterfaceRes, err := client.ApiCallSimple("set-management-interface", payload)
+    setManagementInterfaceRes, err := apiCallSimple(client, "set-management-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setManagementInterfaceRes.Success
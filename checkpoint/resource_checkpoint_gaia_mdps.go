@@ -15,6 +15,7 @@ func resourceGaiaMdps() *schema.Resource {
         Read:   readGaiaMdps,
         Update: updateGaiaMdps,
         Delete: deleteGaiaMdps,
+        Importer: importGaiaResource("mdps-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -122,7 +123,7 @@ func createGaiaMdps(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Mdps - Map = ", payload)
 
-    addMdpsRes, err := client.ApiCallSimple("set-mdps", payload)
+    addMdpsRes, err := apiCallSimple(client, "set-mdps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMdpsRes.Success
@@ -173,7 +174,7 @@ func readGaiaMdps(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showMdpsRes, err := client.ApiCallSimple("show-mdps", payload)
+    showMdpsRes, err := apiCallSimple(client, "show-mdps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMdpsRes.Success
@@ -288,7 +289,7 @@ func updateGaiaMdps(d *schema.ResourceData, m interface{}) error {
         payload["resource-separation"] = resourceseparationMap
     }
 
-    setMdpsRes, err := client.ApiCallSimple("set-mdps", payload)
+    setMdpsRes, err := apiCallSimple(client, "set-mdps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMdpsRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaMdpsTasks() *schema.Resource {
         Read:   readGaiaMdpsTasks,
         Update: updateGaiaMdpsTasks,
         Delete: deleteGaiaMdpsTasks,
+        Importer: importGaiaResource("mdps-tasks-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -121,7 +122,7 @@ func createGaiaMdpsTasks(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create MdpsTasks - Map = ", payload)
 
-    addMdpsTasksRes, err := client.ApiCallSimple("set-mdps-tasks", payload)
+    addMdpsTasksRes, err := apiCallSimple(client, "set-mdps-tasks", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMdpsTasksRes.Success
@@ -172,7 +173,7 @@ func readGaiaMdpsTasks(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showMdpsTasksRes, err := client.ApiCallSimple("show-mdps-tasks", payload)
+    showMdpsTasksRes, err := apiCallSimple(client, "show-mdps-tasks", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMdpsTasksRes.Success
@@ -317,7 +318,7 @@ func updateGaiaMdpsTasks(d *schema.ResourceData, m interface{}) error {
         return readGaiaMdpsTasks(d, m)
     }
 
-    setMdpsTasksRes, err := client.ApiCallSimple("set-mdps-tasks", payload)
+    setMdpsTasksRes, err := apiCallSimple(client, "set-mdps-tasks", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMdpsTasksRes.Success
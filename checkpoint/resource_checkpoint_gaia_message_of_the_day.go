@@ -15,6 +15,7 @@ func resourceGaiaMessageOfTheDay() *schema.Resource {
         Read:   readGaiaMessageOfTheDay,
         Update: updateGaiaMessageOfTheDay,
         Delete: deleteGaiaMessageOfTheDay,
+        Importer: importGaiaResource("message-of-the-day-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -56,7 +57,7 @@ func createGaiaMessageOfTheDay(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create MessageOfTheDay - Map = ", payload)
 
-    addMessageOfTheDayRes, err := client.ApiCallSimple("set-message-of-the-day", payload)
+    addMessageOfTheDayRes, err := apiCallSimple(client, "set-message-of-the-day", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMessageOfTheDayRes.Success
@@ -107,7 +108,7 @@ func readGaiaMessageOfTheDay(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showMessageOfTheDayRes, err := client.ApiCallSimple("show-message-of-the-day", payload)
+    showMessageOfTheDayRes, err := apiCallSimple(client, "show-message-of-the-day", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMessageOfTheDayRes.Success
@@ -184,7 +185,7 @@ func updateGaiaMessageOfTheDay(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setMessageOfTheDayRes, err := client.ApiCallSimple("set-message-of-the-day", payload)
+    setMessageOfTheDayRes, err := apiCallSimple(client, "set-message-of-the-day", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMessageOfTheDayRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaMldInterface() *schema.Resource {
         Read:   readGaiaMldInterface,
         Update: updateGaiaMldInterface,
         Delete: deleteGaiaMldInterface,
+        Importer: importGaiaResource("mld-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -134,7 +135,7 @@ func createGaiaMldInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create MldInterface - Map = ", payload)
 
-    addMldInterfaceRes, err := client.ApiCallSimple("set-mld-interface", payload)
+    addMldInterfaceRes, err := apiCallSimple(client, "set-mld-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMldInterfaceRes.Success
@@ -189,7 +190,7 @@ func readGaiaMldInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showMldInterfaceRes, err := client.ApiCallSimple("show-mld-interface", payload)
+    showMldInterfaceRes, err := apiCallSimple(client, "show-mld-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMldInterfaceRes.Success
@@ -320,7 +321,7 @@ func updateGaiaMldInterface(d *schema.ResourceData, m interface{}) error {
         payload["reset"] = v.(bool)
     }
 
-    setMldInterfaceRes, err := client.ApiCallSimple("set-mld-interface", payload)
+    setMldInterfaceRes, err := apiCallSimple(client, "set-mld-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMldInterfaceRes.Success
@@ -52,7 +52,7 @@ func createGaiaMldInterfaceLocalGroup(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create MldInterfaceLocalGroup - Map = ", payload)
 
-	addMldInterfaceLocalGroupRes, err := client.ApiCallSimple("add-mld-interface-local-group", payload)
+	addMldInterfaceLocalGroupRes, err := apiCallSimple(client, "add-mld-interface-local-group", payload)
 	// DEBUG: generic logger
 	if resourceDebugEnabled(d) {
 		success := err == nil && addMldInterfaceLocalGroupRes.Success
@@ -120,7 +120,7 @@ func deleteGaiaMldInterfaceLocalGroup(d *schema.ResourceData, m interface{}) err
 		payload["local-group"] = v.(string)
 	}
 
-	deleteMldInterfaceLocalGroupRes, err := client.ApiCallSimple("delete-mld-interface-local-group", payload)
+	deleteMldInterfaceLocalGroupRes, err := apiCallSimple(client, "delete-mld-interface-local-group", payload)
 	// DEBUG: generic logger
 	if resourceDebugEnabled(d) {
 		success := err == nil && deleteMldInterfaceLocalGroupRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaMldInterfaceStaticGroup() *schema.Resource {
         Read:   readGaiaMldInterfaceStaticGroup,
         Update: updateGaiaMldInterfaceStaticGroup,
         Delete: deleteGaiaMldInterfaceStaticGroup,
+        Importer: importGaiaResource("mld-interface-static-group-", "interface", "static_group"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -142,7 +143,7 @@ func createGaiaMldInterfaceStaticGroup(d *schema.ResourceData, m interface{}) er
 
     log.Println("Create MldInterfaceStaticGroup - Map = ", payload)
 
-    addMldInterfaceStaticGroupRes, err := client.ApiCallSimple("add-mld-interface-static-group", payload)
+    addMldInterfaceStaticGroupRes, err := apiCallSimple(client, "add-mld-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addMldInterfaceStaticGroupRes.Success
@@ -193,7 +194,7 @@ func createGaiaMldInterfaceStaticGroup(d *schema.ResourceData, m interface{}) er
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setMldInterfaceStaticGroupRes, err := client.ApiCallSimple("set-mld-interface-static-group", updatePayload)
+        setMldInterfaceStaticGroupRes, err := apiCallSimple(client, "set-mld-interface-static-group", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for mld-interface-static-group: %v", err)
         }
@@ -225,7 +226,7 @@ func readGaiaMldInterfaceStaticGroup(d *schema.ResourceData, m interface{}) erro
         payload["member-id"] = v.(string)
     }
 
-    showMldInterfaceStaticGroupRes, err := client.ApiCallSimple("show-mld-interface-static-group", payload)
+    showMldInterfaceStaticGroupRes, err := apiCallSimple(client, "show-mld-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showMldInterfaceStaticGroupRes.Success
@@ -346,7 +347,7 @@ func updateGaiaMldInterfaceStaticGroup(d *schema.ResourceData, m interface{}) er
         payload["source-all-off"] = v.(string)
     }
 
-    setMldInterfaceStaticGroupRes, err := client.ApiCallSimple("set-mld-interface-static-group", payload)
+    setMldInterfaceStaticGroupRes, err := apiCallSimple(client, "set-mld-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setMldInterfaceStaticGroupRes.Success
@@ -397,7 +398,7 @@ func deleteGaiaMldInterfaceStaticGroup(d *schema.ResourceData, m interface{}) er
         payload["static-group"] = v.(string)
     }
 
-    deleteMldInterfaceStaticGroupRes, err := client.ApiCallSimple("delete-mld-interface-static-group", payload)
+    deleteMldInterfaceStaticGroupRes, err := apiCallSimple(client, "delete-mld-interface-static-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteMldInterfaceStaticGroupRes.Success
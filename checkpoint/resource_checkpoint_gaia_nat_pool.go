@@ -15,6 +15,7 @@ func resourceGaiaNatPool() *schema.Resource {
         Read:   readGaiaNatPool,
         Update: updateGaiaNatPool,
         Delete: deleteGaiaNatPool,
+        Importer: importGaiaResource("nat-pool-", "prefix"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -56,7 +57,7 @@ func createGaiaNatPool(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create NatPool - Map = ", payload)
 
-    addNatPoolRes, err := client.ApiCallSimple("add-nat-pool", payload)
+    addNatPoolRes, err := apiCallSimple(client, "add-nat-pool", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addNatPoolRes.Success
@@ -111,7 +112,7 @@ func readGaiaNatPool(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showNatPoolRes, err := client.ApiCallSimple("show-nat-pool", payload)
+    showNatPoolRes, err := apiCallSimple(client, "show-nat-pool", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showNatPoolRes.Success
@@ -181,7 +182,7 @@ func updateGaiaNatPool(d *schema.ResourceData, m interface{}) error {
         payload["comment"] = v.(string)
     }
 
-    setNatPoolRes, err := client.ApiCallSimple("set-nat-pool", payload)
+    setNatPoolRes, err := apiCallSimple(client, "set-nat-pool", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setNatPoolRes.Success
@@ -228,7 +229,7 @@ func deleteGaiaNatPool(d *schema.ResourceData, m interface{}) error {
         payload["prefix"] = v.(string)
     }
 
-    deleteNatPoolRes, err := client.ApiCallSimple("delete-nat-pool", payload)
+    deleteNatPoolRes, err := apiCallSimple(client, "delete-nat-pool", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteNatPoolRes.Success
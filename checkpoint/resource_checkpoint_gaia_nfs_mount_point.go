@@ -14,6 +14,7 @@ func resourceGaiaNfsMountPoint() *schema.Resource {
         Create: createGaiaNfsMountPoint,
         Read:   readGaiaNfsMountPoint,
         Delete: deleteGaiaNfsMountPoint,
+        Importer: importGaiaResource("nfs-mount-point-", "mount_point"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -69,7 +70,7 @@ func createGaiaNfsMountPoint(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create NfsMountPoint - Map = ", payload)
 
-    addNfsMountPointRes, err := client.ApiCallSimple("add-nfs-mount-point", payload)
+    addNfsMountPointRes, err := apiCallSimple(client, "add-nfs-mount-point", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addNfsMountPointRes.Success
@@ -124,7 +125,7 @@ func readGaiaNfsMountPoint(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showNfsMountPointRes, err := client.ApiCallSimple("show-nfs-mount-point", payload)
+    showNfsMountPointRes, err := apiCallSimple(client, "show-nfs-mount-point", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showNfsMountPointRes.Success
@@ -196,7 +197,7 @@ func deleteGaiaNfsMountPoint(d *schema.ResourceData, m interface{}) error {
         payload["mount-point"] = v.(string)
     }
 
-    deleteNfsMountPointRes, err := client.ApiCallSimple("delete-nfs-mount-point", payload)
+    deleteNfsMountPointRes, err := apiCallSimple(client, "delete-nfs-mount-point", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteNfsMountPointRes.Success
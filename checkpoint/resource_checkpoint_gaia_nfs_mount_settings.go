@@ -15,6 +15,7 @@ func resourceGaiaNfsMountSettings() *schema.Resource {
         Read:   readGaiaNfsMountSettings,
         Update: updateGaiaNfsMountSettings,
         Delete: deleteGaiaNfsMountSettings,
+        Importer: importGaiaResource("nfs-mount-settings-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaNfsMountSettings(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create NfsMountSettings - Map = ", payload)
 
-    addNfsMountSettingsRes, err := client.ApiCallSimple("set-nfs-mount-settings", payload)
+    addNfsMountSettingsRes, err := apiCallSimple(client, "set-nfs-mount-settings", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addNfsMountSettingsRes.Success
@@ -98,7 +99,7 @@ func readGaiaNfsMountSettings(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showNfsMountSettingsRes, err := client.ApiCallSimple("show-nfs-mount-settings", payload)
+    showNfsMountSettingsRes, err := apiCallSimple(client, "show-nfs-mount-settings", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showNfsMountSettingsRes.Success
@@ -168,7 +169,7 @@ func updateGaiaNfsMountSettings(d *schema.ResourceData, m interface{}) error {
         payload["timeout"] = v.(int)
     }
 
-    setNfsMountSettingsRes, err := client.ApiCallSimple("set-nfs-mount-settings", payload)
+    setNfsMountSettingsRes, err := apiCallSimple(client, "set-nfs-mount-settings", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setNfsMountSettingsRes.Success
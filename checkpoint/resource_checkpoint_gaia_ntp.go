@@ -15,6 +15,7 @@ func resourceGaiaNtp() *schema.Resource {
         Read:   readGaiaNtp,
         Update: updateGaiaNtp,
         Delete: deleteGaiaNtp,
+        Importer: importGaiaResource("ntp-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -85,7 +86,7 @@ func createGaiaNtp(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Ntp - Map = ", payload)
 
-    addNtpRes, err := client.ApiCallSimple("set-ntp", payload)
+    addNtpRes, err := apiCallSimple(client, "set-ntp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addNtpRes.Success
@@ -136,7 +137,7 @@ func readGaiaNtp(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showNtpRes, err := client.ApiCallSimple("show-ntp", payload)
+    showNtpRes, err := apiCallSimple(client, "show-ntp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showNtpRes.Success
@@ -220,7 +221,7 @@ func updateGaiaNtp(d *schema.ResourceData, m interface{}) error {
         payload["preferred"] = v.(string)
     }
 
-    setNtpRes, err := client.ApiCallSimple("set-ntp", payload)
+    setNtpRes, err := apiCallSimple(client, "set-ntp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setNtpRes.Success
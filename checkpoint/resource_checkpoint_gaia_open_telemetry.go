@@ -15,6 +15,7 @@ func resourceGaiaOpenTelemetry() *schema.Resource {
         Read:   readGaiaOpenTelemetry,
         Update: updateGaiaOpenTelemetry,
         Delete: deleteGaiaOpenTelemetry,
+        Importer: importGaiaResource("open-telemetry-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -286,7 +287,7 @@ func createGaiaOpenTelemetry(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create OpenTelemetry - Map = ", payload)
 
-    addOpenTelemetryRes, err := client.ApiCallSimple("set-open-telemetry", payload)
+    addOpenTelemetryRes, err := apiCallSimple(client, "set-open-telemetry", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addOpenTelemetryRes.Success
@@ -337,7 +338,7 @@ func readGaiaOpenTelemetry(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showOpenTelemetryRes, err := client.ApiCallSimple("show-open-telemetry", payload)
+    showOpenTelemetryRes, err := apiCallSimple(client, "show-open-telemetry", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showOpenTelemetryRes.Success
@@ -554,7 +555,7 @@ func updateGaiaOpenTelemetry(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setOpenTelemetryRes, err := client.ApiCallSimple("set-open-telemetry", payload)
+    setOpenTelemetryRes, err := apiCallSimple(client, "set-open-telemetry", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setOpenTelemetryRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaParam() *schema.Resource {
         Read:   readGaiaParam,
         Update: updateGaiaParam,
         Delete: deleteGaiaParam,
+        Importer: importGaiaResource("param-", "param_path"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -146,7 +147,7 @@ func createGaiaParam(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Param - Map = ", payload)
 
-    addParamRes, err := client.ApiCallSimple("set-param", payload)
+    addParamRes, err := apiCallSimple(client, "set-param", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addParamRes.Success
@@ -225,7 +226,7 @@ func readGaiaParam(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    showParamRes, err := client.ApiCallSimple("show-param", payload)
+    showParamRes, err := apiCallSimple(client, "show-param", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showParamRes.Success
@@ -331,7 +332,7 @@ func updateGaiaParam(d *schema.ResourceData, m interface{}) error {
         payload["use-regex"] = v.(bool)
     }
 
-    setParamRes, err := client.ApiCallSimple("set-param", payload)
+    setParamRes, err := apiCallSimple(client, "set-param", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setParamRes.Success
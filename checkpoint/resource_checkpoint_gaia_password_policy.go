@@ -15,6 +15,7 @@ func resourceGaiaPasswordPolicy() *schema.Resource {
         Read:   readGaiaPasswordPolicy,
         Update: updateGaiaPasswordPolicy,
         Delete: deleteGaiaPasswordPolicy,
+        Importer: importGaiaResource("password-policy-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -252,7 +253,7 @@ func createGaiaPasswordPolicy(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create PasswordPolicy - Map = ", payload)
 
-    addPasswordPolicyRes, err := client.ApiCallSimple("set-password-policy", payload)
+    addPasswordPolicyRes, err := apiCallSimple(client, "set-password-policy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addPasswordPolicyRes.Success
@@ -303,7 +304,7 @@ func readGaiaPasswordPolicy(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showPasswordPolicyRes, err := client.ApiCallSimple("show-password-policy", payload)
+    showPasswordPolicyRes, err := apiCallSimple(client, "show-password-policy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showPasswordPolicyRes.Success
@@ -489,7 +490,7 @@ func updateGaiaPasswordPolicy(d *schema.ResourceData, m interface{}) error {
         payload["all-users-require-two-factor-authentication"] = v.(bool)
     }
 
-    setPasswordPolicyRes, err := client.ApiCallSimple("set-password-policy", payload)
+    setPasswordPolicyRes, err := apiCallSimple(client, "set-password-policy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setPasswordPolicyRes.Success
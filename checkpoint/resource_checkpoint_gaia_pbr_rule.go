@@ -15,6 +15,7 @@ func resourceGaiaPbrRule() *schema.Resource {
         Read:   readGaiaPbrRule,
         Update: updateGaiaPbrRule,
         Delete: deleteGaiaPbrRule,
+        Importer: importGaiaResource("pbr-rule-", "priority"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -214,7 +215,7 @@ func createGaiaPbrRule(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create PbrRule - Map = ", payload)
 
-    addPbrRuleRes, err := client.ApiCallSimple("set-pbr-rule", payload)
+    addPbrRuleRes, err := apiCallSimple(client, "set-pbr-rule", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addPbrRuleRes.Success
@@ -273,7 +274,7 @@ func readGaiaPbrRule(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showPbrRuleRes, err := client.ApiCallSimple("show-pbr-rule", payload)
+    showPbrRuleRes, err := apiCallSimple(client, "show-pbr-rule", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showPbrRuleRes.Success
@@ -416,7 +417,7 @@ func updateGaiaPbrRule(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setPbrRuleRes, err := client.ApiCallSimple("set-pbr-rule", payload)
+    setPbrRuleRes, err := apiCallSimple(client, "set-pbr-rule", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setPbrRuleRes.Success
@@ -467,7 +468,7 @@ func deleteGaiaPbrRule(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    deletePbrRuleRes, err := client.ApiCallSimple("delete-pbr-rule", payload)
+    deletePbrRuleRes, err := apiCallSimple(client, "delete-pbr-rule", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deletePbrRuleRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaPbrTable() *schema.Resource {
         Read:   readGaiaPbrTable,
         Update: updateGaiaPbrTable,
         Delete: deleteGaiaPbrTable,
+        Importer: importGaiaResource("pbr-table-", "table"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -170,7 +171,7 @@ func createGaiaPbrTable(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create PbrTable - Map = ", payload)
 
-    addPbrTableRes, err := client.ApiCallSimple("set-pbr-table", payload)
+    addPbrTableRes, err := apiCallSimple(client, "set-pbr-table", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addPbrTableRes.Success
@@ -241,7 +242,7 @@ func readGaiaPbrTable(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showPbrTableRes, err := client.ApiCallSimple("show-pbr-table", payload)
+    showPbrTableRes, err := apiCallSimple(client, "show-pbr-table", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showPbrTableRes.Success
@@ -397,7 +398,7 @@ func updateGaiaPbrTable(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setPbrTableRes, err := client.ApiCallSimple("set-pbr-table", payload)
+    setPbrTableRes, err := apiCallSimple(client, "set-pbr-table", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setPbrTableRes.Success
@@ -448,7 +449,7 @@ func deleteGaiaPbrTable(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    deletePbrTableRes, err := client.ApiCallSimple("delete-pbr-table", payload)
+    deletePbrTableRes, err := apiCallSimple(client, "delete-pbr-table", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deletePbrTableRes.Success
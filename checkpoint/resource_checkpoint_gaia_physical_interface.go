@@ -15,6 +15,7 @@ func resourceGaiaPhysicalInterface() *schema.Resource {
         Read:   readGaiaPhysicalInterface,
         Update: updateGaiaPhysicalInterface,
         Delete: deleteGaiaPhysicalInterface,
+        Importer: importGaiaResource("physical-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -470,7 +471,7 @@ func createGaiaPhysicalInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create PhysicalInterface - Map = ", payload)
 
-    addPhysicalInterfaceRes, err := client.ApiCallSimple("set-physical-interface", payload)
+    addPhysicalInterfaceRes, err := apiCallSimple(client, "set-physical-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addPhysicalInterfaceRes.Success
@@ -529,7 +530,7 @@ func readGaiaPhysicalInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showPhysicalInterfaceRes, err := client.ApiCallSimple("show-physical-interface", payload)
+    showPhysicalInterfaceRes, err := apiCallSimple(client, "show-physical-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showPhysicalInterfaceRes.Success
@@ -936,7 +937,7 @@ func updateGaiaPhysicalInterface(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setPhysicalInterfaceRes, err := client.ApiCallSimple("set-physical-interface", payload)
+    setPhysicalInterfaceRes, err := apiCallSimple(client, "set-physical-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setPhysicalInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaPimInterface() *schema.Resource {
         Read:   readGaiaPimInterface,
         Update: updateGaiaPimInterface,
         Delete: deleteGaiaPimInterface,
+        Importer: importGaiaResource("pim-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -125,7 +126,7 @@ func createGaiaPimInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create PimInterface - Map = ", payload)
 
-    addPimInterfaceRes, err := client.ApiCallSimple("add-pim-interface", payload)
+    addPimInterfaceRes, err := apiCallSimple(client, "add-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addPimInterfaceRes.Success
@@ -180,7 +181,7 @@ func readGaiaPimInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showPimInterfaceRes, err := client.ApiCallSimple("show-pim-interface", payload)
+    showPimInterfaceRes, err := apiCallSimple(client, "show-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showPimInterfaceRes.Success
@@ -323,7 +324,7 @@ func updateGaiaPimInterface(d *schema.ResourceData, m interface{}) error {
         payload["ip-reachability-detection"] = v.(bool)
     }
 
-    setPimInterfaceRes, err := client.ApiCallSimple("set-pim-interface", payload)
+    setPimInterfaceRes, err := apiCallSimple(client, "set-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setPimInterfaceRes.Success
@@ -370,7 +371,7 @@ func deleteGaiaPimInterface(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deletePimInterfaceRes, err := client.ApiCallSimple("delete-pim-interface", payload)
+    deletePimInterfaceRes, err := apiCallSimple(client, "delete-pim-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deletePimInterfaceRes.Success
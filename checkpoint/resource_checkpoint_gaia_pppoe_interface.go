@@ -15,6 +15,7 @@ func resourceGaiaPppoeInterface() *schema.Resource {
         Read:   readGaiaPppoeInterface,
         Update: updateGaiaPppoeInterface,
         Delete: deleteGaiaPppoeInterface,
+        Importer: importGaiaResource("pppoe-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -299,7 +300,7 @@ func createGaiaPppoeInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create PppoeInterface - Map = ", payload)
 
-    addPppoeInterfaceRes, err := client.ApiCallSimple("add-pppoe-interface", payload)
+    addPppoeInterfaceRes, err := apiCallSimple(client, "add-pppoe-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addPppoeInterfaceRes.Success
@@ -357,7 +358,7 @@ func createGaiaPppoeInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setPppoeInterfaceRes, err := client.ApiCallSimple("set-pppoe-interface", updatePayload)
+        setPppoeInterfaceRes, err := apiCallSimple(client, "set-pppoe-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for pppoe-interface: %v", err)
         }
@@ -382,7 +383,7 @@ func readGaiaPppoeInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showPppoeInterfaceRes, err := client.ApiCallSimple("show-pppoe-interface", payload)
+    showPppoeInterfaceRes, err := apiCallSimple(client, "show-pppoe-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showPppoeInterfaceRes.Success
@@ -615,7 +616,7 @@ func updateGaiaPppoeInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    setPppoeInterfaceRes, err := client.ApiCallSimple("set-pppoe-interface", payload)
+    setPppoeInterfaceRes, err := apiCallSimple(client, "set-pppoe-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setPppoeInterfaceRes.Success
@@ -659,7 +660,7 @@ func deleteGaiaPppoeInterface(d *schema.ResourceData, m interface{}) error {
     payload := map[string]interface{}{}
 
    payload["name"] = d.Get("name")
-    deletePppoeInterfaceRes, err := client.ApiCallSimple("delete-pppoe-interface", payload)
+    deletePppoeInterfaceRes, err := apiCallSimple(client, "delete-pppoe-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deletePppoeInterfaceRes.Success
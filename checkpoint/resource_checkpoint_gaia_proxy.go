@@ -15,6 +15,7 @@ func resourceGaiaProxy() *schema.Resource {
         Read:   readGaiaProxy,
         Update: updateGaiaProxy,
         Delete: deleteGaiaProxy,
+        Importer: importGaiaResource("proxy-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -56,7 +57,7 @@ func createGaiaProxy(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Proxy - Map = ", payload)
 
-    addProxyRes, err := client.ApiCallSimple("set-proxy", payload)
+    addProxyRes, err := apiCallSimple(client, "set-proxy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addProxyRes.Success
@@ -107,7 +108,7 @@ func readGaiaProxy(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showProxyRes, err := client.ApiCallSimple("show-proxy", payload)
+    showProxyRes, err := apiCallSimple(client, "show-proxy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showProxyRes.Success
@@ -184,7 +185,7 @@ func updateGaiaProxy(d *schema.ResourceData, m interface{}) error {
         payload["port"] = v.(int)
     }
 
-    setProxyRes, err := client.ApiCallSimple("set-proxy", payload)
+    setProxyRes, err := apiCallSimple(client, "set-proxy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setProxyRes.Success
@@ -227,7 +228,7 @@ func deleteGaiaProxy(d *schema.ResourceData, m interface{}) error {
 
     payload := map[string]interface{}{}
 
-    deleteProxyRes, err := client.ApiCallSimple("delete-proxy", payload)
+    deleteProxyRes, err := apiCallSimple(client, "delete-proxy", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteProxyRes.Success
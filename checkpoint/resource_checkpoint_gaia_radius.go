@@ -15,6 +15,7 @@ func resourceGaiaRadius() *schema.Resource {
         Read:   readGaiaRadius,
         Update: updateGaiaRadius,
         Delete: deleteGaiaRadius,
+        Importer: importGaiaResource("radius-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -141,7 +142,7 @@ func createGaiaRadius(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Radius - Map = ", payload)
 
-    addRadiusRes, err := client.ApiCallSimple("set-radius", payload)
+    addRadiusRes, err := apiCallSimple(client, "set-radius", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRadiusRes.Success
@@ -192,7 +193,7 @@ func readGaiaRadius(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showRadiusRes, err := client.ApiCallSimple("show-radius", payload)
+    showRadiusRes, err := apiCallSimple(client, "show-radius", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRadiusRes.Success
@@ -322,7 +323,7 @@ func updateGaiaRadius(d *schema.ResourceData, m interface{}) error {
         payload["enabled"] = v.(bool)
     }
 
-    setRadiusRes, err := client.ApiCallSimple("set-radius", payload)
+    setRadiusRes, err := apiCallSimple(client, "set-radius", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRadiusRes.Success
@@ -362,7 +363,7 @@ func deleteGaiaRadius(d *schema.ResourceData, m interface{}) error {
     client := m.(*checkpoint.ApiClient)
     ensureDebugServerFromClient(client)
     payload := map[string]interface{}{"servers": []interface{}{}}
-    res, err := client.ApiCallSimple("set-radius", payload)
+    res, err := apiCallSimple(client, "set-radius", payload)
     if err != nil {
         return fmt.Errorf("Failed to clear radius: %v", err)
     }
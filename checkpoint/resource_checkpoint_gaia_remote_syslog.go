@@ -15,6 +15,7 @@ func resourceGaiaRemoteSyslog() *schema.Resource {
         Read:   readGaiaRemoteSyslog,
         Update: updateGaiaRemoteSyslog,
         Delete: deleteGaiaRemoteSyslog,
+        Importer: importGaiaResource("remote-syslog-", "server_ip"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -131,7 +132,7 @@ func createGaiaRemoteSyslog(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create RemoteSyslog - Map = ", payload)
 
-    addRemoteSyslogRes, err := client.ApiCallSimple("add-remote-syslog", payload)
+    addRemoteSyslogRes, err := apiCallSimple(client, "add-remote-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRemoteSyslogRes.Success
@@ -186,7 +187,7 @@ func readGaiaRemoteSyslog(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showRemoteSyslogRes, err := client.ApiCallSimple("show-remote-syslog", payload)
+    showRemoteSyslogRes, err := apiCallSimple(client, "show-remote-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRemoteSyslogRes.Success
@@ -308,7 +309,7 @@ func updateGaiaRemoteSyslog(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setRemoteSyslogRes, err := client.ApiCallSimple("set-remote-syslog", payload)
+    setRemoteSyslogRes, err := apiCallSimple(client, "set-remote-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRemoteSyslogRes.Success
@@ -355,7 +356,7 @@ func deleteGaiaRemoteSyslog(d *schema.ResourceData, m interface{}) error {
         payload["server-ip"] = v.(string)
     }
 
-    deleteRemoteSyslogRes, err := client.ApiCallSimple("delete-remote-syslog", payload)
+    deleteRemoteSyslogRes, err := apiCallSimple(client, "delete-remote-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteRemoteSyslogRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaRole() *schema.Resource {
         Read:   readGaiaRole,
         Update: updateGaiaRole,
         Delete: deleteGaiaRole,
+        Importer: importGaiaResource("role-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -91,7 +92,7 @@ func createGaiaRole(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Role - Map = ", payload)
 
-    addRoleRes, err := client.ApiCallSimple("add-role", payload)
+    addRoleRes, err := apiCallSimple(client, "add-role", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRoleRes.Success
@@ -146,7 +147,7 @@ func readGaiaRole(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showRoleRes, err := client.ApiCallSimple("show-role", payload)
+    showRoleRes, err := apiCallSimple(client, "show-role", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRoleRes.Success
@@ -226,7 +227,7 @@ func updateGaiaRole(d *schema.ResourceData, m interface{}) error {
         payload["extended-commands"] = v.(*schema.Set).List()
     }
 
-    setRoleRes, err := client.ApiCallSimple("set-role", payload)
+    setRoleRes, err := apiCallSimple(client, "set-role", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRoleRes.Success
@@ -273,7 +274,7 @@ func deleteGaiaRole(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteRoleRes, err := client.ApiCallSimple("delete-role", payload)
+    deleteRoleRes, err := apiCallSimple(client, "delete-role", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteRoleRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaRouteRedistributionToBgpAs() *schema.Resource {
         Read:   readGaiaRouteRedistributionToBgpAs,
         Update: updateGaiaRouteRedistributionToBgpAs,
         Delete: deleteGaiaRouteRedistributionToBgpAs,
+        Importer: importGaiaResource("route-redistribution-to-bgp-as-", "as_number"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -2317,7 +2318,7 @@ func createGaiaRouteRedistributionToBgpAs(d *schema.ResourceData, m interface{})
 
     log.Println("Create RouteRedistributionToBgpAs - Map = ", payload)
 
-    addRouteRedistributionToBgpAsRes, err := client.ApiCallSimple("set-route-redistribution-to-bgp-as", payload)
+    addRouteRedistributionToBgpAsRes, err := apiCallSimple(client, "set-route-redistribution-to-bgp-as", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouteRedistributionToBgpAsRes.Success
@@ -2372,7 +2373,7 @@ func readGaiaRouteRedistributionToBgpAs(d *schema.ResourceData, m interface{}) e
         payload["member-id"] = v.(string)
     }
 
-    showRouteRedistributionToBgpAsRes, err := client.ApiCallSimple("show-route-redistribution-to-bgp-as", payload)
+    showRouteRedistributionToBgpAsRes, err := apiCallSimple(client, "show-route-redistribution-to-bgp-as", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouteRedistributionToBgpAsRes.Success
@@ -3732,7 +3733,7 @@ func updateGaiaRouteRedistributionToBgpAs(d *schema.ResourceData, m interface{})
         payload["reset"] = v.(bool)
     }
 
-    setRouteRedistributionToBgpAsRes, err := client.ApiCallSimple("set-route-redistribution-to-bgp-as", payload)
+    setRouteRedistributionToBgpAsRes, err := apiCallSimple(client, "set-route-redistribution-to-bgp-as", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouteRedistributionToBgpAsRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaRouteRedistributionToIsis() *schema.Resource {
         Read:   readGaiaRouteRedistributionToIsis,
         Update: updateGaiaRouteRedistributionToIsis,
         Delete: deleteGaiaRouteRedistributionToIsis,
+        Importer: importGaiaResource("route-redistribution-to-isis-", "level"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -1888,7 +1889,7 @@ func createGaiaRouteRedistributionToIsis(d *schema.ResourceData, m interface{})
 
     log.Println("Create RouteRedistributionToIsis - Map = ", payload)
 
-    addRouteRedistributionToIsisRes, err := client.ApiCallSimple("set-route-redistribution-to-isis", payload)
+    addRouteRedistributionToIsisRes, err := apiCallSimple(client, "set-route-redistribution-to-isis", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouteRedistributionToIsisRes.Success
@@ -1943,7 +1944,7 @@ func readGaiaRouteRedistributionToIsis(d *schema.ResourceData, m interface{}) er
         payload["member-id"] = v.(string)
     }
 
-    showRouteRedistributionToIsisRes, err := client.ApiCallSimple("show-route-redistribution-to-isis", payload)
+    showRouteRedistributionToIsisRes, err := apiCallSimple(client, "show-route-redistribution-to-isis", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouteRedistributionToIsisRes.Success
@@ -3022,7 +3023,7 @@ func updateGaiaRouteRedistributionToIsis(d *schema.ResourceData, m interface{})
         payload["reset"] = v.(bool)
     }
 
-    setRouteRedistributionToIsisRes, err := client.ApiCallSimple("set-route-redistribution-to-isis", payload)
+    setRouteRedistributionToIsisRes, err := apiCallSimple(client, "set-route-redistribution-to-isis", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouteRedistributionToIsisRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaRouteRedistributionToOspf2() *schema.Resource {
         Read:   readGaiaRouteRedistributionToOspf2,
         Update: updateGaiaRouteRedistributionToOspf2,
         Delete: deleteGaiaRouteRedistributionToOspf2,
+        Importer: importGaiaResource("route-redistribution-to-ospf2-", "instance"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -1513,7 +1514,7 @@ func createGaiaRouteRedistributionToOspf2(d *schema.ResourceData, m interface{})
 
     log.Println("Create RouteRedistributionToOspf2 - Map = ", payload)
 
-    addRouteRedistributionToOspf2Res, err := client.ApiCallSimple("set-route-redistribution-to-ospf2", payload)
+    addRouteRedistributionToOspf2Res, err := apiCallSimple(client, "set-route-redistribution-to-ospf2", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouteRedistributionToOspf2Res.Success
@@ -1568,7 +1569,7 @@ func readGaiaRouteRedistributionToOspf2(d *schema.ResourceData, m interface{}) e
         payload["member-id"] = v.(string)
     }
 
-    showRouteRedistributionToOspf2Res, err := client.ApiCallSimple("show-route-redistribution-to-ospf2", payload)
+    showRouteRedistributionToOspf2Res, err := apiCallSimple(client, "show-route-redistribution-to-ospf2", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouteRedistributionToOspf2Res.Success
@@ -2451,7 +2452,7 @@ func updateGaiaRouteRedistributionToOspf2(d *schema.ResourceData, m interface{})
         payload["reset"] = v.(bool)
     }
 
-    setRouteRedistributionToOspf2Res, err := client.ApiCallSimple("set-route-redistribution-to-ospf2", payload)
+    setRouteRedistributionToOspf2Res, err := apiCallSimple(client, "set-route-redistribution-to-ospf2", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouteRedistributionToOspf2Res.Success
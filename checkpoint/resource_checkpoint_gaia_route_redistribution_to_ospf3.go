@@ -15,6 +15,7 @@ func resourceGaiaRouteRedistributionToOspf3() *schema.Resource {
         Read:   readGaiaRouteRedistributionToOspf3,
         Update: updateGaiaRouteRedistributionToOspf3,
         Delete: deleteGaiaRouteRedistributionToOspf3,
+        Importer: importGaiaResource("route-redistribution-to-ospf3-", "instance"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -1220,7 +1221,7 @@ func createGaiaRouteRedistributionToOspf3(d *schema.ResourceData, m interface{})
 
     log.Println("Create RouteRedistributionToOspf3 - Map = ", payload)
 
-    addRouteRedistributionToOspf3Res, err := client.ApiCallSimple("set-route-redistribution-to-ospf3", payload)
+    addRouteRedistributionToOspf3Res, err := apiCallSimple(client, "set-route-redistribution-to-ospf3", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouteRedistributionToOspf3Res.Success
@@ -1275,7 +1276,7 @@ func readGaiaRouteRedistributionToOspf3(d *schema.ResourceData, m interface{}) e
         payload["member-id"] = v.(string)
     }
 
-    showRouteRedistributionToOspf3Res, err := client.ApiCallSimple("show-route-redistribution-to-ospf3", payload)
+    showRouteRedistributionToOspf3Res, err := apiCallSimple(client, "show-route-redistribution-to-ospf3", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouteRedistributionToOspf3Res.Success
@@ -2076,7 +2077,7 @@ func updateGaiaRouteRedistributionToOspf3(d *schema.ResourceData, m interface{})
         payload["reset"] = v.(bool)
     }
 
-    setRouteRedistributionToOspf3Res, err := client.ApiCallSimple("set-route-redistribution-to-ospf3", payload)
+    setRouteRedistributionToOspf3Res, err := apiCallSimple(client, "set-route-redistribution-to-ospf3", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouteRedistributionToOspf3Res.Success
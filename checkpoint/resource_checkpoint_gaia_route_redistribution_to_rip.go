@@ -15,6 +15,7 @@ func resourceGaiaRouteRedistributionToRip() *schema.Resource {
         Read:   readGaiaRouteRedistributionToRip,
         Update: updateGaiaRouteRedistributionToRip,
         Delete: deleteGaiaRouteRedistributionToRip,
+        Importer: importGaiaResource("route-redistribution-to-rip-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -1357,7 +1358,7 @@ func createGaiaRouteRedistributionToRip(d *schema.ResourceData, m interface{}) e
 
     log.Println("Create RouteRedistributionToRip - Map = ", payload)
 
-    addRouteRedistributionToRipRes, err := client.ApiCallSimple("set-route-redistribution-to-rip", payload)
+    addRouteRedistributionToRipRes, err := apiCallSimple(client, "set-route-redistribution-to-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouteRedistributionToRipRes.Success
@@ -1408,7 +1409,7 @@ func readGaiaRouteRedistributionToRip(d *schema.ResourceData, m interface{}) err
         payload["member-id"] = v.(string)
     }
 
-    showRouteRedistributionToRipRes, err := client.ApiCallSimple("show-route-redistribution-to-rip", payload)
+    showRouteRedistributionToRipRes, err := apiCallSimple(client, "show-route-redistribution-to-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouteRedistributionToRipRes.Success
@@ -2268,7 +2269,7 @@ func updateGaiaRouteRedistributionToRip(d *schema.ResourceData, m interface{}) e
         payload["reset"] = v.(bool)
     }
 
-    setRouteRedistributionToRipRes, err := client.ApiCallSimple("set-route-redistribution-to-rip", payload)
+    setRouteRedistributionToRipRes, err := apiCallSimple(client, "set-route-redistribution-to-rip", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouteRedistributionToRipRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaRouteRedistributionToRipng() *schema.Resource {
         Read:   readGaiaRouteRedistributionToRipng,
         Update: updateGaiaRouteRedistributionToRipng,
         Delete: deleteGaiaRouteRedistributionToRipng,
+        Importer: importGaiaResource("route-redistribution-to-ripng-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -1107,7 +1108,7 @@ func createGaiaRouteRedistributionToRipng(d *schema.ResourceData, m interface{})
 
     log.Println("Create RouteRedistributionToRipng - Map = ", payload)
 
-    addRouteRedistributionToRipngRes, err := client.ApiCallSimple("set-route-redistribution-to-ripng", payload)
+    addRouteRedistributionToRipngRes, err := apiCallSimple(client, "set-route-redistribution-to-ripng", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouteRedistributionToRipngRes.Success
@@ -1158,7 +1159,7 @@ func readGaiaRouteRedistributionToRipng(d *schema.ResourceData, m interface{}) e
         payload["member-id"] = v.(string)
     }
 
-    showRouteRedistributionToRipngRes, err := client.ApiCallSimple("show-route-redistribution-to-ripng", payload)
+    showRouteRedistributionToRipngRes, err := apiCallSimple(client, "show-route-redistribution-to-ripng", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouteRedistributionToRipngRes.Success
@@ -1909,7 +1910,7 @@ func updateGaiaRouteRedistributionToRipng(d *schema.ResourceData, m interface{})
         payload["reset"] = v.(bool)
     }
 
-    setRouteRedistributionToRipngRes, err := client.ApiCallSimple("set-route-redistribution-to-ripng", payload)
+    setRouteRedistributionToRipngRes, err := apiCallSimple(client, "set-route-redistribution-to-ripng", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouteRedistributionToRipngRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaRoutemapId() *schema.Resource {
         Read:   readGaiaRoutemapId,
         Update: updateGaiaRoutemapId,
         Delete: deleteGaiaRoutemapId,
+        Importer: importGaiaResource("routemap-id-", "name", "resource_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -95,7 +96,7 @@ func createGaiaRoutemapId(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create RoutemapId - Map = ", payload)
 
-    addRoutemapIdRes, err := client.ApiCallSimple("add-routemap-id", payload)
+    addRoutemapIdRes, err := apiCallSimple(client, "add-routemap-id", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRoutemapIdRes.Success
@@ -154,7 +155,7 @@ func readGaiaRoutemapId(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showRoutemapIdRes, err := client.ApiCallSimple("show-routemap", payload)
+    showRoutemapIdRes, err := apiCallSimple(client, "show-routemap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRoutemapIdRes.Success
@@ -267,7 +268,7 @@ func updateGaiaRoutemapId(d *schema.ResourceData, m interface{}) error {
         payload["state"] = v.(string)
     }
 
-    setRoutemapIdRes, err := client.ApiCallSimple("set-routemap-id", payload)
+    setRoutemapIdRes, err := apiCallSimple(client, "set-routemap-id", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRoutemapIdRes.Success
@@ -318,7 +319,7 @@ func deleteGaiaRoutemapId(d *schema.ResourceData, m interface{}) error {
         payload["id"] = v.(int)
     }
 
-    deleteRoutemapIdRes, err := client.ApiCallSimple("delete-routemap-id", payload)
+    deleteRoutemapIdRes, err := apiCallSimple(client, "delete-routemap-id", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteRoutemapIdRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaRouterId() *schema.Resource {
         Read:   readGaiaRouterId,
         Update: updateGaiaRouterId,
         Delete: deleteGaiaRouterId,
+        Importer: importGaiaResource("router-id-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaRouterId(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create RouterId - Map = ", payload)
 
-    addRouterIdRes, err := client.ApiCallSimple("set-router-id", payload)
+    addRouterIdRes, err := apiCallSimple(client, "set-router-id", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addRouterIdRes.Success
@@ -98,7 +99,7 @@ func readGaiaRouterId(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showRouterIdRes, err := client.ApiCallSimple("show-router-id", payload)
+    showRouterIdRes, err := apiCallSimple(client, "show-router-id", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showRouterIdRes.Success
@@ -168,7 +169,7 @@ func updateGaiaRouterId(d *schema.ResourceData, m interface{}) error {
         payload["router-id"] = v.(string)
     }
 
-    setRouterIdRes, err := client.ApiCallSimple("set-router-id", payload)
+    setRouterIdRes, err := apiCallSimple(client, "set-router-id", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setRouterIdRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaScheduledBackup() *schema.Resource {
         Read:   readGaiaScheduledBackup,
         Update: updateGaiaScheduledBackup,
         Delete: deleteGaiaScheduledBackup,
+        Importer: importGaiaResource("scheduled-backup-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -240,7 +241,7 @@ func createGaiaScheduledBackup(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create ScheduledBackup - Map = ", payload)
 
-    addScheduledBackupRes, err := client.ApiCallSimple("add-scheduled-backup", payload)
+    addScheduledBackupRes, err := apiCallSimple(client, "add-scheduled-backup", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addScheduledBackupRes.Success
@@ -295,7 +296,7 @@ func readGaiaScheduledBackup(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showScheduledBackupRes, err := client.ApiCallSimple("show-scheduled-backup", payload)
+    showScheduledBackupRes, err := apiCallSimple(client, "show-scheduled-backup", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showScheduledBackupRes.Success
@@ -446,7 +447,7 @@ func updateGaiaScheduledBackup(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setScheduledBackupRes, err := client.ApiCallSimple("set-scheduled-backup", payload)
+    setScheduledBackupRes, err := apiCallSimple(client, "set-scheduled-backup", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setScheduledBackupRes.Success
@@ -493,7 +494,7 @@ func deleteGaiaScheduledBackup(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteScheduledBackupRes, err := client.ApiCallSimple("delete-scheduled-backup", payload)
+    deleteScheduledBackupRes, err := apiCallSimple(client, "delete-scheduled-backup", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteScheduledBackupRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaScheduledJob() *schema.Resource {
         Read:   readGaiaScheduledJob,
         Update: updateGaiaScheduledJob,
         Delete: deleteGaiaScheduledJob,
+        Importer: importGaiaResource("scheduled-job-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -192,7 +193,7 @@ func createGaiaScheduledJob(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create ScheduledJob - Map = ", payload)
 
-    addScheduledJobRes, err := client.ApiCallSimple("add-scheduled-job", payload)
+    addScheduledJobRes, err := apiCallSimple(client, "add-scheduled-job", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addScheduledJobRes.Success
@@ -247,7 +248,7 @@ func readGaiaScheduledJob(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showScheduledJobRes, err := client.ApiCallSimple("show-scheduled-job", payload)
+    showScheduledJobRes, err := apiCallSimple(client, "show-scheduled-job", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showScheduledJobRes.Success
@@ -374,7 +375,7 @@ func updateGaiaScheduledJob(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setScheduledJobRes, err := client.ApiCallSimple("set-scheduled-job", payload)
+    setScheduledJobRes, err := apiCallSimple(client, "set-scheduled-job", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setScheduledJobRes.Success
@@ -421,7 +422,7 @@ func deleteGaiaScheduledJob(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteScheduledJobRes, err := client.ApiCallSimple("delete-scheduled-job", payload)
+    deleteScheduledJobRes, err := apiCallSimple(client, "delete-scheduled-job", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteScheduledJobRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaScheduledJobMail() *schema.Resource {
         Read:   readGaiaScheduledJobMail,
         Update: updateGaiaScheduledJobMail,
         Delete: deleteGaiaScheduledJobMail,
+        Importer: importGaiaResource("scheduled-job-mail-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -47,7 +48,7 @@ func createGaiaScheduledJobMail(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create ScheduledJobMail - Map = ", payload)
 
-    addScheduledJobMailRes, err := client.ApiCallSimple("set-scheduled-job-mail", payload)
+    addScheduledJobMailRes, err := apiCallSimple(client, "set-scheduled-job-mail", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addScheduledJobMailRes.Success
@@ -98,7 +99,7 @@ func readGaiaScheduledJobMail(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showScheduledJobMailRes, err := client.ApiCallSimple("show-scheduled-job-mail", payload)
+    showScheduledJobMailRes, err := apiCallSimple(client, "show-scheduled-job-mail", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showScheduledJobMailRes.Success
@@ -164,7 +165,7 @@ func updateGaiaScheduledJobMail(d *schema.ResourceData, m interface{}) error {
         payload["email-address"] = v.(string)
     }
 
-    setScheduledJobMailRes, err := client.ApiCallSimple("set-scheduled-job-mail", payload)
+    setScheduledJobMailRes, err := apiCallSimple(client, "set-scheduled-job-mail", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setScheduledJobMailRes.Success
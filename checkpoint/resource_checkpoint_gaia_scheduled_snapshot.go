@@ -15,6 +15,7 @@ func resourceGaiaScheduledSnapshot() *schema.Resource {
         Read:   readGaiaScheduledSnapshot,
         Update: updateGaiaScheduledSnapshot,
         Delete: deleteGaiaScheduledSnapshot,
+        Importer: importGaiaResource("scheduled-snapshot-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -258,7 +259,7 @@ func createGaiaScheduledSnapshot(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create ScheduledSnapshot - Map = ", payload)
 
-    addScheduledSnapshotRes, err := client.ApiCallSimple("set-scheduled-snapshot", payload)
+    addScheduledSnapshotRes, err := apiCallSimple(client, "set-scheduled-snapshot", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addScheduledSnapshotRes.Success
@@ -309,7 +310,7 @@ func readGaiaScheduledSnapshot(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showScheduledSnapshotRes, err := client.ApiCallSimple("show-scheduled-snapshot", payload)
+    showScheduledSnapshotRes, err := apiCallSimple(client, "show-scheduled-snapshot", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showScheduledSnapshotRes.Success
@@ -475,7 +476,7 @@ func updateGaiaScheduledSnapshot(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setScheduledSnapshotRes, err := client.ApiCallSimple("set-scheduled-snapshot", payload)
+    setScheduledSnapshotRes, err := apiCallSimple(client, "set-scheduled-snapshot", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setScheduledSnapshotRes.Success
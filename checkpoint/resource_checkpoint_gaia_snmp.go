@@ -15,6 +15,7 @@ func resourceGaiaSnmp() *schema.Resource {
         Read:   readGaiaSnmp,
         Update: updateGaiaSnmp,
         Delete: deleteGaiaSnmp,
+        Importer: importGaiaResource("snmp-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -213,7 +214,7 @@ func createGaiaSnmp(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Snmp - Map = ", payload)
 
-    addSnmpRes, err := client.ApiCallSimple("set-snmp", payload)
+    addSnmpRes, err := apiCallSimple(client, "set-snmp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSnmpRes.Success
@@ -264,7 +265,7 @@ func readGaiaSnmp(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSnmpRes, err := client.ApiCallSimple("show-snmp", payload)
+    showSnmpRes, err := apiCallSimple(client, "show-snmp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSnmpRes.Success
@@ -431,7 +432,7 @@ func updateGaiaSnmp(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setSnmpRes, err := client.ApiCallSimple("set-snmp", payload)
+    setSnmpRes, err := apiCallSimple(client, "set-snmp", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSnmpRes.Success
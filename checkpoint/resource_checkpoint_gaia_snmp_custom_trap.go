@@ -15,6 +15,7 @@ func resourceGaiaSnmpCustomTrap() *schema.Resource {
         Read:   readGaiaSnmpCustomTrap,
         Update: updateGaiaSnmpCustomTrap,
         Delete: deleteGaiaSnmpCustomTrap,
+        Importer: importGaiaResource("snmp-custom-trap-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -92,7 +93,7 @@ func createGaiaSnmpCustomTrap(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create SnmpCustomTrap - Map = ", payload)
 
-    addSnmpCustomTrapRes, err := client.ApiCallSimple("add-snmp-custom-trap", payload)
+    addSnmpCustomTrapRes, err := apiCallSimple(client, "add-snmp-custom-trap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSnmpCustomTrapRes.Success
@@ -147,7 +148,7 @@ func readGaiaSnmpCustomTrap(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSnmpCustomTrapRes, err := client.ApiCallSimple("show-snmp-custom-trap", payload)
+    showSnmpCustomTrapRes, err := apiCallSimple(client, "show-snmp-custom-trap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSnmpCustomTrapRes.Success
@@ -252,7 +253,7 @@ func updateGaiaSnmpCustomTrap(d *schema.ResourceData, m interface{}) error {
         payload["message"] = v.(string)
     }
 
-    setSnmpCustomTrapRes, err := client.ApiCallSimple("set-snmp-custom-trap", payload)
+    setSnmpCustomTrapRes, err := apiCallSimple(client, "set-snmp-custom-trap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSnmpCustomTrapRes.Success
@@ -299,7 +300,7 @@ func deleteGaiaSnmpCustomTrap(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteSnmpCustomTrapRes, err := client.ApiCallSimple("delete-snmp-custom-trap", payload)
+    deleteSnmpCustomTrapRes, err := apiCallSimple(client, "delete-snmp-custom-trap", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteSnmpCustomTrapRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaSnmpPreDefinedTraps() *schema.Resource {
         Read:   readGaiaSnmpPreDefinedTraps,
         Update: updateGaiaSnmpPreDefinedTraps,
         Delete: deleteGaiaSnmpPreDefinedTraps,
+        Importer: importGaiaResource("snmp-pre-defined-traps-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -522,7 +523,7 @@ func createGaiaSnmpPreDefinedTraps(d *schema.ResourceData, m interface{}) error
 
     log.Println("Create SnmpPreDefinedTraps - Map = ", payload)
 
-    addSnmpPreDefinedTrapsRes, err := client.ApiCallSimple("set-snmp-pre-defined-traps", payload)
+    addSnmpPreDefinedTrapsRes, err := apiCallSimple(client, "set-snmp-pre-defined-traps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSnmpPreDefinedTrapsRes.Success
@@ -573,7 +574,7 @@ func readGaiaSnmpPreDefinedTraps(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSnmpPreDefinedTrapsRes, err := client.ApiCallSimple("show-snmp-pre-defined-traps", payload)
+    showSnmpPreDefinedTrapsRes, err := apiCallSimple(client, "show-snmp-pre-defined-traps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSnmpPreDefinedTrapsRes.Success
@@ -890,7 +891,7 @@ func updateGaiaSnmpPreDefinedTraps(d *schema.ResourceData, m interface{}) error
         }
     }
 
-    setSnmpPreDefinedTrapsRes, err := client.ApiCallSimple("set-snmp-pre-defined-traps", payload)
+    setSnmpPreDefinedTrapsRes, err := apiCallSimple(client, "set-snmp-pre-defined-traps", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSnmpPreDefinedTrapsRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaSnmpTrapReceiver() *schema.Resource {
         Read:   readGaiaSnmpTrapReceiver,
         Update: updateGaiaSnmpTrapReceiver,
         Delete: deleteGaiaSnmpTrapReceiver,
+        Importer: importGaiaResource("snmp-trap-receiver-", "address"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -65,7 +66,7 @@ func createGaiaSnmpTrapReceiver(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create SnmpTrapReceiver - Map = ", payload)
 
-    addSnmpTrapReceiverRes, err := client.ApiCallSimple("add-snmp-trap-receiver", payload)
+    addSnmpTrapReceiverRes, err := apiCallSimple(client, "add-snmp-trap-receiver", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSnmpTrapReceiverRes.Success
@@ -120,7 +121,7 @@ func readGaiaSnmpTrapReceiver(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSnmpTrapReceiverRes, err := client.ApiCallSimple("show-snmp-trap-receiver", payload)
+    showSnmpTrapReceiverRes, err := apiCallSimple(client, "show-snmp-trap-receiver", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSnmpTrapReceiverRes.Success
@@ -200,7 +201,7 @@ func updateGaiaSnmpTrapReceiver(d *schema.ResourceData, m interface{}) error {
         payload["community-string"] = v.(string)
     }
 
-    setSnmpTrapReceiverRes, err := client.ApiCallSimple("set-snmp-trap-receiver", payload)
+    setSnmpTrapReceiverRes, err := apiCallSimple(client, "set-snmp-trap-receiver", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSnmpTrapReceiverRes.Success
@@ -247,7 +248,7 @@ func deleteGaiaSnmpTrapReceiver(d *schema.ResourceData, m interface{}) error {
         payload["address"] = v.(string)
     }
 
-    deleteSnmpTrapReceiverRes, err := client.ApiCallSimple("delete-snmp-trap-receiver", payload)
+    deleteSnmpTrapReceiverRes, err := apiCallSimple(client, "delete-snmp-trap-receiver", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteSnmpTrapReceiverRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaSnmpUser() *schema.Resource {
         Read:   readGaiaSnmpUser,
         Update: updateGaiaSnmpUser,
         Delete: deleteGaiaSnmpUser,
+        Importer: importGaiaResource("snmp-user-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -144,7 +145,7 @@ func createGaiaSnmpUser(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create SnmpUser - Map = ", payload)
 
-    addSnmpUserRes, err := client.ApiCallSimple("add-snmp-user", payload)
+    addSnmpUserRes, err := apiCallSimple(client, "add-snmp-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSnmpUserRes.Success
@@ -199,7 +200,7 @@ func readGaiaSnmpUser(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSnmpUserRes, err := client.ApiCallSimple("show-snmp-user", payload)
+    showSnmpUserRes, err := apiCallSimple(client, "show-snmp-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSnmpUserRes.Success
@@ -339,7 +340,7 @@ func updateGaiaSnmpUser(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setSnmpUserRes, err := client.ApiCallSimple("set-snmp-user", payload)
+    setSnmpUserRes, err := apiCallSimple(client, "set-snmp-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSnmpUserRes.Success
@@ -386,7 +387,7 @@ func deleteGaiaSnmpUser(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteSnmpUserRes, err := client.ApiCallSimple("delete-snmp-user", payload)
+    deleteSnmpUserRes, err := apiCallSimple(client, "delete-snmp-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteSnmpUserRes.Success
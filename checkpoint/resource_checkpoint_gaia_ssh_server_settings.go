@@ -15,6 +15,7 @@ func resourceGaiaSshServerSettings() *schema.Resource {
         Read:   readGaiaSshServerSettings,
         Update: updateGaiaSshServerSettings,
         Delete: deleteGaiaSshServerSettings,
+        Importer: importGaiaResource("ssh-server-settings-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -234,7 +235,7 @@ func createGaiaSshServerSettings(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create SshServerSettings - Map = ", payload)
 
-    addSshServerSettingsRes, err := client.ApiCallSimple("set-ssh-server-settings", payload)
+    addSshServerSettingsRes, err := apiCallSimple(client, "set-ssh-server-settings", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSshServerSettingsRes.Success
@@ -289,7 +290,7 @@ func readGaiaSshServerSettings(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSshServerSettingsRes, err := client.ApiCallSimple("show-ssh-server-settings", payload)
+    showSshServerSettingsRes, err := apiCallSimple(client, "show-ssh-server-settings", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSshServerSettingsRes.Success
@@ -461,7 +462,7 @@ func updateGaiaSshServerSettings(d *schema.ResourceData, m interface{}) error {
         payload["login-grace-time"] = v.(int)
     }
 
-    setSshServerSettingsRes, err := client.ApiCallSimple("set-ssh-server-settings", payload)
+    setSshServerSettingsRes, err := apiCallSimple(client, "set-ssh-server-settings", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSshServerSettingsRes.Success
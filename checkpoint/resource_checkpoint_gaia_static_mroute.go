@@ -15,6 +15,7 @@ func resourceGaiaStaticMroute() *schema.Resource {
         Read:   readGaiaStaticMroute,
         Update: updateGaiaStaticMroute,
         Delete: deleteGaiaStaticMroute,
+        Importer: importGaiaResource("static-mroute-", "address", "mask_length"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -113,7 +114,7 @@ func createGaiaStaticMroute(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create StaticMroute - Map = ", payload)
 
-    addStaticMrouteRes, err := client.ApiCallSimple("set-static-mroute", payload)
+    addStaticMrouteRes, err := apiCallSimple(client, "set-static-mroute", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addStaticMrouteRes.Success
@@ -176,7 +177,7 @@ func readGaiaStaticMroute(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showStaticMrouteRes, err := client.ApiCallSimple("show-static-mroute", payload)
+    showStaticMrouteRes, err := apiCallSimple(client, "show-static-mroute", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showStaticMrouteRes.Success
@@ -294,7 +295,7 @@ func updateGaiaStaticMroute(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setStaticMrouteRes, err := client.ApiCallSimple("set-static-mroute", payload)
+    setStaticMrouteRes, err := apiCallSimple(client, "set-static-mroute", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setStaticMrouteRes.Success
@@ -349,7 +350,7 @@ func deleteGaiaStaticMroute(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    deleteStaticMrouteRes, err := client.ApiCallSimple("delete-static-mroute", payload)
+    deleteStaticMrouteRes, err := apiCallSimple(client, "delete-static-mroute", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteStaticMrouteRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaStaticRoute() *schema.Resource {
         Read:   readGaiaStaticRoute,
         Update: updateGaiaStaticRoute,
         Delete: deleteGaiaStaticRoute,
+        Importer: importGaiaResource("static-route-", "address", "mask_length"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -151,7 +152,7 @@ func createGaiaStaticRoute(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create StaticRoute - Map = ", payload)
 
-    addStaticRouteRes, err := client.ApiCallSimple("set-static-route", payload)
+    addStaticRouteRes, err := apiCallSimple(client, "set-static-route", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addStaticRouteRes.Success
@@ -214,7 +215,7 @@ func readGaiaStaticRoute(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showStaticRouteRes, err := client.ApiCallSimple("show-static-route", payload)
+    showStaticRouteRes, err := apiCallSimple(client, "show-static-route", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showStaticRouteRes.Success
@@ -369,7 +370,7 @@ func updateGaiaStaticRoute(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    setStaticRouteRes, err := client.ApiCallSimple("set-static-route", payload)
+    setStaticRouteRes, err := apiCallSimple(client, "set-static-route", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setStaticRouteRes.Success
@@ -424,7 +425,7 @@ func deleteGaiaStaticRoute(d *schema.ResourceData, m interface{}) error {
         payload["virtual-system-id"] = v.(int)
     }
 
-    deleteStaticRouteRes, err := client.ApiCallSimple("delete-static-route", payload)
+    deleteStaticRouteRes, err := apiCallSimple(client, "delete-static-route", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteStaticRouteRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaSyslog() *schema.Resource {
         Read:   readGaiaSyslog,
         Update: updateGaiaSyslog,
         Delete: deleteGaiaSyslog,
+        Importer: importGaiaResource("syslog-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -158,7 +159,7 @@ func createGaiaSyslog(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Syslog - Map = ", payload)
 
-    addSyslogRes, err := client.ApiCallSimple("set-syslog", payload)
+    addSyslogRes, err := apiCallSimple(client, "set-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSyslogRes.Success
@@ -209,7 +210,7 @@ func readGaiaSyslog(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSyslogRes, err := client.ApiCallSimple("show-syslog", payload)
+    showSyslogRes, err := apiCallSimple(client, "show-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSyslogRes.Success
@@ -388,7 +389,7 @@ func updateGaiaSyslog(d *schema.ResourceData, m interface{}) error {
     if v, ok := d.GetOkExists("send_to_mgmt"); ok {
         clearPayload["send-to-mgmt"] = v.(bool)
     }
-    clearRes, err := client.ApiCallSimple("set-syslog", clearPayload)
+    clearRes, err := apiCallSimple(client, "set-syslog", clearPayload)
     if err != nil {
         return fmt.Errorf("Failed to clear forwarded-logs-files: %v", err)
     }
@@ -396,7 +397,7 @@ func updateGaiaSyslog(d *schema.ResourceData, m interface{}) error {
         return fmt.Errorf(clearRes.ErrorMsg)
     }
 
-    setSyslogRes, err := client.ApiCallSimple("set-syslog", payload)
+    setSyslogRes, err := apiCallSimple(client, "set-syslog", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSyslogRes.Success
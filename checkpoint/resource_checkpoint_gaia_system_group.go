@@ -15,6 +15,7 @@ func resourceGaiaSystemGroup() *schema.Resource {
         Read:   readGaiaSystemGroup,
         Update: updateGaiaSystemGroup,
         Delete: deleteGaiaSystemGroup,
+        Importer: importGaiaResource("system-group-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -68,7 +69,7 @@ func createGaiaSystemGroup(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create SystemGroup - Map = ", payload)
 
-    addSystemGroupRes, err := client.ApiCallSimple("add-system-group", payload)
+    addSystemGroupRes, err := apiCallSimple(client, "add-system-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addSystemGroupRes.Success
@@ -123,7 +124,7 @@ func readGaiaSystemGroup(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showSystemGroupRes, err := client.ApiCallSimple("show-system-group", payload)
+    showSystemGroupRes, err := apiCallSimple(client, "show-system-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showSystemGroupRes.Success
@@ -210,7 +211,7 @@ func updateGaiaSystemGroup(d *schema.ResourceData, m interface{}) error {
         payload["users"] = v.(*schema.Set).List()
     }
 
-    setSystemGroupRes, err := client.ApiCallSimple("set-system-group", payload)
+    setSystemGroupRes, err := apiCallSimple(client, "set-system-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setSystemGroupRes.Success
@@ -257,7 +258,7 @@ func deleteGaiaSystemGroup(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteSystemGroupRes, err := client.ApiCallSimple("delete-system-group", payload)
+    deleteSystemGroupRes, err := apiCallSimple(client, "delete-system-group", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteSystemGroupRes.Success
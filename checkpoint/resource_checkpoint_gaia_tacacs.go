@@ -15,6 +15,7 @@ func resourceGaiaTacacs() *schema.Resource {
         Read:   readGaiaTacacs,
         Update: updateGaiaTacacs,
         Delete: deleteGaiaTacacs,
+        Importer: importGaiaResource("tacacs-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -112,7 +113,7 @@ func createGaiaTacacs(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create Tacacs - Map = ", payload)
 
-    addTacacsRes, err := client.ApiCallSimple("set-tacacs", payload)
+    addTacacsRes, err := apiCallSimple(client, "set-tacacs", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addTacacsRes.Success
@@ -163,7 +164,7 @@ func readGaiaTacacs(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showTacacsRes, err := client.ApiCallSimple("show-tacacs", payload)
+    showTacacsRes, err := apiCallSimple(client, "show-tacacs", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showTacacsRes.Success
@@ -283,7 +284,7 @@ func updateGaiaTacacs(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setTacacsRes, err := client.ApiCallSimple("set-tacacs", payload)
+    setTacacsRes, err := apiCallSimple(client, "set-tacacs", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setTacacsRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaTimeAndDate() *schema.Resource {
         Read:   readGaiaTimeAndDate,
         Update: updateGaiaTimeAndDate,
         Delete: deleteGaiaTimeAndDate,
+        Importer: importGaiaResource("time-and-date-"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -81,7 +82,7 @@ func createGaiaTimeAndDate(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create TimeAndDate - Map = ", payload)
 
-    addTimeAndDateRes, err := client.ApiCallSimple("set-time-and-date", payload)
+    addTimeAndDateRes, err := apiCallSimple(client, "set-time-and-date", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addTimeAndDateRes.Success
@@ -144,7 +145,7 @@ func readGaiaTimeAndDate(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showTimeAndDateRes, err := client.ApiCallSimple("show-time-and-date", payload)
+    showTimeAndDateRes, err := apiCallSimple(client, "show-time-and-date", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showTimeAndDateRes.Success
@@ -231,7 +232,7 @@ func updateGaiaTimeAndDate(d *schema.ResourceData, m interface{}) error {
         payload["date"] = v.(string)
     }
 
-    setTimeAndDateRes, err := client.ApiCallSimple("set-time-and-date", payload)
+    setTimeAndDateRes, err := apiCallSimple(client, "set-time-and-date", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setTimeAndDateRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaUser() *schema.Resource {
         Read:   readGaiaUser,
         Update: updateGaiaUser,
         Delete: deleteGaiaUser,
+        Importer: importGaiaResource("user-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -180,7 +181,7 @@ func createGaiaUser(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create User - Map = ", payload)
 
-    addUserRes, err := client.ApiCallSimple("add-user", payload)
+    addUserRes, err := apiCallSimple(client, "add-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addUserRes.Success
@@ -235,7 +236,7 @@ func readGaiaUser(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showUserRes, err := client.ApiCallSimple("show-user", payload)
+    showUserRes, err := apiCallSimple(client, "show-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showUserRes.Success
@@ -405,7 +406,7 @@ func updateGaiaUser(d *schema.ResourceData, m interface{}) error {
         payload["unlock"] = v.(bool)
     }
 
-    setUserRes, err := client.ApiCallSimple("set-user", payload)
+    setUserRes, err := apiCallSimple(client, "set-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setUserRes.Success
@@ -452,7 +453,7 @@ func deleteGaiaUser(d *schema.ResourceData, m interface{}) error {
         payload["name"] = v.(string)
     }
 
-    deleteUserRes, err := client.ApiCallSimple("delete-user", payload)
+    deleteUserRes, err := apiCallSimple(client, "delete-user", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteUserRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaVirtualGateway() *schema.Resource {
         Read:   readGaiaVirtualGateway,
         Update: updateGaiaVirtualGateway,
         Delete: deleteGaiaVirtualGateway,
+        Importer: importGaiaResource("virtual-gateway-", "resource_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -269,7 +270,7 @@ func createGaiaVirtualGateway(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create VirtualGateway - Map = ", payload)
 
-    addVirtualGatewayRes, err := client.ApiCallSimple("add-virtual-gateway", payload)
+    addVirtualGatewayRes, err := apiCallSimple(client, "add-virtual-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addVirtualGatewayRes.Success
@@ -336,7 +337,7 @@ func readGaiaVirtualGateway(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showVirtualGatewayRes, err := client.ApiCallSimple("show-virtual-gateway", payload)
+    showVirtualGatewayRes, err := apiCallSimple(client, "show-virtual-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showVirtualGatewayRes.Success
@@ -466,7 +467,7 @@ func updateGaiaVirtualGateway(d *schema.ResourceData, m interface{}) error {
         }
     }
 
-    setVirtualGatewayRes, err := client.ApiCallSimple("set-virtual-gateway", payload)
+    setVirtualGatewayRes, err := apiCallSimple(client, "set-virtual-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setVirtualGatewayRes.Success
@@ -525,7 +526,7 @@ func deleteGaiaVirtualGateway(d *schema.ResourceData, m interface{}) error {
         payload["id"] = v.(int)
     }
 
-    deleteVirtualGatewayRes, err := client.ApiCallSimple("delete-virtual-gateway", payload)
+    deleteVirtualGatewayRes, err := apiCallSimple(client, "delete-virtual-gateway", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteVirtualGatewayRes.Success
@@ -16,6 +16,7 @@ func resourceGaiaVirtualSwitch() *schema.Resource {
         Read:   readGaiaVirtualSwitch,
         Update: updateGaiaVirtualSwitch,
         Delete: deleteGaiaVirtualSwitch,
+        Importer: importGaiaResource("virtual-switch-", "resource_id"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -101,7 +102,7 @@ func createGaiaVirtualSwitch(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create VirtualSwitch - Map = ", payload)
 
-    addVirtualSwitchRes, err := client.ApiCallSimple("add-virtual-switch", payload)
+    addVirtualSwitchRes, err := apiCallSimple(client, "add-virtual-switch", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addVirtualSwitchRes.Success
@@ -168,7 +169,7 @@ func readGaiaVirtualSwitch(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showVirtualSwitchRes, err := client.ApiCallSimple("show-virtual-switch", payload)
+    showVirtualSwitchRes, err := apiCallSimple(client, "show-virtual-switch", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showVirtualSwitchRes.Success
@@ -250,7 +251,7 @@ func updateGaiaVirtualSwitch(d *schema.ResourceData, m interface{}) error {
         payload["interface"] = v.(string)
     }
 
-    setVirtualSwitchRes, err := client.ApiCallSimple("set-virtual-switch", payload)
+    setVirtualSwitchRes, err := apiCallSimple(client, "set-virtual-switch", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setVirtualSwitchRes.Success
@@ -309,7 +310,7 @@ func deleteGaiaVirtualSwitch(d *schema.ResourceData, m interface{}) error {
         payload["id"] = v.(string)
     }
 
-    deleteVirtualSwitchRes, err := client.ApiCallSimple("delete-virtual-switch", payload)
+    deleteVirtualSwitchRes, err := apiCallSimple(client, "delete-virtual-switch", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteVirtualSwitchRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaVlanInterface() *schema.Resource {
         Read:   readGaiaVlanInterface,
         Update: updateGaiaVlanInterface,
         Delete: deleteGaiaVlanInterface,
+        Importer: importGaiaResource("vlan-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -446,7 +447,7 @@ func createGaiaVlanInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create VlanInterface - Map = ", payload)
 
-    addVlanInterfaceRes, err := client.ApiCallSimple("add-vlan-interface", payload)
+    addVlanInterfaceRes, err := apiCallSimple(client, "add-vlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addVlanInterfaceRes.Success
@@ -495,7 +496,7 @@ func createGaiaVlanInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setVlanInterfaceRes, err := client.ApiCallSimple("set-vlan-interface", updatePayload)
+        setVlanInterfaceRes, err := apiCallSimple(client, "set-vlan-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for vlan-interface: %v", err)
         }
@@ -531,7 +532,7 @@ func readGaiaVlanInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showVlanInterfaceRes, err := client.ApiCallSimple("show-vlan-interface", payload)
+    showVlanInterfaceRes, err := apiCallSimple(client, "show-vlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showVlanInterfaceRes.Success
@@ -839,7 +840,7 @@ func updateGaiaVlanInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    setVlanInterfaceRes, err := client.ApiCallSimple("set-vlan-interface", payload)
+    setVlanInterfaceRes, err := apiCallSimple(client, "set-vlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setVlanInterfaceRes.Success
@@ -887,7 +888,7 @@ func deleteGaiaVlanInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    deleteVlanInterfaceRes, err := client.ApiCallSimple("delete-vlan-interface", payload)
+    deleteVlanInterfaceRes, err := apiCallSimple(client, "delete-vlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteVlanInterfaceRes.Success
@@ -15,6 +15,7 @@ func resourceGaiaVxlanInterface() *schema.Resource {
         Read:   readGaiaVxlanInterface,
         Update: updateGaiaVxlanInterface,
         Delete: deleteGaiaVxlanInterface,
+        Importer: importGaiaResource("vxlan-interface-", "name"),
         Schema: map[string]*schema.Schema{
             "debug": {
                 Type:        schema.TypeBool,
@@ -202,7 +203,7 @@ func createGaiaVxlanInterface(d *schema.ResourceData, m interface{}) error {
 
     log.Println("Create VxlanInterface - Map = ", payload)
 
-    addVxlanInterfaceRes, err := client.ApiCallSimple("add-vxlan-interface", payload)
+    addVxlanInterfaceRes, err := apiCallSimple(client, "add-vxlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && addVxlanInterfaceRes.Success
@@ -256,7 +257,7 @@ func createGaiaVxlanInterface(d *schema.ResourceData, m interface{}) error {
     if hasUpdateOnlyFields {
         log.Println("Two-phase creation: Applying update-only fields - Map = ", updatePayload)
         
-        setVxlanInterfaceRes, err := client.ApiCallSimple("set-vxlan-interface", updatePayload)
+        setVxlanInterfaceRes, err := apiCallSimple(client, "set-vxlan-interface", updatePayload)
         if err != nil {
             return fmt.Errorf("Failed to apply update-only fields for vxlan-interface: %v", err)
         }
@@ -291,7 +292,7 @@ func readGaiaVxlanInterface(d *schema.ResourceData, m interface{}) error {
         payload["member-id"] = v.(string)
     }
 
-    showVxlanInterfaceRes, err := client.ApiCallSimple("show-vxlan-interface", payload)
+    showVxlanInterfaceRes, err := apiCallSimple(client, "show-vxlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && showVxlanInterfaceRes.Success
@@ -512,7 +513,7 @@ func updateGaiaVxlanInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    setVxlanInterfaceRes, err := client.ApiCallSimple("set-vxlan-interface", payload)
+    setVxlanInterfaceRes, err := apiCallSimple(client, "set-vxlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && setVxlanInterfaceRes.Success
@@ -560,7 +561,7 @@ func deleteGaiaVxlanInterface(d *schema.ResourceData, m interface{}) error {
     }
 
    payload["name"] = d.Get("name")
-    deleteVxlanInterfaceRes, err := client.ApiCallSimple("delete-vxlan-interface", payload)
+    deleteVxlanInterfaceRes, err := apiCallSimple(client, "delete-vxlan-interface", payload)
     // DEBUG: generic logger
     if resourceDebugEnabled(d) {
         success := err == nil && deleteVxlanInterfaceRes.Success
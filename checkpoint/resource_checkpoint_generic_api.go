@@ -61,7 +61,7 @@ func createManagementGenericApi(d *schema.ResourceData, m interface{}) error {
 		method = v.(string)
 	}
 
-	genericApiRes, err := client.ApiCall(apiCommand, payload, client.GetSessionID(), true, client.IsProxyUsed(), method)
+	genericApiRes, err := apiCall(client, apiCommand, payload, client.GetSessionID(), true, client.IsProxyUsed(), method)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
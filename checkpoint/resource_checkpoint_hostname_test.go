@@ -94,3 +94,12 @@ resource "checkpoint_hostname" "test" {
 }
 `, name)
 }
+
+func TestCheckpointHostname_offline(t *testing.T) {
+	_, provider := testFakeProvider(t, "gaia_api", nil)
+
+	state := testFakeApply(t, provider, "checkpoint_hostname", nil, map[string]interface{}{"name": "gw1"})
+	if state.ID != "gw1" || state.Attributes["name"] != "gw1" {
+		t.Fatalf("unexpected state after create: %v", state)
+	}
+}
@@ -179,7 +179,7 @@ func createManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create AccessLayer - Map = ", accessLayer)
 
-	addAccessLayerRes, err := client.ApiCall("add-access-layer", accessLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	addAccessLayerRes, err := apiCall(client, "add-access-layer", accessLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessLayerRes.Success {
 		if addAccessLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addAccessLayerRes.ErrorMsg)
@@ -200,7 +200,7 @@ func readManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showAccessLayerRes, err := client.ApiCall("show-access-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAccessLayerRes, err := apiCall(client, "show-access-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -374,7 +374,7 @@ func updateManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update AccessLayer - Map = ", accessLayer)
 
-	updateAccessLayerRes, err := client.ApiCall("set-access-layer", accessLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAccessLayerRes, err := apiCall(client, "set-access-layer", accessLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessLayerRes.Success {
 		if updateAccessLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateAccessLayerRes.ErrorMsg)
@@ -403,7 +403,7 @@ func deleteManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete AccessLayer")
 
-	deleteAccessLayerRes, err := client.ApiCall("delete-access-layer", accessLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAccessLayerRes, err := apiCall(client, "delete-access-layer", accessLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAccessLayerRes.Success {
 		if deleteAccessLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAccessLayerRes.ErrorMsg)
@@ -233,7 +233,7 @@ func createManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create AccessRole - Map = ", accessRole)
 
-	addAccessRoleRes, err := client.ApiCall("add-access-role", accessRole, client.GetSessionID(), true, client.IsProxyUsed())
+	addAccessRoleRes, err := apiCall(client, "add-access-role", accessRole, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessRoleRes.Success {
 		if addAccessRoleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addAccessRoleRes.ErrorMsg)
@@ -253,7 +253,7 @@ func readManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showAccessRoleRes, err := client.ApiCall("show-access-role", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAccessRoleRes, err := apiCall(client, "show-access-role", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -549,7 +549,7 @@ func updateManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update AccessRole - Map = ", accessRole)
 
-	updateAccessRoleRes, err := client.ApiCall("set-access-role", accessRole, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAccessRoleRes, err := apiCall(client, "set-access-role", accessRole, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessRoleRes.Success {
 		if updateAccessRoleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateAccessRoleRes.ErrorMsg)
@@ -578,7 +578,7 @@ func deleteManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete AccessRole")
 
-	deleteAccessRoleRes, err := client.ApiCall("delete-access-role", accessRolePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAccessRoleRes, err := apiCall(client, "delete-access-role", accessRolePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAccessRoleRes.Success {
 		if deleteAccessRoleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAccessRoleRes.ErrorMsg)
@@ -78,10 +78,11 @@ func resourceManagementAccessRule() *schema.Resource {
 				Description: "Rule name.",
 			},
 			"action": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "\"Accept\", \"Drop\", \"Ask\", \"Inform\", \"Reject\", \"User Auth\", \"Client Auth\", \"Apply Layer\".",
-				Default:     "Drop",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "\"Accept\", \"Drop\", \"Ask\", \"Inform\", \"Reject\", \"User Auth\", \"Client Auth\", \"Apply Layer\".",
+				Default:      "Drop",
+				ValidateFunc: validateStringValueIgnoreCase("Accept", "Drop", "Ask", "Inform", "Reject", "User Auth", "Client Auth", "Apply Layer"),
 			},
 			"action_settings": {
 				Type:        schema.TypeList,
@@ -112,10 +113,11 @@ func resourceManagementAccessRule() *schema.Resource {
 				},
 			},
 			"content_direction": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "On which direction the file types processing is applied.",
-				Default:     "any",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "On which direction the file types processing is applied.",
+				Default:      "any",
+				ValidateFunc: validateStringValue("any", "up", "down"),
 			},
 			"content_negate": {
 				Type:        schema.TypeBool,
@@ -231,9 +233,10 @@ func resourceManagementAccessRule() *schema.Resource {
 							Description: "Turns accounting for track on and off.",
 						},
 						"alert": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Type of alert for the track.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Type of alert for the track.",
+							ValidateFunc: validateStringValueIgnoreCase("none", "alert", "mail", "snmp", "user alert 1", "user alert 2", "user alert 3"),
 						},
 						"enable_firewall_session": {
 							Type:        schema.TypeBool,
@@ -251,9 +254,10 @@ func resourceManagementAccessRule() *schema.Resource {
 							Description: "Determines whether to perform the log per session.",
 						},
 						"type": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "\"Log\", \"Extended Log\", \"Detailed Log\", \"None\".",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "\"Log\", \"Extended Log\", \"Detailed Log\", \"None\".",
+							ValidateFunc: validateStringValueIgnoreCase("Log", "Extended Log", "Detailed Log", "None"),
 						},
 					},
 				},
@@ -568,7 +572,7 @@ func createManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Access Rule - Map = ", accessRule)
 
-	addAccessRuleRes, err := client.ApiCall("add-access-rule", accessRule, client.GetSessionID(), true, client.IsProxyUsed())
+	addAccessRuleRes, err := apiCall(client, "add-access-rule", accessRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessRuleRes.Success {
 		if addAccessRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addAccessRuleRes.ErrorMsg)
@@ -590,7 +594,7 @@ func readManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	showAccessRuleRes, err := client.ApiCall("show-access-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAccessRuleRes, err := apiCall(client, "show-access-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1137,7 +1141,7 @@ func updateManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 	log.Println("Update Access Rule - Map = ", accessRule)
 
 	if len(accessRule) != 4 {
-		updateAccessRuleRes, err := client.ApiCall("set-access-rule", accessRule, client.GetSessionID(), true, client.IsProxyUsed())
+		updateAccessRuleRes, err := apiCall(client, "set-access-rule", accessRule, client.GetSessionID(), true, client.IsProxyUsed())
 		if err != nil || !updateAccessRuleRes.Success {
 			if updateAccessRuleRes.ErrorMsg != "" {
 				return fmt.Errorf("%s", updateAccessRuleRes.ErrorMsg)
@@ -1162,7 +1166,7 @@ func deleteManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	deleteAccessRuleRes, err := client.ApiCall("delete-access-rule", accessRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAccessRuleRes, err := apiCall(client, "delete-access-rule", accessRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAccessRuleRes.Success {
 		if deleteAccessRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAccessRuleRes.ErrorMsg)
@@ -0,0 +1,663 @@
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accessRulebaseSpec = rulebaseSpec{command: "show-access-rulebase", containerKey: "layer"}
+
+// Rule fields of checkpoint_management_access_rulebase which reference objects, with the value the API uses when the field is empty.
+var accessRulebaseObjectFields = map[string]string{
+	"source":      "Any",
+	"destination": "Any",
+	"service":     "Any",
+	"install_on":  "Policy Targets",
+}
+
+func resourceManagementAccessRulebase() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementAccessRulebase,
+		Read:   readManagementAccessRulebase,
+		Update: updateManagementAccessRulebase,
+		Delete: deleteManagementAccessRulebase,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("layer", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"layer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Layer identified by the name or UID.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules above the first section, in rulebase order.",
+				Elem:        accessRulebaseRuleSchema(),
+			},
+			"section": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Sections of the layer, in rulebase order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Section name.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Section UID.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules of the section, in rulebase order.",
+							Elem:        accessRulebaseRuleSchema(),
+						},
+					},
+				},
+			},
+			"owned_uids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "UIDs of the rules and sections the resource added. Only these are deleted with the resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func accessRulebaseRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Rule name. Rules are matched to the rulebase by name, so names must be unique in the layer.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rule UID.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Drop",
+				Description:  "Action-Accept, Drop, Ask, Inform, Reject, User Auth, Client Auth.",
+				ValidateFunc: validateStringValueIgnoreCase("Accept", "Drop", "Ask", "Inform", "Reject", "User Auth", "Client Auth"),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable/Disable the rule.",
+			},
+			"source": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Collection of Network objects identified by the name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"source_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "True if negate is set for source.",
+			},
+			"destination": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Collection of Network objects identified by the name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"destination_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "True if negate is set for destination.",
+			},
+			"service": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Collection of Network objects identified by the name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "True if negate is set for service.",
+			},
+			"install_on": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Which Gateways identified by the name to install the policy on.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"track": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "None",
+				Description:  "Track type - Log, Extended Log, Detailed Log, None.",
+				ValidateFunc: validateStringValueIgnoreCase("Log", "Extended Log", "Detailed Log", "None"),
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments string.",
+			},
+		},
+	}
+}
+
+func createManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+	if err := applyManagementAccessRulebase(d, m); err != nil {
+		return err
+	}
+	d.SetId(d.Get("layer").(string))
+	return readManagementAccessRulebase(d, m)
+}
+
+func updateManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+	if err := applyManagementAccessRulebase(d, m); err != nil {
+		return err
+	}
+	return readManagementAccessRulebase(d, m)
+}
+
+func readManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	entries, err := showRulebase(client, accessRulebaseSpec, d.Get("layer").(string), true)
+	if err != nil {
+		if errors.Is(err, errRulebaseNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	owned := d.Get("owned_uids").(*schema.Set)
+	existing := make([]interface{}, 0)
+	rules := make([]interface{}, 0)
+	sections := make([]interface{}, 0)
+	var section map[string]interface{}
+	for _, entry := range entries {
+		if owned.Contains(entry.uid) {
+			existing = append(existing, entry.uid)
+		}
+		if entry.section {
+			section = map[string]interface{}{
+				"name": entry.name,
+				"uid":  entry.uid,
+				"rule": make([]interface{}, 0),
+			}
+			sections = append(sections, section)
+			continue
+		}
+		rule := flattenAccessRulebaseRule(entry.object)
+		if section == nil {
+			rules = append(rules, rule)
+		} else {
+			section["rule"] = append(section["rule"].([]interface{}), rule)
+		}
+	}
+
+	_ = d.Set("rule", rules)
+	_ = d.Set("section", sections)
+	_ = d.Set("owned_uids", existing)
+	return nil
+}
+
+func deleteManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	layer := d.Get("layer").(string)
+	owned := d.Get("owned_uids").(*schema.Set)
+
+	// rules and sections which existed before the resource, e.g. the cleanup rule, are kept
+	ops := make([]rulebaseOp, 0)
+	deleteRules := func(rules []interface{}) {
+		for _, rule := range rules {
+			if uid := rule.(map[string]interface{})["uid"].(string); owned.Contains(uid) {
+				ops = append(ops, rulebaseOp{action: "delete", uid: uid})
+			}
+		}
+	}
+	deleteRules(d.Get("rule").([]interface{}))
+	for _, section := range d.Get("section").([]interface{}) {
+		deleteRules(section.(map[string]interface{})["rule"].([]interface{}))
+	}
+	for _, section := range d.Get("section").([]interface{}) {
+		if uid := section.(map[string]interface{})["uid"].(string); owned.Contains(uid) {
+			ops = append(ops, rulebaseOp{action: "delete", section: true, uid: uid})
+		}
+	}
+
+	if _, err := runAccessRulebaseOps(client, layer, ops, nil, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// applyManagementAccessRulebase brings the layer rulebase to the configured rules and sections.
+func applyManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	layer := d.Get("layer").(string)
+
+	desired, err := expandAccessRulebase(d)
+	if err != nil {
+		return err
+	}
+	actual, err := showRulebase(client, accessRulebaseSpec, layer, true)
+	if err != nil {
+		return err
+	}
+
+	ops, ruleUids, sectionUids := planAccessRulebase(actual, desired)
+	log.Printf("Access rulebase %s - %d changes", layer, len(ops))
+	added, err := runAccessRulebaseOps(client, layer, ops, ruleUids, sectionUids)
+	owned := d.Get("owned_uids").(*schema.Set)
+	for _, uid := range added {
+		owned.Add(uid)
+	}
+	_ = d.Set("owned_uids", owned)
+	return err
+}
+
+// rulebaseItem is a configured rule or section, in rulebase order.
+type rulebaseItem struct {
+	section bool
+	name    string
+	rule    map[string]interface{}
+}
+
+func expandAccessRulebase(d *schema.ResourceData) ([]rulebaseItem, error) {
+	items := make([]rulebaseItem, 0)
+	ruleNames := make(map[string]bool)
+	sectionNames := make(map[string]bool)
+
+	addRules := func(rules []interface{}) error {
+		for _, rule := range rules {
+			ruleMap := rule.(map[string]interface{})
+			name := ruleMap["name"].(string)
+			if ruleNames[name] {
+				return fmt.Errorf("rule name %q is used more than once, rule names must be unique", name)
+			}
+			ruleNames[name] = true
+			items = append(items, rulebaseItem{name: name, rule: ruleMap})
+		}
+		return nil
+	}
+
+	if err := addRules(d.Get("rule").([]interface{})); err != nil {
+		return nil, err
+	}
+	for _, section := range d.Get("section").([]interface{}) {
+		sectionMap := section.(map[string]interface{})
+		name := sectionMap["name"].(string)
+		if sectionNames[name] {
+			return nil, fmt.Errorf("section name %q is used more than once, section names must be unique", name)
+		}
+		sectionNames[name] = true
+		items = append(items, rulebaseItem{section: true, name: name})
+		if err := addRules(sectionMap["rule"].([]interface{})); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// rulebaseOp is a single API call of a rulebase change. Rules and sections which don't exist yet
+// are referenced by name and resolved to the UID returned when they are added.
+type rulebaseOp struct {
+	action  string // add, set, move, delete
+	section bool
+	uid     string
+	name    string
+	after   *rulebaseItem // entry to position after, nil for top of the rulebase
+	fields  map[string]interface{}
+}
+
+// planAccessRulebase computes the calls which turn the actual rulebase into the desired one:
+// entries which are not configured are deleted, entries out of order are moved (sections, which
+// can't be moved, are deleted and added again), missing entries are added in place and rules whose
+// fields differ are updated. Only entries off the longest in-order sequence are moved.
+func planAccessRulebase(actual []rulebaseEntry, desired []rulebaseItem) ([]rulebaseOp, map[string]string, map[string]string) {
+	ruleUids := make(map[string]string)
+	sectionUids := make(map[string]string)
+	actualIdx := make(map[string]int)
+	matchedActual := make(map[int]bool)
+	ops := make([]rulebaseOp, 0)
+
+	key := func(section bool, name string) string {
+		if section {
+			return "section;" + name
+		}
+		return "rule;" + name
+	}
+
+	// match configured entries to the rulebase by name
+	for _, item := range desired {
+		for i, entry := range actual {
+			if !matchedActual[i] && entry.section == item.section && entry.name == item.name {
+				matchedActual[i] = true
+				actualIdx[key(item.section, item.name)] = i
+				if item.section {
+					sectionUids[item.name] = entry.uid
+				} else {
+					ruleUids[item.name] = entry.uid
+				}
+				break
+			}
+		}
+	}
+
+	for i, entry := range actual {
+		if !matchedActual[i] && !entry.section {
+			ops = append(ops, rulebaseOp{action: "delete", uid: entry.uid, name: entry.name})
+		}
+	}
+	for i, entry := range actual {
+		if !matchedActual[i] && entry.section {
+			ops = append(ops, rulebaseOp{action: "delete", section: true, uid: entry.uid, name: entry.name})
+		}
+	}
+
+	// sections out of order are recreated
+	sectionSeq := make([]int, 0)
+	for _, item := range desired {
+		if idx, ok := actualIdx[key(true, item.name)]; ok && item.section {
+			sectionSeq = append(sectionSeq, idx)
+		}
+	}
+	inOrder := longestIncreasingSubsequence(sectionSeq, nil)
+	for _, item := range desired {
+		if !item.section {
+			continue
+		}
+		if idx, ok := actualIdx[key(true, item.name)]; ok && !inOrder[idx] {
+			ops = append(ops, rulebaseOp{action: "delete", section: true, uid: sectionUids[item.name], name: item.name})
+			delete(actualIdx, key(true, item.name))
+			delete(sectionUids, item.name)
+		}
+	}
+
+	// move matched rules which are out of order, sections are weighted so they all stay in place
+	seq := make([]int, 0)
+	weights := make([]int, 0)
+	for _, item := range desired {
+		if idx, ok := actualIdx[key(item.section, item.name)]; ok {
+			seq = append(seq, idx)
+			weights = append(weights, 1)
+			if item.section {
+				weights[len(weights)-1] = len(desired) + 1
+			}
+		}
+	}
+	inOrder = longestIncreasingSubsequence(seq, weights)
+	var prevMatched *rulebaseItem
+	for i := range desired {
+		item := desired[i]
+		idx, ok := actualIdx[key(item.section, item.name)]
+		if !ok {
+			continue
+		}
+		if !inOrder[idx] {
+			ops = append(ops, rulebaseOp{action: "move", uid: ruleUids[item.name], name: item.name, after: prevMatched})
+		}
+		prevMatched = &desired[i]
+	}
+
+	// add missing entries after their predecessor
+	var prev *rulebaseItem
+	for i := range desired {
+		item := desired[i]
+		if _, ok := actualIdx[key(item.section, item.name)]; !ok {
+			op := rulebaseOp{action: "add", section: item.section, name: item.name, after: prev}
+			if !item.section {
+				op.fields = accessRulebaseRuleFields(item.rule, nil)
+			}
+			ops = append(ops, op)
+		}
+		prev = &desired[i]
+	}
+
+	// update rules whose fields changed
+	for _, item := range desired {
+		if item.section {
+			continue
+		}
+		if idx, ok := actualIdx[key(false, item.name)]; ok {
+			if fields := accessRulebaseRuleFields(item.rule, actual[idx].object); len(fields) > 0 {
+				ops = append(ops, rulebaseOp{action: "set", uid: ruleUids[item.name], name: item.name, fields: fields})
+			}
+		}
+	}
+
+	return ops, ruleUids, sectionUids
+}
+
+// longestIncreasingSubsequence returns the values of seq which are part of its heaviest increasing
+// subsequence. Without weights each value weighs 1.
+func longestIncreasingSubsequence(seq []int, weights []int) map[int]bool {
+	weight := func(i int) int {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+	lengths := make([]int, len(seq))
+	prevs := make([]int, len(seq))
+	best := -1
+	for i := range seq {
+		lengths[i] = weight(i)
+		prevs[i] = -1
+		for j := 0; j < i; j++ {
+			if seq[j] < seq[i] && lengths[j]+weight(i) > lengths[i] {
+				lengths[i] = lengths[j] + weight(i)
+				prevs[i] = j
+			}
+		}
+		if best < 0 || lengths[i] > lengths[best] {
+			best = i
+		}
+	}
+	res := make(map[int]bool)
+	for i := best; i >= 0; i = prevs[i] {
+		res[seq[i]] = true
+	}
+	return res
+}
+
+// accessRulebaseRuleFields returns the API fields of a configured rule. When the current rule is
+// given only the fields which differ from it are returned.
+func accessRulebaseRuleFields(rule map[string]interface{}, current map[string]interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+
+	if v := rule["action"].(string); current == nil || !strings.EqualFold(v, objectName(current["action"])) {
+		fields["action"] = v
+	}
+	if v := rule["enabled"].(bool); current == nil || current["enabled"] != v {
+		fields["enabled"] = v
+	}
+	if v := rule["comments"].(string); (current == nil && v != "") || (current != nil && getString(current, "comments") != v) {
+		fields["comments"] = v
+	}
+	for _, field := range []string{"source", "destination", "service"} {
+		if v := rule[field+"_negate"].(bool); current == nil || current[field+"-negate"] != v {
+			fields[field+"-negate"] = v
+		}
+	}
+	for field, emptyValue := range accessRulebaseObjectFields {
+		var v []interface{}
+		if set, ok := rule[field].(*schema.Set); ok {
+			v = set.List()
+		}
+		apiField := strings.Replace(field, "_", "-", -1)
+		if current == nil {
+			if len(v) > 0 {
+				fields[apiField] = v
+			}
+			continue
+		}
+		if len(v) == 0 {
+			v = []interface{}{emptyValue}
+		}
+		if !sameObjects(v, current[apiField]) {
+			fields[apiField] = v
+		}
+	}
+	if v := rule["track"].(string); current == nil || !strings.EqualFold(v, accessRuleTrackType(current)) {
+		fields["track"] = map[string]interface{}{"type": v}
+	}
+
+	return fields
+}
+
+func flattenAccessRulebaseRule(rule map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"name":     getString(rule, "name"),
+		"uid":      getString(rule, "uid"),
+		"action":   objectName(rule["action"]),
+		"comments": getString(rule, "comments"),
+		"track":    accessRuleTrackType(rule),
+	}
+	if v, ok := rule["enabled"].(bool); ok {
+		res["enabled"] = v
+	}
+	for _, field := range []string{"source", "destination", "service"} {
+		if v, ok := rule[field+"-negate"].(bool); ok {
+			res[field+"_negate"] = v
+		}
+	}
+	for field := range accessRulebaseObjectFields {
+		names := make([]interface{}, 0)
+		objects, _ := rule[strings.Replace(field, "_", "-", -1)].([]interface{})
+		for _, object := range objects {
+			names = append(names, objectName(object))
+		}
+		res[field] = names
+	}
+	return res
+}
+
+// objectName returns the name of an object returned by the API, or the value itself when it isn't an object.
+func objectName(v interface{}) string {
+	if object, ok := v.(map[string]interface{}); ok {
+		return getString(object, "name")
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func accessRuleTrackType(rule map[string]interface{}) string {
+	if track, ok := rule["track"].(map[string]interface{}); ok {
+		return objectName(track["type"])
+	}
+	return ""
+}
+
+// sameObjects reports whether the configured identifiers match the objects returned by the API by name or UID.
+func sameObjects(identifiers []interface{}, objects interface{}) bool {
+	objectList, _ := objects.([]interface{})
+	if len(identifiers) != len(objectList) {
+		return false
+	}
+	for _, identifier := range identifiers {
+		found := false
+		for _, object := range objectList {
+			objectMap, _ := object.(map[string]interface{})
+			if objectMap != nil && (objectMap["name"] == identifier || objectMap["uid"] == identifier) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// runAccessRulebaseOps sends the rulebase changes and returns the UIDs of the added rules and sections.
+func runAccessRulebaseOps(client *checkpoint.ApiClient, layer string, ops []rulebaseOp, ruleUids map[string]string, sectionUids map[string]string) ([]string, error) {
+	added := make([]string, 0)
+	position := func(op rulebaseOp) interface{} {
+		if op.after == nil {
+			return "top"
+		}
+		if op.after.section {
+			if op.section {
+				return map[string]interface{}{"below": sectionUids[op.after.name]}
+			}
+			return map[string]interface{}{"top": sectionUids[op.after.name]}
+		}
+		return map[string]interface{}{"below": ruleUids[op.after.name]}
+	}
+
+	for _, op := range ops {
+		payload := map[string]interface{}{"layer": layer}
+		for k, v := range op.fields {
+			payload[k] = v
+		}
+
+		command := op.action + "-access-rule"
+		if op.section {
+			command = op.action + "-access-section"
+		}
+		switch op.action {
+		case "add":
+			payload["name"] = op.name
+			payload["position"] = position(op)
+		case "move":
+			command = "set-access-rule"
+			payload["uid"] = op.uid
+			payload["new-position"] = position(op)
+		default:
+			payload["uid"] = op.uid
+		}
+
+		log.Printf("Access rulebase %s - %s %s", layer, command, op.name)
+		res, err := apiCall(client, command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+		if err != nil {
+			return added, fmt.Errorf("failed to %s %s: %s", command, op.name, err)
+		}
+		if !res.Success {
+			if op.action == "delete" && objectNotFound(getString(res.GetData(), "code")) {
+				continue
+			}
+			return added, fmt.Errorf("failed to %s %s: %s", command, op.name, res.ErrorMsg)
+		}
+		if op.action == "add" {
+			uid := getString(res.GetData(), "uid")
+			added = append(added, uid)
+			if op.section {
+				sectionUids[op.name] = uid
+			} else {
+				ruleUids[op.name] = uid
+			}
+		}
+	}
+	return added, nil
+}
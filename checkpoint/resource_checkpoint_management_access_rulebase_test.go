@@ -0,0 +1,226 @@
+package checkpoint
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCheckpointManagementAccessRulebase_basic(t *testing.T) {
+
+	resourceName := "checkpoint_management_access_rulebase.test"
+	layerName := "tfTestManagementAccessRulebase_" + acctest.RandString(6)
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementAccessRulebaseConfig(layerName, "rule1", "rule2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "rule1"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.rule.0.name", "rule2"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.rule.1.name", "Cleanup rule"),
+				),
+			},
+			{
+				Config: testAccManagementAccessRulebaseConfig(layerName, "rule2", "rule1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "rule2"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.rule.0.name", "rule1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccManagementAccessRulebaseConfig(layer string, first string, second string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_access_layer" "test" {
+  name = "%s"
+}
+
+resource "checkpoint_management_access_rulebase" "test" {
+  layer = checkpoint_management_access_layer.test.name
+
+  rule {
+    name   = "%s"
+    action = "Accept"
+    track  = "Log"
+  }
+
+  section {
+    name = "section1"
+
+    rule {
+      name = "%s"
+    }
+
+    rule {
+      name = "Cleanup rule"
+    }
+  }
+}
+`, layer, first, second)
+}
+
+func testAccessRulebaseRuleObject(name string, action string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":               name,
+		"uid":                "uid-" + name,
+		"action":             map[string]interface{}{"name": action},
+		"enabled":            true,
+		"comments":           "",
+		"source-negate":      false,
+		"destination-negate": false,
+		"service-negate":     false,
+		"source":             []interface{}{map[string]interface{}{"name": "Any"}},
+		"destination":        []interface{}{map[string]interface{}{"name": "Any"}},
+		"service":            []interface{}{map[string]interface{}{"name": "Any"}},
+		"install-on":         []interface{}{map[string]interface{}{"name": "Policy Targets"}},
+		"track":              map[string]interface{}{"type": map[string]interface{}{"name": "None"}},
+	}
+}
+
+func testAccessRulebaseRule(name string, action string) rulebaseItem {
+	return rulebaseItem{name: name, rule: map[string]interface{}{
+		"name":               name,
+		"action":             action,
+		"enabled":            true,
+		"comments":           "",
+		"source_negate":      false,
+		"destination_negate": false,
+		"service_negate":     false,
+		"track":              "None",
+	}}
+}
+
+func TestPlanAccessRulebase(t *testing.T) {
+	section := rulebaseEntry{uid: "uid-S1", name: "S1", section: true}
+	actual := []rulebaseEntry{
+		{uid: "uid-r1", name: "r1", object: testAccessRulebaseRuleObject("r1", "Accept")},
+		section,
+		{uid: "uid-r2", name: "r2", sectionUid: "uid-S1", object: testAccessRulebaseRuleObject("r2", "Accept")},
+		{uid: "uid-r3", name: "r3", sectionUid: "uid-S1", object: testAccessRulebaseRuleObject("r3", "Accept")},
+		{uid: "uid-old", name: "old", sectionUid: "uid-S1", object: testAccessRulebaseRuleObject("old", "Accept")},
+	}
+	desired := []rulebaseItem{
+		testAccessRulebaseRule("r1", "Accept"),
+		{section: true, name: "S1"},
+		testAccessRulebaseRule("r3", "accept"),
+		testAccessRulebaseRule("r2", "Drop"),
+		testAccessRulebaseRule("new", "Accept"),
+	}
+
+	ops, ruleUids, _ := planAccessRulebase(actual, desired)
+	if len(ops) != 4 {
+		t.Fatalf("expected 4 operations, got %+v", ops)
+	}
+	expected := []struct {
+		action string
+		name   string
+		after  string
+	}{
+		{"delete", "old", ""},
+		{"move", "r2", "r3"},
+		{"add", "new", "r2"},
+		{"set", "r2", ""},
+	}
+	for i, e := range expected {
+		op := ops[i]
+		if op.action != e.action || op.name != e.name {
+			t.Fatalf("operation %d: expected %s %s, got %s %s", i, e.action, e.name, op.action, op.name)
+		}
+		if e.after != "" && (op.after == nil || op.after.name != e.after) {
+			t.Fatalf("operation %d: expected position after %s, got %+v", i, e.after, op.after)
+		}
+	}
+	if len(ops[3].fields) != 1 || ops[3].fields["action"] != "Drop" {
+		t.Fatalf("expected only action to be set, got %v", ops[3].fields)
+	}
+	if ruleUids["r2"] != "uid-r2" {
+		t.Fatalf("unexpected rule uids %v", ruleUids)
+	}
+}
+
+func TestPlanAccessRulebaseRecreatesSectionsOutOfOrder(t *testing.T) {
+	actual := []rulebaseEntry{
+		{uid: "uid-S1", name: "S1", section: true},
+		{uid: "uid-S2", name: "S2", section: true},
+	}
+	desired := []rulebaseItem{
+		{section: true, name: "S2"},
+		{section: true, name: "S1"},
+	}
+
+	ops, _, _ := planAccessRulebase(actual, desired)
+	if len(ops) != 2 || ops[0].action != "delete" || ops[1].action != "add" || ops[0].name != ops[1].name {
+		t.Fatalf("expected one section to be recreated, got %+v", ops)
+	}
+}
+
+func TestPlanAccessRulebaseNoChanges(t *testing.T) {
+	actual := []rulebaseEntry{
+		{uid: "uid-r1", name: "r1", object: testAccessRulebaseRuleObject("r1", "Accept")},
+	}
+	desired := []rulebaseItem{testAccessRulebaseRule("r1", "Accept")}
+
+	if ops, _, _ := planAccessRulebase(actual, desired); len(ops) != 0 {
+		t.Fatalf("expected no operations, got %+v", ops)
+	}
+}
+
+func TestAccessRulebaseLayerDeleted_offline(t *testing.T) {
+	_, provider := testFakeProvider(t, "web_api", nil)
+	r := provider.ResourcesMap["checkpoint_management_access_rulebase"]
+
+	d := r.TestResourceData()
+	d.SetId("Network")
+	_ = d.Set("layer", "Network")
+	if err := r.Read(d, provider.Meta()); err != nil {
+		t.Fatalf("expected a deleted layer to be removed from the state, got %s", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be removed from the state")
+	}
+}
+
+func TestAccessRulebaseDeleteOwned_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	_, owned := server.add("access-rule", map[string]interface{}{"name": "rule1"})
+	_, cleanup := server.add("access-rule", map[string]interface{}{"name": "Cleanup rule"})
+	r := provider.ResourcesMap["checkpoint_management_access_rulebase"]
+
+	d := r.TestResourceData()
+	d.SetId("Network")
+	_ = d.Set("layer", "Network")
+	_ = d.Set("rule", []interface{}{
+		map[string]interface{}{"name": "rule1", "uid": owned["uid"]},
+		map[string]interface{}{"name": "Cleanup rule", "uid": cleanup["uid"]},
+	})
+	_ = d.Set("owned_uids", []interface{}{owned["uid"]})
+	if err := r.Delete(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+
+	if server.find("access-rule", map[string]interface{}{"uid": owned["uid"]}) != nil {
+		t.Errorf("expected the rule added by the resource to be deleted")
+	}
+	if server.find("access-rule", map[string]interface{}{"uid": cleanup["uid"]}) == nil {
+		t.Errorf("expected the cleanup rule to be kept")
+	}
+	if commands := strings.Join(server.commands(), ","); strings.Count(commands, "delete-access-rule") != 1 {
+		t.Errorf("expected a single rule to be deleted, got %s", commands)
+	}
+}
@@ -131,7 +131,7 @@ func createManagementAccessSection(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create AccessSection - Map = ", accessSection)
 
-	addAccessSectionRes, err := client.ApiCall("add-access-section", accessSection, client.GetSessionID(), true, client.IsProxyUsed())
+	addAccessSectionRes, err := apiCall(client, "add-access-section", accessSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessSectionRes.Success {
 		if addAccessSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addAccessSectionRes.ErrorMsg)
@@ -153,7 +153,7 @@ func readManagementAccessSection(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	showAccessSectionRes, err := client.ApiCall("show-access-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAccessSectionRes, err := apiCall(client, "show-access-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -216,7 +216,7 @@ func updateManagementAccessSection(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update AccessSection - Map = ", accessSection)
 
-	updateAccessSectionRes, err := client.ApiCall("set-access-section", accessSection, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAccessSectionRes, err := apiCall(client, "set-access-section", accessSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessSectionRes.Success {
 		if updateAccessSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateAccessSectionRes.ErrorMsg)
@@ -246,7 +246,7 @@ func deleteManagementAccessSection(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete AccessSection")
 
-	deleteAccessSectionRes, err := client.ApiCall("delete-access-section", accessSectionPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAccessSectionRes, err := apiCall(client, "delete-access-section", accessSectionPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAccessSectionRes.Success {
 		if deleteAccessSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAccessSectionRes.ErrorMsg)
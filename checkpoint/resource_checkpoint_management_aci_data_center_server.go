@@ -153,7 +153,7 @@ func createManagementAciDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Create aciDataCenterServer - Map = ", aciDataCenterServer)
 
-	addAciDataCenterServerRes, err := client.ApiCall("add-data-center-server", aciDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addAciDataCenterServerRes, err := apiCall(client, "add-data-center-server", aciDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -167,7 +167,7 @@ func createManagementAciDataCenterServer(d *schema.ResourceData, m interface{})
 	payload := map[string]interface{}{
 		"name": aciDataCenterServer["name"],
 	}
-	showAciDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAciDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -184,7 +184,7 @@ func readManagementAciDataCenterServer(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showAciDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAciDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -325,7 +325,7 @@ func updateManagementAciDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Update aciDataCenterServer - Map = ", aciDataCenterServer)
 
-	updateAciDataCenterServerRes, err := client.ApiCall("set-data-center-server", aciDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAciDataCenterServerRes, err := apiCall(client, "set-data-center-server", aciDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -358,7 +358,7 @@ func deleteManagementAciDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Delete aciDataCenterServer")
 
-	deleteAciDataCenterServerRes, err := client.ApiCall("delete-data-center-server", aciDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAciDataCenterServerRes, err := apiCall(client, "delete-data-center-server", aciDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAciDataCenterServerRes.Success {
 		if deleteAciDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAciDataCenterServerRes.ErrorMsg)
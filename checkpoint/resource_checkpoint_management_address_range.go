@@ -198,15 +198,12 @@ func createManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Address Range - Map = ", addressRange)
 
-	addAddressRangeRes, err := client.ApiCall("add-address-range", addressRange, client.GetSessionID(), true, client.IsProxyUsed())
-	if err != nil || !addAddressRangeRes.Success {
-		if addAddressRangeRes.ErrorMsg != "" {
-			return fmt.Errorf("%s", addAddressRangeRes.ErrorMsg)
-		}
-		return fmt.Errorf("%s", err.Error())
+	uid, err := addObject(client, "address-range", addressRange)
+	if err != nil {
+		return err
 	}
 
-	d.SetId(addAddressRangeRes.GetData()["uid"].(string))
+	d.SetId(uid)
 
 	return readManagementAddressRange(d, m)
 }
@@ -219,7 +216,7 @@ func readManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showAddressRangeRes, err := client.ApiCall("show-address-range", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAddressRangeRes, err := apiCall(client, "show-address-range", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -396,12 +393,8 @@ func updateManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Address Range - Map = ", addressRange)
-	updateAddressRangeRes, err := client.ApiCall("set-address-range", addressRange, client.GetSessionID(), true, client.IsProxyUsed())
-	if err != nil || !updateAddressRangeRes.Success {
-		if updateAddressRangeRes.ErrorMsg != "" {
-			return fmt.Errorf("%s", updateAddressRangeRes.ErrorMsg)
-		}
-		return fmt.Errorf("%s", err.Error())
+	if err := setObject(client, "address-range", addressRange); err != nil {
+		return err
 	}
 
 	return readManagementAddressRange(d, m)
@@ -422,12 +415,8 @@ func deleteManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 		addressRangePayload["ignore-warnings"] = v.(bool)
 	}
 
-	deleteAddressRangeRes, err := client.ApiCall("delete-address-range", addressRangePayload, client.GetSessionID(), true, client.IsProxyUsed())
-	if err != nil || !deleteAddressRangeRes.Success {
-		if deleteAddressRangeRes.ErrorMsg != "" {
-			return fmt.Errorf("%s", deleteAddressRangeRes.ErrorMsg)
-		}
-		return fmt.Errorf("%s", err.Error())
+	if err := deleteObject(client, "address-range", addressRangePayload); err != nil {
+		return err
 	}
 	d.SetId("")
 
@@ -221,7 +221,7 @@ func createManagementAdministrator(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create Administrator - Map = ", administrator)
 
-	addAdministratorRes, err := client.ApiCall("add-administrator", administrator, client.GetSessionID(), true, client.IsProxyUsed())
+	addAdministratorRes, err := apiCall(client, "add-administrator", administrator, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAdministratorRes.Success {
 		if addAdministratorRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addAdministratorRes.ErrorMsg)
@@ -240,7 +240,7 @@ func readManagementAdministrator(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showAdministratorRes, err := client.ApiCall("show-administrator", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAdministratorRes, err := apiCall(client, "show-administrator", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -473,7 +473,7 @@ func updateManagementAdministrator(d *schema.ResourceData, m interface{}) error
 	}
 
 	log.Println("Update Administrator - Map = ", administrator)
-	updateAdministratorRes, err := client.ApiCall("set-administrator", administrator, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAdministratorRes, err := apiCall(client, "set-administrator", administrator, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAdministratorRes.Success {
 		if updateAdministratorRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateAdministratorRes.ErrorMsg)
@@ -498,7 +498,7 @@ func deleteManagementAdministrator(d *schema.ResourceData, m interface{}) error
 		administratorPayload["ignore-warnings"] = v.(bool)
 	}
 
-	deleteAdministratorRes, err := client.ApiCall("delete-administrator", administratorPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAdministratorRes, err := apiCall(client, "delete-administrator", administratorPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAdministratorRes.Success {
 		if deleteAdministratorRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAdministratorRes.ErrorMsg)
@@ -159,7 +159,7 @@ func createManagementAwsDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Create awsDataCenterServer - Map = ", awsDataCenterServer)
 
-	addAwsDataCenterServerRes, err := client.ApiCall("add-data-center-server", awsDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addAwsDataCenterServerRes, err := apiCall(client, "add-data-center-server", awsDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -173,7 +173,7 @@ func createManagementAwsDataCenterServer(d *schema.ResourceData, m interface{})
 	payload := map[string]interface{}{
 		"name": awsDataCenterServer["name"],
 	}
-	showAwsDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAwsDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -190,7 +190,7 @@ func readManagementAwsDataCenterServer(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showAwsDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAwsDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -329,7 +329,7 @@ func updateManagementAwsDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Update awsDataCenterServer - Map = ", awsDataCenterServer)
 
-	updateAwsDataCenterServerRes, err := client.ApiCall("set-data-center-server", awsDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAwsDataCenterServerRes, err := apiCall(client, "set-data-center-server", awsDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -362,7 +362,7 @@ func deleteManagementAwsDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Delete awsDataCenterServer")
 
-	deleteAwsDataCenterServerRes, err := client.ApiCall("delete-data-center-server", awsDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAwsDataCenterServerRes, err := apiCall(client, "delete-data-center-server", awsDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAwsDataCenterServerRes.Success {
 		if deleteAwsDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAwsDataCenterServerRes.ErrorMsg)
@@ -148,7 +148,7 @@ func createManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create AzureAd - Map = ", azureAd)
 
-	addAzureAdRes, err := client.ApiCall("add-azure-ad", azureAd, client.GetSessionID(), true, client.IsProxyUsed())
+	addAzureAdRes, err := apiCall(client, "add-azure-ad", azureAd, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAzureAdRes.Success {
 		if addAzureAdRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addAzureAdRes.ErrorMsg)
@@ -160,7 +160,7 @@ func createManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 		"name": d.Get("name").(string),
 	}
 
-	showAzureAdRes, err := client.ApiCall("show-azure-ad", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureAdRes, err := apiCall(client, "show-azure-ad", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -186,7 +186,7 @@ func readManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showAzureAdRes, err := client.ApiCall("show-azure-ad", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureAdRes, err := apiCall(client, "show-azure-ad", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -352,7 +352,7 @@ func updateManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update AzureAd - Map = ", azureAd)
 
-	updateAzureAdRes, err := client.ApiCall("set-azure-ad", azureAd, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAzureAdRes, err := apiCall(client, "set-azure-ad", azureAd, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAzureAdRes.Success {
 		if updateAzureAdRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateAzureAdRes.ErrorMsg)
@@ -381,7 +381,7 @@ func deleteManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete AzureAd")
 
-	deleteAzureAdRes, err := client.ApiCall("delete-azure-ad", azureAdPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAzureAdRes, err := apiCall(client, "delete-azure-ad", azureAdPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAzureAdRes.Success {
 		if deleteAzureAdRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAzureAdRes.ErrorMsg)
@@ -167,7 +167,7 @@ func createManagementAzureDataCenterServer(d *schema.ResourceData, m interface{}
 
 	log.Println("Create azureDataCenterServer - Map = ", azureDataCenterServer)
 
-	addAzureDataCenterServerRes, err := client.ApiCall("add-data-center-server", azureDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addAzureDataCenterServerRes, err := apiCall(client, "add-data-center-server", azureDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -181,7 +181,7 @@ func createManagementAzureDataCenterServer(d *schema.ResourceData, m interface{}
 	payload := map[string]interface{}{
 		"name": azureDataCenterServer["name"],
 	}
-	showAzureDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -198,7 +198,7 @@ func readManagementAzureDataCenterServer(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showAzureDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showAzureDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -341,7 +341,7 @@ func updateManagementAzureDataCenterServer(d *schema.ResourceData, m interface{}
 
 	log.Println("Update azureDataCenterServer - Map = ", azureDataCenterServer)
 
-	updateAzureDataCenterServerRes, err := client.ApiCall("set-data-center-server", azureDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateAzureDataCenterServerRes, err := apiCall(client, "set-data-center-server", azureDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -374,7 +374,7 @@ func deleteManagementAzureDataCenterServer(d *schema.ResourceData, m interface{}
 
 	log.Println("Delete azureDataCenterServer")
 
-	deleteAzureDataCenterServerRes, err := client.ApiCall("delete-data-center-server", azureDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAzureDataCenterServerRes, err := apiCall(client, "delete-data-center-server", azureDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAzureDataCenterServerRes.Success {
 		if deleteAzureDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAzureDataCenterServerRes.ErrorMsg)
@@ -747,7 +747,7 @@ func createManagementCheckpointHost(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create CheckpointHost - Map = ", checkpointHost)
 
-	addCheckpointHostRes, err := client.ApiCall("add-checkpoint-host", checkpointHost, client.GetSessionID(), true, client.IsProxyUsed())
+	addCheckpointHostRes, err := apiCall(client, "add-checkpoint-host", checkpointHost, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addCheckpointHostRes.Success {
 		if addCheckpointHostRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addCheckpointHostRes.ErrorMsg)
@@ -768,7 +768,7 @@ func readManagementCheckpointHost(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showCheckpointHostRes, err := client.ApiCall("show-checkpoint-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showCheckpointHostRes, err := apiCall(client, "show-checkpoint-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1434,7 +1434,7 @@ func updateManagementCheckpointHost(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update CheckpointHost - Map = ", checkpointHost)
 
-	updateCheckpointHostRes, err := client.ApiCall("set-checkpoint-host", checkpointHost, client.GetSessionID(), true, client.IsProxyUsed())
+	updateCheckpointHostRes, err := apiCall(client, "set-checkpoint-host", checkpointHost, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateCheckpointHostRes.Success {
 		if updateCheckpointHostRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateCheckpointHostRes.ErrorMsg)
@@ -1462,7 +1462,7 @@ func deleteManagementCheckpointHost(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete CheckpointHost")
 
-	deleteCheckpointHostRes, err := client.ApiCall("delete-checkpoint-host", checkpointHostPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteCheckpointHostRes, err := apiCall(client, "delete-checkpoint-host", checkpointHostPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteCheckpointHostRes.Success {
 		if deleteCheckpointHostRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteCheckpointHostRes.ErrorMsg)
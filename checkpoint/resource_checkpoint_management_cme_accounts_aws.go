@@ -170,7 +170,7 @@ func readManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error {
 
 	url := CmeApiPath + "/accounts/" + name
 
-	AWSAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AWSAccountRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -350,7 +350,7 @@ func createManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error
 
 	url := CmeApiPath + "/accounts/aws"
 
-	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cmeAccountsRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -456,7 +456,7 @@ func updateManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error
 	log.Println("Set cme AWS account - name = ", name)
 
 	url := CmeApiPath + "/accounts/aws/" + name
-	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeAccountsRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -482,7 +482,7 @@ func deleteManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error
 
 	url := CmeApiPath + "/accounts/" + name
 
-	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+	res, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -88,7 +88,7 @@ func deleteManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) err
 	log.Println("Delete cme Azure account - name = ", name)
 	url := CmeApiPath + "/accounts/" + name
 
-	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+	res, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -116,7 +116,7 @@ func readManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) error
 	log.Println("Read cme Azure account - name = ", name)
 	url := CmeApiPath + "/accounts/" + name
 
-	AzureAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AzureAccountRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -186,7 +186,7 @@ func createManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) err
 
 	url := CmeApiPath + "/accounts/azure"
 
-	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cmeAccountsRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -236,7 +236,7 @@ func updateManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) err
 	log.Println("Set cme Azure account - name = ", name)
 
 	url := CmeApiPath + "/accounts/azure/" + name
-	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeAccountsRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
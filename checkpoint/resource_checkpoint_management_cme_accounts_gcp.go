@@ -77,7 +77,7 @@ func deleteManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error
 	log.Println("Delete cme GCP account - name = ", name)
 	url := CmeApiPath + "/accounts/" + name
 
-	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+	res, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -105,7 +105,7 @@ func readManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error {
 	log.Println("Read cme GCP account - name = ", name)
 	url := CmeApiPath + "/accounts/" + name
 
-	GCPAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	GCPAccountRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -169,7 +169,7 @@ func createManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error
 
 	url := CmeApiPath + "/accounts/gcp"
 
-	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cmeAccountsRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -213,7 +213,7 @@ func updateManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error
 	log.Println("Set cme GCP account - name = ", name)
 
 	url := CmeApiPath + "/accounts/gcp/" + name
-	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeAccountsRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -56,7 +56,7 @@ func createUpdateManagementCMEDelayCycle(d *schema.ResourceData, m interface{})
 
 	url := CmeApiPath + "/generalConfiguration/delayCycle"
 
-	cmeDelayCycleRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeDelayCycleRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -76,7 +76,7 @@ func readManagementCMEDelayCycle(d *schema.ResourceData, m interface{}) error {
 	log.Println("Read cme delay cycle")
 	url := CmeApiPath + "/generalConfiguration/delayCycle"
 
-	cmeDelayCycleRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeDelayCycleRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -262,7 +262,7 @@ func readManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{})
 
 	url := CmeApiPath + "/gwConfigurations/" + name
 
-	AWSGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AWSGWConfigurationRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -515,7 +515,7 @@ func createManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{
 
 	url := CmeApiPath + "/gwConfigurations/aws"
 
-	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cmeGWConfigurationRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -667,7 +667,7 @@ func updateManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{
 	log.Println("Set cme AWS GW configuration - name = ", name)
 
 	url := CmeApiPath + "/gwConfigurations/aws/" + name
-	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeGWConfigurationRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -693,7 +693,7 @@ func deleteManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{
 	url := CmeApiPath + "/gwConfigurations/" + name
 
 	log.Println("Delete cme AWS GW configuration - name = ", name)
-	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+	res, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -231,7 +231,7 @@ func readManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interface{
 
 	url := CmeApiPath + "/gwConfigurations/" + name
 
-	AzureGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	AzureGWConfigurationRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -462,7 +462,7 @@ func createManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interfac
 
 	url := CmeApiPath + "/gwConfigurations/azure"
 
-	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cmeGWConfigurationRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -602,7 +602,7 @@ func updateManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interfac
 	log.Println("Set cme Azure GW configuration - name = ", name)
 
 	url := CmeApiPath + "/gwConfigurations/azure/" + name
-	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeGWConfigurationRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -628,7 +628,7 @@ func deleteManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interfac
 	url := CmeApiPath + "/gwConfigurations/" + name
 
 	log.Println("Delete cme Azure GW configuration - name = ", name)
-	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+	res, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -226,7 +226,7 @@ func readManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{})
 
 	url := CmeApiPath + "/gwConfigurations/" + name
 
-	GCPGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	GCPGWConfigurationRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -452,7 +452,7 @@ func createManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{
 
 	url := CmeApiPath + "/gwConfigurations/gcp"
 
-	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	cmeGWConfigurationRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -589,7 +589,7 @@ func updateManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{
 	log.Println("Set cme GCP GW configuration - name = ", name)
 
 	url := CmeApiPath + "/gwConfigurations/gcp/" + name
-	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeGWConfigurationRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -615,7 +615,7 @@ func deleteManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{
 	url := CmeApiPath + "/gwConfigurations/" + name
 
 	log.Println("Delete cme GCP GW configuration - name = ", name)
-	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+	res, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -69,7 +69,7 @@ func createUpdateManagementCMEManagement(d *schema.ResourceData, m interface{})
 
 	url := CmeApiPath + "/management"
 
-	cmeManagementRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+	cmeManagementRes, err := apiCall(client, url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -89,7 +89,7 @@ func readManagementCMEManagement(d *schema.ResourceData, m interface{}) error {
 	log.Println("Read cme management")
 	url := CmeApiPath + "/management"
 
-	cmeManagementRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeManagementRes, err := apiCall(client, url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -46,7 +46,7 @@ func createManagementAbortGetInterfaces(d *schema.ResourceData, m interface{}) e
 		payload["force-cleanup"] = v.(bool)
 	}
 
-	AbortGetInterfacesRes, err := client.ApiCall("abort-get-interfaces", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	AbortGetInterfacesRes, err := apiCall(client, "abort-get-interfaces", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -47,7 +47,7 @@ func createManagementAddApiKey(d *schema.ResourceData, m interface{}) error {
 		payload["admin-name"] = v.(string)
 	}
 
-	AddApiKeyRes, _ := client.ApiCall("add-api-key", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	AddApiKeyRes, _ := apiCall(client, "add-api-key", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !AddApiKeyRes.Success {
 		return fmt.Errorf("%s", AddApiKeyRes.ErrorMsg)
 	}
@@ -102,7 +102,7 @@ func createManagementAddCustomTrustedCaCertificate(d *schema.ResourceData, m int
 		payload["base64-certificate"] = v.(string)
 	}
 
-	AddCustomTrustedCaCertificateRes, _ := client.ApiCall("add-custom-trusted-ca-certificate", payload, client.GetSessionID(), true, false)
+	AddCustomTrustedCaCertificateRes, _ := apiCall(client, "add-custom-trusted-ca-certificate", payload, client.GetSessionID(), true, false)
 	if !AddCustomTrustedCaCertificateRes.Success {
 		return fmt.Errorf("%s", AddCustomTrustedCaCertificateRes.ErrorMsg)
 	}
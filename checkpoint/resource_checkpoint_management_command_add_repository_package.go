@@ -56,7 +56,7 @@ func createManagementAddRepositoryPackage(d *schema.ResourceData, m interface{})
 		payload["source"] = v.(string)
 	}
 
-	AddRepositoryPackageRes, _ := client.ApiCall("add-repository-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	AddRepositoryPackageRes, _ := apiCall(client, "add-repository-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !AddRepositoryPackageRes.Success {
 		return fmt.Errorf("%s", AddRepositoryPackageRes.ErrorMsg)
 	}
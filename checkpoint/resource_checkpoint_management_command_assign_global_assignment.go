@@ -55,7 +55,7 @@ func createManagementAssignGlobalAssignment(d *schema.ResourceData, m interface{
 		payload["global-domains"] = v.(*schema.Set).List()
 	}
 
-	AssignGlobalAssignmentRes, _ := client.ApiCall("assign-global-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	AssignGlobalAssignmentRes, _ := apiCall(client, "assign-global-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !AssignGlobalAssignmentRes.Success {
 		return fmt.Errorf("%s", AssignGlobalAssignmentRes.ErrorMsg)
 	}
@@ -25,6 +25,17 @@ func resourceManagementBackupDomain() *schema.Resource {
 				ForceNew:    true,
 				Description: "Path in which the backup domain data will be saved. <br>Should be the directory path or the full file path with \".tgz\" <br>If no path was inserted the default will be: \"/var/log/&lt;domain name&gt;_&lt;date&gt;.tgz\".",
 			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path to download the backup file to once the command completes.",
+			},
+			"output_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the file downloaded to output_path.",
+			},
 			"task_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -46,13 +57,16 @@ func createManagementBackupDomain(d *schema.ResourceData, m interface{}) error {
 		payload["file-path"] = v.(string)
 	}
 
-	BackupDomainRes, _ := client.ApiCall("backup-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	BackupDomainRes, _ := apiCall(client, "backup-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !BackupDomainRes.Success {
 		return fmt.Errorf("%s", BackupDomainRes.ErrorMsg)
 	}
 
 	d.SetId("backup-domain-" + acctest.RandString(10))
 	_ = d.Set("task_id", resolveTaskId(BackupDomainRes.GetData()))
+	if err := downloadCommandArtifact(d, client, BackupDomainRes.GetData(), d.Get("file_path").(string)); err != nil {
+		return err
+	}
 	return readManagementBackupDomain(d, m)
 }
 
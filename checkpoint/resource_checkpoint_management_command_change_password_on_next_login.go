@@ -27,7 +27,7 @@ func createManagementChangePasswordOnNextLogin(d *schema.ResourceData, m interfa
 	client := m.(*checkpoint.ApiClient)
 
 	var payload = map[string]interface{}{}
-	ChangePasswordOnNextLoginRes, err := client.ApiCall("change-password-on-next-login", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ChangePasswordOnNextLoginRes, err := apiCall(client, "change-password-on-next-login", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
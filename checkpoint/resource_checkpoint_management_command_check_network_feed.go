@@ -252,7 +252,7 @@ func createManagementCheckNetworkFeed(d *schema.ResourceData, m interface{}) err
 		payload["targets"] = v.(*schema.Set).List()
 	}
 
-	CheckNetworkFeedRes, _ := client.ApiCall("check-network-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	CheckNetworkFeedRes, _ := apiCall(client, "check-network-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !CheckNetworkFeedRes.Success {
 		return fmt.Errorf("%s", CheckNetworkFeedRes.ErrorMsg)
 	}
@@ -291,7 +291,7 @@ func createManagementCheckThreatIocFeed(d *schema.ResourceData, m interface{}) e
 		payload["targets"] = v.(*schema.Set).List()
 	}
 
-	CheckThreatIocFeedRes, _ := client.ApiCall("check-threat-ioc-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	CheckThreatIocFeedRes, _ := apiCall(client, "check-threat-ioc-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !CheckThreatIocFeedRes.Success {
 		return fmt.Errorf("%s", CheckThreatIocFeedRes.ErrorMsg)
 	}
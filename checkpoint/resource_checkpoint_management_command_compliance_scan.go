@@ -1,23 +1,161 @@
 package checkpoint
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"strings"
+	"time"
+
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Statuses of best practices which didn't pass the compliance scan.
+var complianceFailedStatuses = map[string]bool{
+	"poor":          true,
+	"fail":          true,
+	"failed":        true,
+	"non-compliant": true,
+	"not compliant": true,
+}
+
+func complianceScoreSchema(description string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description + " name.",
+		},
+		"score": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: description + " compliance score.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description + " compliance status.",
+		},
+	}
+}
+
 func resourceManagementComplianceScan() *schema.Resource {
+	gatewaySchema := complianceScoreSchema("Gateway")
+	gatewaySchema["uid"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Gateway unique identifier.",
+	}
+	gatewaySchema["blades"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Compliance results of the gateway per blade.",
+		Elem:        &schema.Resource{Schema: complianceScoreSchema("Blade")},
+	}
+
 	return &schema.Resource{
 		Create: createManagementComplianceScan,
 		Read:   readManagementComplianceScan,
 		Delete: deleteManagementComplianceScan,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
+			"fail_on": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Fail the scan when the results don't meet the thresholds, or don't have the results to check them. The resource is kept in the state with the scan results.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_score": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "Fail when the overall compliance score is below this value.",
+						},
+						"critical_best_practice": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Fail when a critical best practice failed.",
+						},
+					},
+				},
+			},
 			"task_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Compliance scan task UID.",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Compliance scan task status.",
+			},
+			"score": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Overall compliance score.",
+			},
+			"blades": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Compliance results per blade.",
+				Elem:        &schema.Resource{Schema: complianceScoreSchema("Blade")},
+			},
+			"gateways": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Compliance results per gateway.",
+				Elem:        &schema.Resource{Schema: gatewaySchema},
+			},
+			"failed_best_practices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Best practices which failed the compliance scan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"best_practice_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Best practice ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Best practice name.",
+						},
+						"blade": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Blade of the best practice.",
+						},
+						"gateway": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Gateway the best practice failed on. Empty for best practices of the management server.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Best practice severity.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Best practice status.",
+						},
+					},
+				},
+			},
+			"task_details": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Compliance scan task details in JSON format.",
+			},
 		},
 	}
 }
@@ -27,7 +165,7 @@ func createManagementComplianceScan(d *schema.ResourceData, m interface{}) error
 	client := m.(*checkpoint.ApiClient)
 
 	var payload = map[string]interface{}{}
-	ComplianceScanRes, err := client.ApiCall("compliance-scan", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ComplianceScanRes, err := apiCall(client, "compliance-scan", payload, client.GetSessionID(), false, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -35,10 +173,45 @@ func createManagementComplianceScan(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("%s", ComplianceScanRes.ErrorMsg)
 	}
 
+	taskRes, err := HandleTaskCreate(context.Background(), client, "compliance-scan", ComplianceScanRes, true, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("compliance-scan task polling failed: %s", err)
+	}
+
 	d.SetId("compliance-scan-" + acctest.RandString(10))
-	_ = d.Set("task_id", resolveTaskId(ComplianceScanRes.GetData()))
+	_ = d.Set("task_id", taskRes.TaskID)
+	_ = d.Set("status", taskRes.Status)
 
-	return nil
+	results := complianceResults{}
+	if taskRes.Completed {
+		if taskData, err := showTaskFull(client, taskRes.TaskID); err == nil {
+			if details, ok := taskDetailsJSON(taskData); ok {
+				_ = d.Set("task_details", details)
+				results = parseComplianceResults(details)
+			}
+		} else {
+			log.Printf("[WARN] failed to read compliance-scan task details: %s", err)
+		}
+	}
+	_ = d.Set("score", results.score)
+	_ = d.Set("blades", results.blades)
+	_ = d.Set("gateways", results.gateways)
+	_ = d.Set("failed_best_practices", results.failedBestPractices)
+
+	if !taskRes.IsSuccess() {
+		return fmt.Errorf("compliance-scan task %s ended with status: %s\n%s", taskRes.TaskID, taskRes.Status, taskRes.Message)
+	}
+	if v, ok := d.GetOk("fail_on"); ok {
+		failOn, _ := v.([]interface{})[0].(map[string]interface{})
+		if failOn != nil {
+			minScore, _ := failOn["min_score"].(float64)
+			if msg := results.failure(minScore, failOn["critical_best_practice"] == true); msg != "" {
+				return fmt.Errorf("compliance-scan task %s failed the fail_on thresholds: %s", taskRes.TaskID, msg)
+			}
+		}
+	}
+
+	return readManagementComplianceScan(d, m)
 }
 
 func readManagementComplianceScan(d *schema.ResourceData, m interface{}) error {
@@ -50,3 +223,142 @@ func deleteManagementComplianceScan(d *schema.ResourceData, m interface{}) error
 	d.SetId("")
 	return nil
 }
+
+// complianceResults are the compliance scan results in the format of the resource schema. found is
+// whether the task details have the results, and scoreFound whether they have the overall score.
+type complianceResults struct {
+	found               bool
+	score               float64
+	scoreFound          bool
+	blades              []interface{}
+	gateways            []interface{}
+	failedBestPractices []interface{}
+}
+
+// parseComplianceResults parses the results of the compliance scan in the task details: an entry with
+// the "overall-score", the "blades" scores, the "gateways" with their "blades" and "best-practices",
+// and the "best-practices" of the management server. Results in any other format aren't parsed.
+func parseComplianceResults(details string) complianceResults {
+	results := complianceResults{
+		blades:              make([]interface{}, 0),
+		gateways:            make([]interface{}, 0),
+		failedBestPractices: make([]interface{}, 0),
+	}
+	var entries []interface{}
+	if err := json.Unmarshal([]byte(details), &entries); err != nil {
+		log.Printf("[WARN] failed to parse compliance-scan task details: %s", err)
+		return results
+	}
+
+	for _, entry := range entries {
+		result, ok := entry.(map[string]interface{})
+		if !ok || (result["overall-score"] == nil && result["blades"] == nil && result["gateways"] == nil) {
+			continue
+		}
+		results.found = true
+		if score, ok := complianceNumber(result["overall-score"]); ok {
+			results.score, results.scoreFound = score, true
+		}
+		results.blades = append(results.blades, complianceScores(result["blades"])...)
+		results.failedBestPractices = append(results.failedBestPractices, failedBestPractices(result["best-practices"], "")...)
+		for _, gw := range complianceList(result["gateways"]) {
+			score, _ := complianceNumber(gw["score"])
+			results.gateways = append(results.gateways, map[string]interface{}{
+				"name":   getString(gw, "name"),
+				"uid":    getString(gw, "uid"),
+				"score":  score,
+				"status": getString(gw, "status"),
+				"blades": complianceScores(gw["blades"]),
+			})
+			results.failedBestPractices = append(results.failedBestPractices, failedBestPractices(gw["best-practices"], getString(gw, "name"))...)
+		}
+	}
+	if !results.found {
+		log.Printf("[WARN] compliance-scan task details don't have the compliance results")
+	}
+	return results
+}
+
+// failure returns why the results don't meet the fail_on thresholds, or "" when they do. Results
+// which can't be checked against a threshold fail it.
+func (r complianceResults) failure(minScore float64, criticalBestPractice bool) string {
+	if !r.found && (minScore > 0 || criticalBestPractice) {
+		return "the task details don't have the compliance results"
+	}
+	var reasons []string
+	if minScore > 0 && !r.scoreFound {
+		reasons = append(reasons, "the task details don't have the overall compliance score")
+	} else if minScore > 0 && r.score < minScore {
+		reasons = append(reasons, fmt.Sprintf("compliance score %g is below %g", r.score, minScore))
+	}
+	if criticalBestPractice {
+		for _, bp := range r.failedBestPractices {
+			bpMap := bp.(map[string]interface{})
+			if !strings.EqualFold(bpMap["severity"].(string), "critical") {
+				continue
+			}
+			reason := fmt.Sprintf("critical best practice %s %s failed", bpMap["best_practice_id"], bpMap["name"])
+			if gateway := bpMap["gateway"].(string); gateway != "" {
+				reason += " on " + gateway
+			}
+			reasons = append(reasons, reason)
+		}
+	}
+	return strings.Join(reasons, "\n")
+}
+
+func complianceScores(v interface{}) []interface{} {
+	scores := make([]interface{}, 0)
+	for _, item := range complianceList(v) {
+		score, _ := complianceNumber(item["score"])
+		scores = append(scores, map[string]interface{}{
+			"name":   getString(item, "name"),
+			"score":  score,
+			"status": getString(item, "status"),
+		})
+	}
+	return scores
+}
+
+// failedBestPractices returns the best practices of the gateway, or of the management server when
+// gateway is empty, which didn't pass the compliance scan.
+func failedBestPractices(v interface{}, gateway string) []interface{} {
+	failed := make([]interface{}, 0)
+	for _, bp := range complianceList(v) {
+		if complianceFailedStatuses[strings.ToLower(getString(bp, "status"))] {
+			failed = append(failed, map[string]interface{}{
+				"best_practice_id": getString(bp, "best-practice-id"),
+				"name":             getString(bp, "name"),
+				"blade":            getString(bp, "blade"),
+				"gateway":          gateway,
+				"severity":         getString(bp, "severity"),
+				"status":           getString(bp, "status"),
+			})
+		}
+	}
+	return failed
+}
+
+func complianceList(v interface{}) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0)
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			items = append(items, itemMap)
+		}
+	}
+	return items
+}
+
+func complianceNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		var f float64
+		if _, err := fmt.Sscanf(strings.TrimSuffix(n, "%"), "%g", &f); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,105 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var testComplianceScanDetails = []interface{}{
+	map[string]interface{}{
+		"overall-score": float64(72),
+		"blades": []interface{}{
+			map[string]interface{}{"name": "Firewall", "score": float64(80), "status": "Good"},
+			map[string]interface{}{"name": "IPS", "score": "55%", "status": "Poor"},
+		},
+		"gateways": []interface{}{
+			map[string]interface{}{
+				"name":   "gw1",
+				"uid":    "u-gw1",
+				"score":  float64(60),
+				"status": "Medium",
+				"blades": []interface{}{
+					map[string]interface{}{"name": "Firewall", "score": float64(60), "status": "Medium"},
+				},
+				"best-practices": []interface{}{
+					map[string]interface{}{"best-practice-id": "FW101", "name": "Cleanup rule", "blade": "Firewall", "severity": "Critical", "status": "Poor"},
+					map[string]interface{}{"best-practice-id": "FW102", "name": "Stealth rule", "blade": "Firewall", "severity": "Critical", "status": "Good"},
+				},
+			},
+		},
+		"best-practices": []interface{}{
+			map[string]interface{}{"best-practice-id": "IPS101", "name": "IPS updates", "blade": "IPS", "severity": "Medium", "status": "Poor"},
+		},
+	},
+}
+
+func TestParseComplianceResults(t *testing.T) {
+	details, _ := json.Marshal(testComplianceScanDetails)
+	results := parseComplianceResults(string(details))
+
+	if results.score != 72 {
+		t.Errorf("expected overall score 72, got %g", results.score)
+	}
+	if len(results.blades) != 2 || results.blades[1].(map[string]interface{})["score"] != float64(55) {
+		t.Errorf("unexpected blades %v", results.blades)
+	}
+	if len(results.gateways) != 1 {
+		t.Fatalf("unexpected gateways %v", results.gateways)
+	}
+	gw := results.gateways[0].(map[string]interface{})
+	if gw["name"] != "gw1" || gw["uid"] != "u-gw1" || len(gw["blades"].([]interface{})) != 1 {
+		t.Errorf("unexpected gateway %v", gw)
+	}
+	if len(results.failedBestPractices) != 2 {
+		t.Fatalf("expected 2 failed best practices, got %v", results.failedBestPractices)
+	}
+
+	if msg := results.failure(70, false); msg != "" {
+		t.Errorf("score above threshold should pass, got %s", msg)
+	}
+	if msg := results.failure(80, false); !strings.Contains(msg, "72 is below 80") {
+		t.Errorf("expected score failure, got %s", msg)
+	}
+	if msg := results.failure(0, true); !strings.Contains(msg, "FW101") || !strings.Contains(msg, "on gw1") || strings.Contains(msg, "IPS101") {
+		t.Errorf("expected only the critical best practice to fail, got %s", msg)
+	}
+}
+
+func TestParseComplianceResultsThresholds(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		details  string
+		minScore float64
+		critical bool
+		wantErr  string
+	}{
+		{"zero score", `[{"overall-score": 0, "blades": []}]`, 50, false, "compliance score 0 is below 50"},
+		{"zero score without threshold", `[{"overall-score": 0, "blades": []}]`, 0, true, ""},
+		{"missing score", `[{"blades": [{"name": "Firewall", "score": 90}]}]`, 50, false, "don't have the overall compliance score"},
+		{"unknown format", `[{"statusCode": "succeeded", "statusDescription": "Scan completed"}]`, 0, true, "don't have the compliance results"},
+		{"unknown format without thresholds", `[{"statusCode": "succeeded"}]`, 0, false, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := parseComplianceResults(tc.details).failure(tc.minScore, tc.critical)
+			if tc.wantErr == "" && msg != "" || tc.wantErr != "" && !strings.Contains(msg, tc.wantErr) {
+				t.Errorf("expected failure %q, got %q", tc.wantErr, msg)
+			}
+		})
+	}
+}
+
+func TestCheckpointManagementComplianceScan_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["compliance-scan"] = testComplianceScanDetails
+
+	state, diags := testFakeApplyDiags(t, provider, "checkpoint_management_compliance_scan", nil, map[string]interface{}{
+		"fail_on": []interface{}{map[string]interface{}{"critical_best_practice": true}},
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "FW101") {
+		t.Fatalf("expected the scan to fail on the critical best practice, got %v", diags)
+	}
+	if state == nil || state.Attributes["score"] != "72" || state.Attributes["gateways.0.name"] != "gw1" || state.Attributes["failed_best_practices.#"] != "2" {
+		t.Errorf("expected the scan results in the state, got %v", state)
+	}
+}
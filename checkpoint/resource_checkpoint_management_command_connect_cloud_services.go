@@ -71,7 +71,7 @@ func createManagementConnectCloudServices(d *schema.ResourceData, m interface{})
 		payload["auth-token"] = v.(string)
 	}
 
-	ConnectCloudServicesRes, err := client.ApiCall("connect-cloud-services", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ConnectCloudServicesRes, err := apiCall(client, "connect-cloud-services", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
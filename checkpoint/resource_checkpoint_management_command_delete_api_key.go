@@ -52,7 +52,7 @@ func createManagementDeleteApiKey(d *schema.ResourceData, m interface{}) error {
 		payload["admin-name"] = v.(string)
 	}
 
-	DeleteApiKeyRes, _ := client.ApiCall("delete-api-key", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	DeleteApiKeyRes, _ := apiCall(client, "delete-api-key", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !DeleteApiKeyRes.Success {
 		return fmt.Errorf("%s", DeleteApiKeyRes.ErrorMsg)
 	}
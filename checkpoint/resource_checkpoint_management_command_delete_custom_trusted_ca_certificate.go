@@ -62,7 +62,7 @@ func createManagementDeleteCustomTrustedCaCertificate(d *schema.ResourceData, m
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	DeleteCustomTrustedCaCertificateRes, _ := client.ApiCall("delete-custom-trusted-ca-certificate", payload, client.GetSessionID(), true, false)
+	DeleteCustomTrustedCaCertificateRes, _ := apiCall(client, "delete-custom-trusted-ca-certificate", payload, client.GetSessionID(), true, false)
 	if !DeleteCustomTrustedCaCertificateRes.Success {
 		return fmt.Errorf("%s", DeleteCustomTrustedCaCertificateRes.ErrorMsg)
 	}
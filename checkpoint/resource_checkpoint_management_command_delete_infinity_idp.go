@@ -62,7 +62,7 @@ func createManagementDeleteInfinityIdp(d *schema.ResourceData, m interface{}) er
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	DeleteInfinityIdpRes, _ := client.ApiCall("delete-infinity-idp", payload, client.GetSessionID(), true, false)
+	DeleteInfinityIdpRes, _ := apiCall(client, "delete-infinity-idp", payload, client.GetSessionID(), true, false)
 	if !DeleteInfinityIdpRes.Success {
 		return fmt.Errorf("%s", DeleteInfinityIdpRes.ErrorMsg)
 	}
@@ -61,7 +61,7 @@ func createManagementDeleteInfinityIdpObject(d *schema.ResourceData, m interface
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	DeleteInfinityIdpObjectRes, _ := client.ApiCall("delete-infinity-idp-object", payload, client.GetSessionID(), true, false)
+	DeleteInfinityIdpObjectRes, _ := apiCall(client, "delete-infinity-idp-object", payload, client.GetSessionID(), true, false)
 	if !DeleteInfinityIdpObjectRes.Success {
 		return fmt.Errorf("%s", DeleteInfinityIdpObjectRes.ErrorMsg)
 	}
@@ -32,7 +32,7 @@ func createManagementDisconnectCloudServices(d *schema.ResourceData, m interface
 		payload["force"] = v.(bool)
 	}
 
-	disconnectCloudServicesRes, err := client.ApiCall("disconnect-cloud-services", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	disconnectCloudServicesRes, err := apiCall(client, "disconnect-cloud-services", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -61,6 +61,17 @@ func resourceManagementExport() *schema.Resource {
 				ForceNew:    true,
 				Description: "N/A",
 			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path to download the exported archive to once the command completes.",
+			},
+			"output_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the file downloaded to output_path.",
+			},
 			"task_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -98,13 +109,16 @@ func createManagementExport(d *schema.ResourceData, m interface{}) error {
 		payload["query-limit"] = v.(int)
 	}
 
-	ExportRes, _ := client.ApiCall("export", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ExportRes, _ := apiCall(client, "export", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !ExportRes.Success {
 		return fmt.Errorf("%s", ExportRes.ErrorMsg)
 	}
 
 	d.SetId("export-" + acctest.RandString(10))
 	_ = d.Set("task_id", resolveTaskId(ExportRes.GetData()))
+	if err := downloadCommandArtifact(d, client, ExportRes.GetData(), ""); err != nil {
+		return err
+	}
 	return readManagementExport(d, m)
 }
 
@@ -86,6 +86,17 @@ func resourceManagementExportManagement() *schema.Resource {
 				Default:     false,
 				Description: "Ignoring the verification warnings. By Setting this parameter to 'true' export will not be blocked by warnings.",
 			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path to download the export file to once the command completes.",
+			},
+			"output_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the file downloaded to output_path.",
+			},
 			"task_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -143,7 +154,7 @@ func createManagementExportManagement(d *schema.ResourceData, m interface{}) err
 		payload["ignore-warnings"] = v.(bool)
 	}
 
-	ExportManagementRes, err := client.ApiCall("export-management", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ExportManagementRes, err := apiCall(client, "export-management", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -153,6 +164,9 @@ func createManagementExportManagement(d *schema.ResourceData, m interface{}) err
 
 	d.SetId("export-management-" + acctest.RandString(10))
 	_ = d.Set("task_id", resolveTaskId(ExportManagementRes.GetData()))
+	if err := downloadCommandArtifact(d, client, ExportManagementRes.GetData(), d.Get("file_path").(string)); err != nil {
+		return err
+	}
 
 	return readManagementExportManagement(d, m)
 }
@@ -25,6 +25,17 @@ func resourceManagementExportSmartTask() *schema.Resource {
 				ForceNew:    true,
 				Description: "Path to the SmartTask file to be exported. <br>Should be the full file path (example, \"/home/admin/exported-smart-task.txt)\".<br>If no path was inserted the default will be: \"/var/log/<task_name>.txt\".",
 			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path to download the exported SmartTask file to once the command completes.",
+			},
+			"output_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the file downloaded to output_path.",
+			},
 		},
 	}
 }
@@ -41,7 +52,7 @@ func createManagementExportSmartTask(d *schema.ResourceData, m interface{}) erro
 		payload["file-path"] = v.(string)
 	}
 
-	ExportSmartTaskRes, err := client.ApiCall("export-smart-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ExportSmartTaskRes, err := apiCall(client, "export-smart-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -56,6 +67,9 @@ func createManagementExportSmartTask(d *schema.ResourceData, m interface{}) erro
 	}
 
 	d.SetId("export-smart-task-" + acctest.RandString(10))
+	if err := downloadCommandArtifact(d, client, exportSmartTask, d.Get("file_path").(string)); err != nil {
+		return err
+	}
 	return readManagementExportSmartTask(d, m)
 }
 
@@ -59,7 +59,7 @@ func createManagementGaiaApi(d *schema.ResourceData, m interface{}) error {
 
 	commandName := "gaia-api/" + d.Get("command_name").(string)
 
-	GaiaApiRes, err := client.ApiCall(commandName, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	GaiaApiRes, err := apiCall(client, commandName, payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
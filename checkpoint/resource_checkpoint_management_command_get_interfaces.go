@@ -76,7 +76,7 @@ func createManagementGetInterfaces(d *schema.ResourceData, m interface{}) error
 		payload["with-topology"] = v.(bool)
 	}
 
-	GetInterfacesRes, _ := client.ApiCall("get-interfaces", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	GetInterfacesRes, _ := apiCall(client, "get-interfaces", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !GetInterfacesRes.Success {
 		return fmt.Errorf("%s", GetInterfacesRes.ErrorMsg)
 	}
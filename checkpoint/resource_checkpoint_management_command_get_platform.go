@@ -36,7 +36,7 @@ func createManagementGetPlatform(d *schema.ResourceData, m interface{}) error {
 		payload["name"] = v.(string)
 	}
 
-	GetPlatformRes, _ := client.ApiCall("get-platform", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	GetPlatformRes, _ := apiCall(client, "get-platform", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !GetPlatformRes.Success {
 		return fmt.Errorf("%s", GetPlatformRes.ErrorMsg)
 	}
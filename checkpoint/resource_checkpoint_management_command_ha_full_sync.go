@@ -47,7 +47,7 @@ func createManagementHaFullSync(d *schema.ResourceData, m interface{}) error {
 		payload["uid"] = v.(string)
 	}
 
-	HaFullSyncRes, _ := client.ApiCall("ha-full-sync", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	HaFullSyncRes, _ := apiCall(client, "ha-full-sync", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !HaFullSyncRes.Success {
 		return fmt.Errorf("%s", HaFullSyncRes.ErrorMsg)
 	}
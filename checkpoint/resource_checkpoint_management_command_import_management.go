@@ -148,7 +148,7 @@ func createManagementImportManagement(d *schema.ResourceData, m interface{}) err
 		payload["ignore-warnings"] = v.(bool)
 	}
 
-	ImportManagementRes, err := client.ApiCall("import-management", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ImportManagementRes, err := apiCall(client, "import-management", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
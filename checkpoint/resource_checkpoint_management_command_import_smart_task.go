@@ -36,7 +36,7 @@ func createManagementImportSmartTask(d *schema.ResourceData, m interface{}) erro
 		payload["file-path"] = v.(string)
 	}
 
-	ImportSmartTaskRes, err := client.ApiCall("import-smart-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ImportSmartTaskRes, err := apiCall(client, "import-smart-task", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
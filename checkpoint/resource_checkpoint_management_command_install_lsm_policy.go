@@ -40,7 +40,7 @@ func createManagementInstallLsmPolicy(d *schema.ResourceData, m interface{}) err
 		payload["targets"] = v.(*schema.Set).List()
 	}
 
-	InstallLsmPolicyRes, _ := client.ApiCall("install-lsm-policy", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	InstallLsmPolicyRes, _ := apiCall(client, "install-lsm-policy", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !InstallLsmPolicyRes.Success {
 		return fmt.Errorf("%s", InstallLsmPolicyRes.ErrorMsg)
 	}
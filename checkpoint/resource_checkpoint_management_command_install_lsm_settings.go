@@ -44,7 +44,7 @@ func readManagementInstallLsmSettings(d *schema.ResourceData, m interface{}) err
 		payload["targets"] = v.(*schema.Set).List()
 	}
 
-	InstallLsmSettingsRes, _ := client.ApiCall("install-lsm-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	InstallLsmSettingsRes, _ := apiCall(client, "install-lsm-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !InstallLsmSettingsRes.Success {
 		return fmt.Errorf("%s", InstallLsmSettingsRes.ErrorMsg)
 	}
@@ -1,7 +1,11 @@
 package checkpoint
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"time"
+
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +16,9 @@ func resourceManagementInstallPolicy() *schema.Resource {
 		Create: createManagementInstallPolicy,
 		Read:   readManagementInstallPolicy,
 		Delete: deleteManagementInstallPolicy,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"policy_package": {
 				Type:        schema.TypeString,
@@ -79,6 +86,12 @@ func resourceManagementInstallPolicy() *schema.Resource {
 				Computed:    true,
 				Description: "Command asynchronous task unique identifier.",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Install policy task status.",
+			},
+			"targets_status": installTargetsStatusSchema(),
 			"triggers": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -92,6 +105,45 @@ func resourceManagementInstallPolicy() *schema.Resource {
 	}
 }
 
+// installTargetsStatusSchema is the install-policy status per installation target.
+func installTargetsStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Install policy status per installation target.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Installation target name.",
+				},
+				"target_uid": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Installation target unique identifier.",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Install policy status of the target.",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Install policy status description of the target.",
+				},
+				"messages": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Install policy messages of the target.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
 func createManagementInstallPolicy(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 
@@ -125,13 +177,37 @@ func createManagementInstallPolicy(d *schema.ResourceData, m interface{}) error
 		payload["ignore-warnings"] = v.(bool)
 	}
 
-	installPolicyRes, _ := client.ApiCall("install-policy", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	installPolicyRes, err := apiCall(client, "install-policy", payload, client.GetSessionID(), false, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf("failed to install policy: %s", err)
+	}
 	if !installPolicyRes.Success {
 		return fmt.Errorf("%s", installPolicyRes.ErrorMsg)
 	}
 
+	taskRes, err := HandleTaskCreate(context.Background(), client, "install-policy", installPolicyRes, true, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("install-policy task polling failed: %s", err)
+	}
+
 	d.SetId("install-policy-" + acctest.RandString(10))
-	_ = d.Set("task_id", resolveTaskId(installPolicyRes.GetData()))
+	_ = d.Set("task_id", taskRes.TaskID)
+	_ = d.Set("status", taskRes.Status)
+
+	targetsStatus := make([]interface{}, 0)
+	if taskRes.Completed {
+		if taskData, err := showTaskFull(client, taskRes.TaskID); err == nil {
+			targetsStatus = taskTargetsStatus(taskData)
+		} else {
+			log.Printf("[WARN] failed to read install-policy task details: %s", err)
+		}
+	}
+	_ = d.Set("targets_status", targetsStatus)
+
+	if !taskRes.IsSuccess() {
+		return fmt.Errorf("%s", taskFailureMessage("install-policy", taskRes, targetsStatus))
+	}
+
 	return readManagementInstallPolicy(d, m)
 }
 
@@ -109,7 +109,7 @@ func createManagementInstallSoftwarePackage(d *schema.ResourceData, m interface{
 		payload["concurrency-limit"] = v.(int)
 	}
 
-	InstallSoftwarePackageRes, _ := client.ApiCall("install-software-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	InstallSoftwarePackageRes, _ := apiCall(client, "install-software-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !InstallSoftwarePackageRes.Success {
 		return fmt.Errorf("%s", InstallSoftwarePackageRes.ErrorMsg)
 	}
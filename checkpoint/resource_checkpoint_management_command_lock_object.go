@@ -59,7 +59,7 @@ func createManagementLockObject(d *schema.ResourceData, m interface{}) error {
 		payload["layer"] = v.(string)
 	}
 
-	LockObjectRes, err := client.ApiCall("lock-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	LockObjectRes, err := apiCall(client, "lock-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
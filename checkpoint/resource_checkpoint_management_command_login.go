@@ -114,7 +114,7 @@ func createManagementLogin(d *schema.ResourceData, m interface{}) error {
 		payload["session-timeout"] = v.(int)
 	}
 
-	loginRes, _ := client.ApiCall("login", payload, "", true, client.IsProxyUsed())
+	loginRes, _ := apiCall(client, "login", payload, "", true, client.IsProxyUsed())
 	if !loginRes.Success {
 		return fmt.Errorf("%s", loginRes.ErrorMsg)
 	}
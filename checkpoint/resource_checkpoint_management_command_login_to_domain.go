@@ -135,7 +135,7 @@ func createManagementLoginToDomain(d *schema.ResourceData, m interface{}) error
 		payload["read-only"] = v.(bool)
 	}
 
-	LoginToDomainRes, err := client.ApiCall("login-to-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	LoginToDomainRes, err := apiCall(client, "login-to-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
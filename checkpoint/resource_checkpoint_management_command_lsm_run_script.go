@@ -60,7 +60,7 @@ func createManagementLsmRunScript(d *schema.ResourceData, m interface{}) error {
 		payload["targets"] = v.(*schema.Set).List()
 	}
 
-	LsmRunScriptRes, _ := client.ApiCall("lsm-run-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	LsmRunScriptRes, _ := apiCall(client, "lsm-run-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !LsmRunScriptRes.Success {
 		return fmt.Errorf("%s", LsmRunScriptRes.ErrorMsg)
 	}
@@ -1,7 +1,10 @@
 package checkpoint
 
 import (
+	"context"
 	"fmt"
+	"time"
+
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +15,10 @@ func resourceManagementPublish() *schema.Resource {
 		Create: createManagementPublish,
 		Read:   readManagementPublish,
 		Delete: deleteManagementPublish,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"uid": {
 				Type:        schema.TypeString,
@@ -24,6 +31,11 @@ func resourceManagementPublish() *schema.Resource {
 				Computed:    true,
 				Description: "Command asynchronous task unique identifier.",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Publish task status.",
+			},
 			"triggers": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -52,13 +64,34 @@ func createManagementPublish(d *schema.ResourceData, m interface{}) error {
 		payload["uid"] = v.(string)
 	}
 
-	publishRes, _ := client.ApiCall("publish", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if d.Id() != "" {
+		// publish on destroy
+		timeout = d.Timeout(schema.TimeoutDelete)
+	}
+
+	publishRes, err := apiCall(client, "publish", payload, client.GetSessionID(), false, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf("failed to publish: %s", err)
+	}
 	if !publishRes.Success {
 		return fmt.Errorf("%s", publishRes.ErrorMsg)
 	}
 
-	d.SetId("publish-" + acctest.RandString(10))
-	_ = d.Set("task_id", resolveTaskId(publishRes.GetData()))
+	taskRes, err := HandleTaskCreate(context.Background(), client, "publish", publishRes, true, timeout)
+	if err != nil {
+		return fmt.Errorf("publish task polling failed: %s", err)
+	}
+
+	if d.Id() == "" {
+		d.SetId("publish-" + acctest.RandString(10))
+	}
+	_ = d.Set("task_id", taskRes.TaskID)
+	_ = d.Set("status", taskRes.Status)
+
+	if !taskRes.IsSuccess() {
+		return fmt.Errorf("%s", taskFailureMessage("publish", taskRes, nil))
+	}
 
 	return readManagementPublish(d, m)
 }
@@ -70,7 +103,9 @@ func readManagementPublish(d *schema.ResourceData, m interface{}) error {
 func deleteManagementPublish(d *schema.ResourceData, m interface{}) error {
 	if runPublish, ok := d.GetOkExists("run_publish_on_destroy"); ok {
 		if runPublish.(bool) {
-			_ = createManagementPublish(d, m)
+			if err := createManagementPublish(d, m); err != nil {
+				return err
+			}
 		}
 	}
 	d.SetId("")
@@ -82,7 +82,7 @@ func createManagementPutFile(d *schema.ResourceData, m interface{}) error {
 		payload["comments"] = v.(string)
 	}
 
-	PutFileRes, _ := client.ApiCall("put-file", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	PutFileRes, _ := apiCall(client, "put-file", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !PutFileRes.Success {
 		return fmt.Errorf("%s", PutFileRes.ErrorMsg)
 	}
@@ -48,7 +48,7 @@ func createManagementRenewScaledSharingServerCertificate(d *schema.ResourceData,
 		return fmt.Errorf("name or uid must be specified")
 	}
 
-	RenewScaledSharingServerCertificateRes, err := client.ApiCall("renew-scaled-sharing-server-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	RenewScaledSharingServerCertificateRes, err := apiCall(client, "renew-scaled-sharing-server-certificate", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
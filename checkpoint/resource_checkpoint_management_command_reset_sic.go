@@ -43,7 +43,7 @@ func createManagementResetSic(d *schema.ResourceData, m interface{}) error {
 		payload["name"] = v.(string)
 	}
 
-	ResetSicRes, _ := client.ApiCall("reset-sic", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	ResetSicRes, _ := apiCall(client, "reset-sic", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !ResetSicRes.Success {
 		return fmt.Errorf("%s", ResetSicRes.ErrorMsg)
 	}
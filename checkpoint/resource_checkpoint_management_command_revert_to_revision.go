@@ -2,6 +2,10 @@ package checkpoint
 
 import (
 	"fmt"
+	"log"
+	"sort"
+	"strings"
+
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +23,34 @@ func resourceManagementRevertToRevision() *schema.Resource {
 				ForceNew:    true,
 				Description: "Session unique identifier. Specify the session  id you would like to revert your database to.",
 			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Revert even when verify-revert reports conflicts or affected installed policies, or its details don't have them.",
+			},
+			"verify_task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "verify-revert asynchronous task unique identifier.",
+			},
+			"conflicts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Conflicts reported by verify-revert.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"affected_installed_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Installed policies verify-revert reported the revert affects.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"task_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -36,7 +68,28 @@ func createManagementRevertToRevision(d *schema.ResourceData, m interface{}) err
 		payload["to-session"] = v.(string)
 	}
 
-	RevertToRevisionRes, _ := client.ApiCall("revert-to-revision", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	verifyTaskId, conflicts, policies, verified, err := verifyRevert(client, payload)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("verify_task_id", verifyTaskId)
+	_ = d.Set("conflicts", conflicts)
+	_ = d.Set("affected_installed_policies", policies)
+	if !verified {
+		if !d.Get("force").(bool) {
+			return fmt.Errorf("verify-revert task %s details don't have the conflicts and the affected installed policies, set force to revert anyway", verifyTaskId)
+		}
+		log.Printf("[WARN] revert forced although the verify-revert task %s details don't have the conflicts and the affected installed policies", verifyTaskId)
+	}
+	if len(conflicts) > 0 || len(policies) > 0 {
+		if !d.Get("force").(bool) {
+			return fmt.Errorf("verify-revert reported that the revert isn't safe, set force to revert anyway.\nConflicts: %s\nAffected installed policies: %s",
+				strings.Join(conflicts, ", "), strings.Join(policies, ", "))
+		}
+		log.Printf("[WARN] revert forced although verify-revert reported conflicts %v and affected installed policies %v", conflicts, policies)
+	}
+
+	RevertToRevisionRes, _ := apiCall(client, "revert-to-revision", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !RevertToRevisionRes.Success {
 		return fmt.Errorf("%s", RevertToRevisionRes.ErrorMsg)
 	}
@@ -55,3 +108,80 @@ func deleteManagementRevertToRevision(d *schema.ResourceData, m interface{}) err
 	d.SetId("")
 	return nil
 }
+
+// verifyRevert runs verify-revert and returns its task id, the conflicts and the affected installed
+// policies it reports, and whether its details have them.
+func verifyRevert(client *checkpoint.ApiClient, payload map[string]interface{}) (string, []string, []string, bool, error) {
+	verifyRevertRes, err := apiCall(client, "verify-revert", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return "", nil, nil, false, fmt.Errorf("failed to verify revert: %s", err)
+	}
+	if !verifyRevertRes.Success {
+		return "", nil, nil, false, fmt.Errorf("failed to verify revert: %s", verifyRevertRes.ErrorMsg)
+	}
+
+	details := []interface{}{verifyRevertRes.GetData()}
+	taskId, _ := resolveTaskId(verifyRevertRes.GetData()).(string)
+	if taskId != "" {
+		taskData, err := showTaskFull(client, taskId)
+		if err != nil {
+			return "", nil, nil, false, fmt.Errorf("failed to read verify-revert task %s: %s", taskId, err)
+		}
+		details = nil
+		if tasks, ok := taskData["tasks"].([]interface{}); ok && len(tasks) > 0 {
+			if task, ok := tasks[0].(map[string]interface{}); ok {
+				details, _ = task["task-details"].([]interface{})
+			}
+		}
+	}
+	conflicts, policies, verified := revertBlockers(details)
+	return taskId, conflicts, policies, verified, nil
+}
+
+// revertBlockers returns the "conflicts" and the "affected-installed-policies" of the verify-revert
+// details entries, and whether an entry has them. Details without them can't tell whether the revert
+// is safe.
+func revertBlockers(details []interface{}) ([]string, []string, bool) {
+	conflicts, policies := map[string]bool{}, map[string]bool{}
+	verified := false
+	for _, entry := range details {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, set := range map[string]map[string]bool{"conflicts": conflicts, "affected-installed-policies": policies} {
+			list, ok := entryMap[key].([]interface{})
+			if !ok {
+				continue
+			}
+			verified = true
+			for _, item := range list {
+				set[revertItemName(item)] = true
+			}
+		}
+	}
+	return revertSortedNames(conflicts), revertSortedNames(policies), verified
+}
+
+// revertItemName describes a conflict or a policy of verify-revert by its name or message.
+func revertItemName(item interface{}) string {
+	itemMap, ok := item.(map[string]interface{})
+	if !ok {
+		return toString(item)
+	}
+	for _, k := range []string{"name", "message", "uid"} {
+		if v := getString(itemMap, k); v != "" {
+			return v
+		}
+	}
+	return toString(item)
+}
+
+func revertSortedNames(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+package checkpoint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testVerifyRevertConflicts = []interface{}{map[string]interface{}{
+	"conflicts": []interface{}{map[string]interface{}{"uid": "h1", "name": "web", "type": "host"}},
+	"affected-installed-policies": []interface{}{
+		map[string]interface{}{"name": "Standard"},
+	},
+}}
+
+var testVerifyRevertSafe = []interface{}{map[string]interface{}{
+	"conflicts":                   []interface{}{},
+	"affected-installed-policies": []interface{}{},
+}}
+
+func TestRevertToRevisionVerified_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["verify-revert"] = testVerifyRevertSafe
+	server.results["revert-to-revision"] = nil
+
+	state := testFakeApply(t, provider, "checkpoint_management_revert_to_revision", nil, map[string]interface{}{"to_session": "s1"})
+	if state.Attributes["verify_task_id"] == "" || state.Attributes["task_id"] == "" {
+		t.Errorf("expected verify-revert and revert-to-revision tasks, got %v", state.Attributes)
+	}
+}
+
+func TestRevertToRevisionConflicts_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["verify-revert"] = testVerifyRevertConflicts
+
+	// revert-to-revision isn't known to the server, so the revert fails if it's attempted
+	_, diags := testFakeApplyDiags(t, provider, "checkpoint_management_revert_to_revision", nil, map[string]interface{}{"to_session": "s1"})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "set force") || !strings.Contains(diags[0].Summary, "Standard") {
+		t.Fatalf("expected the revert to be refused, got %v", diags)
+	}
+
+	server.results["revert-to-revision"] = nil
+	state := testFakeApply(t, provider, "checkpoint_management_revert_to_revision", nil, map[string]interface{}{"to_session": "s1", "force": true})
+	if state.Attributes["conflicts.0"] != "web" || state.Attributes["affected_installed_policies.0"] != "Standard" {
+		t.Errorf("expected the conflicts and the affected policies, got %v", state.Attributes)
+	}
+}
+
+func TestRevertToRevisionUnverified_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["verify-revert"] = []interface{}{map[string]interface{}{"statusCode": "succeeded"}}
+
+	_, diags := testFakeApplyDiags(t, provider, "checkpoint_management_revert_to_revision", nil, map[string]interface{}{"to_session": "s1"})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "don't have the conflicts") {
+		t.Fatalf("expected the revert to be refused when verify-revert details are unknown, got %v", diags)
+	}
+
+	server.results["revert-to-revision"] = nil
+	state := testFakeApply(t, provider, "checkpoint_management_revert_to_revision", nil, map[string]interface{}{"to_session": "s1", "force": true})
+	if state.Attributes["task_id"] == "" {
+		t.Errorf("expected the forced revert to run, got %v", state.Attributes)
+	}
+}
+
+func TestDataSourceManagementRevisions_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	now := time.Now()
+	for i, publisher := range []string{"admin", "pipeline", "pipeline"} {
+		uid := fmt.Sprintf("rev%d", i+1)
+		server.addRevision(uid, publisher, now.Add(-time.Duration(3-i)*time.Hour))
+		server.details[uid]["changes"] = i + 1
+	}
+	server.addSessionInfo("open", "run", "pipeline", now)
+	ds := provider.DataSourcesMap["checkpoint_management_revisions"]
+
+	d := ds.TestResourceData()
+	_ = d.Set("publisher", "pipeline")
+	_ = d.Set("limit", 1)
+	if err := ds.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	revisions := d.Get("revisions").([]interface{})
+	if len(revisions) != 1 {
+		t.Fatalf("expected the latest revision of the publisher, got %v", revisions)
+	}
+	revision := revisions[0].(map[string]interface{})
+	if revision["uid"] != "rev3" || revision["changes"] != 3 || revision["publisher"] != "pipeline" || revision["publish_time"] == "" {
+		t.Errorf("expected the latest revision, got %v", revision)
+	}
+}
@@ -27,7 +27,7 @@ func createManagementRunAppControlUpdate(d *schema.ResourceData, m interface{})
 
 	var payload = map[string]interface{}{}
 
-	RunAppControlUpdateRes, err := client.ApiCallSimple("run-app-control-update", payload)
+	RunAppControlUpdateRes, err := apiCallSimple(client, "run-app-control-update", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
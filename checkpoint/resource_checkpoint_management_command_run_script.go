@@ -1,8 +1,12 @@
 package checkpoint
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode/utf8"
+
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,6 +57,13 @@ func resourceManagementRunScript() *schema.Resource {
 				ForceNew:    true,
 				Description: "Script timeout in seconds.",
 			},
+			"fail_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Fail when the script failed or exited with a non-zero exit code on any target. The resource is kept in the state with the output of each target.",
+			},
 			"tasks": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -66,6 +77,45 @@ func resourceManagementRunScript() *schema.Resource {
 				Computed:    true,
 				Description: "Response message in JSON format",
 			},
+			"targets_output": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Script output per target.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target name.",
+						},
+						"target_uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target unique identifier.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Script status on the target.",
+						},
+						"exit_code": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Script exit code on the target.",
+						},
+						"stdout": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Script standard output on the target.",
+						},
+						"stderr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Script standard error on the target.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -98,7 +148,7 @@ func createManagementRunScript(d *schema.ResourceData, m interface{}) error {
 		payload["timeout"] = v
 	}
 
-	runScriptRes, err := client.ApiCall("run-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	runScriptRes, err := apiCall(client, "run-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -112,7 +162,7 @@ func createManagementRunScript(d *schema.ResourceData, m interface{}) error {
 	var showTaskPayload = map[string]interface{}{}
 	showTaskPayload["task-id"] = taskIds
 	showTaskPayload["details-level"] = "full"
-	showTaskRes, err := client.ApiCallSimple("show-task", showTaskPayload)
+	showTaskRes, err := apiCallSimple(client, "show-task", showTaskPayload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -128,6 +178,14 @@ func createManagementRunScript(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId("run-script-" + acctest.RandString(10))
+	targetsOutput := runScriptTargetsOutput(showTaskRes.GetData())
+	_ = d.Set("targets_output", targetsOutput)
+
+	if d.Get("fail_on_error").(bool) {
+		if msg := runScriptFailures(targetsOutput); msg != "" {
+			return fmt.Errorf("run-script failed:%s", msg)
+		}
+	}
 
 	return readManagementRunScript(d, m)
 }
@@ -140,3 +198,72 @@ func deleteManagementRunScript(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// runScriptTargetsOutput extracts the output of each target from the run-script tasks. Each target
+// runs the script in its own task.
+func runScriptTargetsOutput(data map[string]interface{}) []interface{} {
+	targets := make([]interface{}, 0)
+	tasks, _ := normalizeData(data)["tasks"].([]interface{})
+	for _, task := range tasks {
+		taskMap, ok := task.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		details, _ := taskMap["task-details"].([]interface{})
+		for _, detail := range details {
+			detailMap, ok := detail.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			status := strings.ToLower(getString(detailMap, "statusCode"))
+			if status == "" {
+				status = strings.ToLower(getString(taskMap, "status"))
+			}
+			// The exit code is reported by newer servers only. Otherwise the status tells whether the script succeeded.
+			exitCode := 0
+			if v, ok := detailMap["exitCode"].(float64); ok {
+				exitCode = int(v)
+			} else if status != "succeeded" {
+				exitCode = 1
+			}
+			targets = append(targets, map[string]interface{}{
+				"target_name": getString(detailMap, "gatewayName"),
+				"target_uid":  getString(detailMap, "gatewayId"),
+				"status":      status,
+				"exit_code":   exitCode,
+				"stdout":      decodeScriptOutput(getString(detailMap, "responseMessage")),
+				"stderr":      decodeScriptOutput(getString(detailMap, "responseError")),
+			})
+		}
+	}
+	return targets
+}
+
+// runScriptFailures lists the targets on which the script failed, or returns "" when it succeeded on all targets.
+func runScriptFailures(targets []interface{}) string {
+	msg := ""
+	for _, target := range targets {
+		targetMap := target.(map[string]interface{})
+		if targetMap["exit_code"].(int) == 0 && targetMap["status"] == "succeeded" {
+			continue
+		}
+		name := targetMap["target_name"].(string)
+		if name == "" {
+			name = targetMap["target_uid"].(string)
+		}
+		msg += fmt.Sprintf("\n%s: %s, exit code %d", name, targetMap["status"], targetMap["exit_code"])
+		if stderr := strings.TrimSpace(targetMap["stderr"].(string)); stderr != "" {
+			msg += "\n  " + stderr
+		}
+	}
+	return msg
+}
+
+// decodeScriptOutput decodes the base64 encoded script output. Output which isn't base64 encoded text is returned as is.
+func decodeScriptOutput(output string) string {
+	decoded, err := base64.StdEncoding.DecodeString(output)
+	if err != nil || !utf8.Valid(decoded) {
+		return output
+	}
+	return string(decoded)
+}
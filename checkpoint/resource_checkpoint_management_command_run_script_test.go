@@ -0,0 +1,42 @@
+package checkpoint
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestCheckpointManagementRunScript_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	server.results["run-script"] = []interface{}{
+		map[string]interface{}{
+			"gatewayName":     "gw1",
+			"gatewayId":       "u-gw1",
+			"statusCode":      "failed",
+			"responseMessage": base64.StdEncoding.EncodeToString([]byte("checking\n")),
+			"responseError":   base64.StdEncoding.EncodeToString([]byte("service is down\n")),
+		},
+	}
+
+	state, diags := testFakeApplyDiags(t, provider, "checkpoint_management_run_script", nil, map[string]interface{}{
+		"script_name":   "health check",
+		"script":        "check.sh",
+		"targets":       []interface{}{"gw1"},
+		"fail_on_error": true,
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "gw1: failed, exit code 1") || !strings.Contains(diags[0].Summary, "service is down") {
+		t.Fatalf("expected the script to fail on gw1, got %v", diags)
+	}
+	if state == nil || state.Attributes["targets_output.0.stdout"] != "checking\n" || state.Attributes["targets_output.0.target_uid"] != "u-gw1" {
+		t.Errorf("expected the decoded output in the state, got %v", state)
+	}
+}
+
+func TestDecodeScriptOutput(t *testing.T) {
+	if out := decodeScriptOutput(base64.StdEncoding.EncodeToString([]byte("ok"))); out != "ok" {
+		t.Errorf("expected decoded output, got %q", out)
+	}
+	if out := decodeScriptOutput("not base64!"); out != "not base64!" {
+		t.Errorf("expected plain output to be kept, got %q", out)
+	}
+}
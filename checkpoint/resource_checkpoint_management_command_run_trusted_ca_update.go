@@ -36,7 +36,7 @@ func createManagementRunTrustedCaUpdate(d *schema.ResourceData, m interface{}) e
 		payload["package-path"] = v.(string)
 	}
 
-	RunTrustedCaUpdateRes, _ := client.ApiCall("run-trusted-ca-update", payload, client.GetSessionID(), true, false)
+	RunTrustedCaUpdateRes, _ := apiCall(client, "run-trusted-ca-update", payload, client.GetSessionID(), true, false)
 	if !RunTrustedCaUpdateRes.Success {
 		return fmt.Errorf("%s", RunTrustedCaUpdateRes.ErrorMsg)
 	}
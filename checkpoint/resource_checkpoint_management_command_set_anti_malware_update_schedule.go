@@ -134,7 +134,7 @@ func createManagementSetAntiMalwareUpdateSchedule(d *schema.ResourceData, m inte
 		}
 	}
 
-	SetAntiMalwareUpdateScheduleRes, err := client.ApiCallSimple("set-anti-malware-update-schedule", payload)
+	SetAntiMalwareUpdateScheduleRes, err := apiCallSimple(client, "set-anti-malware-update-schedule", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
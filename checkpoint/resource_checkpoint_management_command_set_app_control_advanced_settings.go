@@ -214,7 +214,7 @@ func createManagementSetAppControlAdvancedSettings(d *schema.ResourceData, m int
 		payload["domain-level-permission"] = v.(bool)
 	}
 
-	SetAppControlAdvancedSettingsRes, _ := client.ApiCall("set-app-control-advanced-settings", payload, client.GetSessionID(), true, false)
+	SetAppControlAdvancedSettingsRes, _ := apiCall(client, "set-app-control-advanced-settings", payload, client.GetSessionID(), true, false)
 	if !SetAppControlAdvancedSettingsRes.Success {
 		return fmt.Errorf("%s", SetAppControlAdvancedSettingsRes.ErrorMsg)
 	}
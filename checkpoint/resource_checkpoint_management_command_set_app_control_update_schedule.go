@@ -262,7 +262,7 @@ func createManagementSetAppControlUpdateSchedule(d *schema.ResourceData, m inter
 		}
 	}
 
-	SetAppControlUpdateScheduleRes, err := client.ApiCallSimple("set-app-control-update-schedule", payload)
+	SetAppControlUpdateScheduleRes, err := apiCallSimple(client, "set-app-control-update-schedule", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -130,7 +130,7 @@ func createManagementSetAutomaticPurge(d *schema.ResourceData, m interface{}) er
 		}
 	}
 
-	SetAutomaticPurgeRes, _ := client.ApiCall("set-automatic-purge", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetAutomaticPurgeRes, _ := apiCall(client, "set-automatic-purge", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetAutomaticPurgeRes.Success {
 		return fmt.Errorf("%s", SetAutomaticPurgeRes.ErrorMsg)
 	}
@@ -68,7 +68,7 @@ func createManagementSetContentAwarenessAdvancedSettings(d *schema.ResourceData,
 		payload["inspect-archives"] = v.(bool)
 	}
 
-	SetContentAwarenessAdvancedSettingsRes, _ := client.ApiCall("set-content-awareness-advanced-settings", payload, client.GetSessionID(), true, false)
+	SetContentAwarenessAdvancedSettingsRes, _ := apiCall(client, "set-content-awareness-advanced-settings", payload, client.GetSessionID(), true, false)
 	if !SetContentAwarenessAdvancedSettingsRes.Success {
 		return fmt.Errorf("%s", SetContentAwarenessAdvancedSettingsRes.ErrorMsg)
 	}
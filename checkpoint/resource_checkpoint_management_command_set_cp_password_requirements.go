@@ -37,7 +37,7 @@ func createManagementSetCpPasswordRequirements(d *schema.ResourceData, m interfa
 		payload["min-password-length"] = v.(int)
 	}
 
-	SetCpPasswordRequirementsRes, err := client.ApiCall("set-cp-password-requirements", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetCpPasswordRequirementsRes, err := apiCall(client, "set-cp-password-requirements", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
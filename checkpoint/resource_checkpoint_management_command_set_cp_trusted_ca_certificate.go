@@ -52,7 +52,7 @@ func createManagementSetCpTrustedCaCertificate(d *schema.ResourceData, m interfa
 		payload["status"] = v.(string)
 	}
 
-	SetCpTrustedCaCertificateRes, _ := client.ApiCall("set-cp-trusted-ca-certificate", payload, client.GetSessionID(), true, false)
+	SetCpTrustedCaCertificateRes, _ := apiCall(client, "set-cp-trusted-ca-certificate", payload, client.GetSessionID(), true, false)
 	if !SetCpTrustedCaCertificateRes.Success {
 		return fmt.Errorf("%s", SetCpTrustedCaCertificateRes.ErrorMsg)
 	}
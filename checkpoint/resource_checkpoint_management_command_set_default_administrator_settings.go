@@ -112,7 +112,7 @@ func createManagementSetDefaultAdministratorSettings(d *schema.ResourceData, m i
 		payload["days-to-notify-expiration-to-admin"] = v.(int)
 	}
 
-	SetDefaultAdministratorSettingsRes, err := client.ApiCall("set-default-administrator-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetDefaultAdministratorSettingsRes, err := apiCall(client, "set-default-administrator-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -46,7 +46,7 @@ func createManagementSetGatewayGlobalUse(d *schema.ResourceData, m interface{})
 		payload["target"] = v.(string)
 	}
 
-	SetGatewayGlobalUseRes, _ := client.ApiCall("set-gateway-global-use", payload, client.GetSessionID(), true, false)
+	SetGatewayGlobalUseRes, _ := apiCall(client, "set-gateway-global-use", payload, client.GetSessionID(), true, false)
 	if !SetGatewayGlobalUseRes.Success {
 		return fmt.Errorf("%s", SetGatewayGlobalUseRes.ErrorMsg)
 	}
@@ -135,7 +135,7 @@ func createManagementSetGlobalDomain(d *schema.ResourceData, m interface{}) erro
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetGlobalDomainRes, _ := client.ApiCall("set-global-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetGlobalDomainRes, _ := apiCall(client, "set-global-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetGlobalDomainRes.Success {
 		return fmt.Errorf("%s", SetGlobalDomainRes.ErrorMsg)
 	}
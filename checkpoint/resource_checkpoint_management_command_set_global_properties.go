@@ -3246,7 +3246,7 @@ func readManagementSetGlobalProperties(d *schema.ResourceData, m interface{}) er
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetGlobalPropertiesRes, _ := client.ApiCall("set-global-properties", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetGlobalPropertiesRes, _ := apiCall(client, "set-global-properties", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetGlobalPropertiesRes.Success {
 		return fmt.Errorf("%s", SetGlobalPropertiesRes.ErrorMsg)
 	}
@@ -278,7 +278,7 @@ func createManagementSetHttpsAdvancedSettings(d *schema.ResourceData, m interfac
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetHttpsAdvancedSettingsRes, _ := client.ApiCall("set-https-advanced-settings", payload, client.GetSessionID(), true, false)
+	SetHttpsAdvancedSettingsRes, _ := apiCall(client, "set-https-advanced-settings", payload, client.GetSessionID(), true, false)
 	if !SetHttpsAdvancedSettingsRes.Success {
 		return fmt.Errorf("%s", SetHttpsAdvancedSettingsRes.ErrorMsg)
 	}
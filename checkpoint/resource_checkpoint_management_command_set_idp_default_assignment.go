@@ -57,7 +57,7 @@ func createManagementSetIdpDefaultAssignment(d *schema.ResourceData, m interface
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetIdpDefaultAssignmentRes, _ := client.ApiCall("set-idp-default-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetIdpDefaultAssignmentRes, _ := apiCall(client, "set-idp-default-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetIdpDefaultAssignmentRes.Success {
 		return fmt.Errorf("%s", SetIdpDefaultAssignmentRes.ErrorMsg)
 	}
@@ -77,7 +77,7 @@ func createManagementSetIdpToDomainAssignment(d *schema.ResourceData, m interfac
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetIdpToDomainAssignmentRes, _ := client.ApiCall("set-idp-to-domain-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetIdpToDomainAssignmentRes, _ := apiCall(client, "set-idp-to-domain-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetIdpToDomainAssignmentRes.Success {
 		return fmt.Errorf("%s", SetIdpToDomainAssignmentRes.ErrorMsg)
 	}
@@ -155,7 +155,7 @@ func createManagementSetInternalTrustedCa(d *schema.ResourceData, m interface{})
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetInternalTrustedCaRes, _ := client.ApiCall("set-internal-trusted-ca", payload, client.GetSessionID(), true, false)
+	SetInternalTrustedCaRes, _ := apiCall(client, "set-internal-trusted-ca", payload, client.GetSessionID(), true, false)
 	if !SetInternalTrustedCaRes.Success {
 		return fmt.Errorf("%s", SetInternalTrustedCaRes.ErrorMsg)
 	}
@@ -111,7 +111,7 @@ func createManagementSetIpsUpdateSchedule(d *schema.ResourceData, m interface{})
 		}
 	}
 
-	SetIpsUpdateScheduleRes, _ := client.ApiCall("set-ips-update-schedule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetIpsUpdateScheduleRes, _ := apiCall(client, "set-ips-update-schedule", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetIpsUpdateScheduleRes.Success {
 		return fmt.Errorf("%s", SetIpsUpdateScheduleRes.ErrorMsg)
 	}
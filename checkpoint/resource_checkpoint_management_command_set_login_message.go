@@ -61,7 +61,7 @@ func createManagementSetLoginMessage(d *schema.ResourceData, m interface{}) erro
 		payload["warning"] = v.(bool)
 	}
 
-	SetLoginMessageRes, _ := client.ApiCall("set-login-message", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetLoginMessageRes, _ := apiCall(client, "set-login-message", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetLoginMessageRes.Success {
 		return fmt.Errorf("%s", SetLoginMessageRes.ErrorMsg)
 	}
@@ -72,7 +72,7 @@ func createManagementSetLoginRestrictions(d *schema.ResourceData, m interface{})
 		payload["display-access-denied-message"] = v.(bool)
 	}
 
-	SetLoginRestrictionsRes, err := client.ApiCall("set-login-restrictions", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetLoginRestrictionsRes, err := apiCall(client, "set-login-restrictions", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
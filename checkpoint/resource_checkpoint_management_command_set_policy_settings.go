@@ -103,7 +103,7 @@ func createManagementSetPolicySettings(d *schema.ResourceData, m interface{}) er
 		}
 	}
 
-	SetPolicySettingsRes, _ := client.ApiCall("set-policy-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetPolicySettingsRes, _ := apiCall(client, "set-policy-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetPolicySettingsRes.Success {
 		return fmt.Errorf("%s", SetPolicySettingsRes.ErrorMsg)
 	}
@@ -42,7 +42,7 @@ func createManagementSetSmartConsoleIdleTimeout(d *schema.ResourceData, m interf
 		payload["timeout-duration"] = v.(int)
 	}
 
-	SetSmartConsoleIdleTimeoutRes, err := client.ApiCall("set-smart-console-idle-timeout", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetSmartConsoleIdleTimeoutRes, err := apiCall(client, "set-smart-console-idle-timeout", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -31,7 +31,7 @@ func createManagementSetSyncWithUserCenter(d *schema.ResourceData, m interface{}
 		payload["enabled"] = v.(bool)
 	}
 
-	SetSyncWithUserCenterRes, err := client.ApiCallSimple("set-sync-with-user-center", payload)
+	SetSyncWithUserCenterRes, err := apiCallSimple(client, "set-sync-with-user-center", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
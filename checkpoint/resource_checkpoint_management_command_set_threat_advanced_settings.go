@@ -166,7 +166,7 @@ func createManagementSetThreatAdvancedSettings(d *schema.ResourceData, m interfa
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetThreatAdvancedSettingsRes, _ := client.ApiCall("set-threat-advanced-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetThreatAdvancedSettingsRes, _ := apiCall(client, "set-threat-advanced-settings", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetThreatAdvancedSettingsRes.Success {
 		return fmt.Errorf("%s", SetThreatAdvancedSettingsRes.ErrorMsg)
 	}
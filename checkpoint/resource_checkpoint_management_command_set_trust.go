@@ -120,7 +120,7 @@ func createManagementSetTrust(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	SetTrustRes, err := client.ApiCall("set-trust", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetTrustRes, err := apiCall(client, "set-trust", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
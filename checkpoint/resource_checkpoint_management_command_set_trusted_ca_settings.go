@@ -32,7 +32,7 @@ func createManagementSetTrustedCaSettings(d *schema.ResourceData, m interface{})
 		payload["automatic-update"] = v.(bool)
 	}
 
-	SetTrustedCaSettingsRes, _ := client.ApiCall("set-trusted-ca-settings", payload, client.GetSessionID(), true, false)
+	SetTrustedCaSettingsRes, _ := apiCall(client, "set-trusted-ca-settings", payload, client.GetSessionID(), true, false)
 	if !SetTrustedCaSettingsRes.Success {
 		return fmt.Errorf("%s", SetTrustedCaSettingsRes.ErrorMsg)
 	}
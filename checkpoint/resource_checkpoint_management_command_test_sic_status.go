@@ -57,7 +57,7 @@ func readManagementTestSicStatus(d *schema.ResourceData, m interface{}) error {
 		payload["name"] = v.(string)
 	}
 
-	TestSicStatusRes, _ := client.ApiCall("test-sic-status", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	TestSicStatusRes, _ := apiCall(client, "test-sic-status", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !TestSicStatusRes.Success {
 		return fmt.Errorf("%s", TestSicStatusRes.ErrorMsg)
 	}
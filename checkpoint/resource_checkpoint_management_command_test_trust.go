@@ -53,7 +53,7 @@ func createManagementTestTrust(d *schema.ResourceData, m interface{}) error {
 		payload["trust-method"] = v.(string)
 	}
 
-	TestTrustRes, err := client.ApiCall("test-trust", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	TestTrustRes, err := apiCall(client, "test-trust", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -109,7 +109,7 @@ func createManagementUninstallSoftwarePackage(d *schema.ResourceData, m interfac
 		payload["concurrency-limit"] = v.(int)
 	}
 
-	UninstallSoftwarePackageRes, _ := client.ApiCall("uninstall-software-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	UninstallSoftwarePackageRes, _ := apiCall(client, "uninstall-software-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !UninstallSoftwarePackageRes.Success {
 		return fmt.Errorf("%s", UninstallSoftwarePackageRes.ErrorMsg)
 	}
@@ -58,7 +58,7 @@ func createManagementUnlockObject(d *schema.ResourceData, m interface{}) error {
 		payload["layer"] = v.(string)
 	}
 
-	UnlockObjectRes, err := client.ApiCall("unlock-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	UnlockObjectRes, err := apiCall(client, "unlock-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -40,7 +40,7 @@ func createManagementUpdateProvisionedSatellites(d *schema.ResourceData, m inter
 		payload["vpn-center-gateways"] = v.(*schema.Set).List()
 	}
 
-	UpdateProvisionedSatellitesRes, _ := client.ApiCall("update-provisioned-satellites", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	UpdateProvisionedSatellitesRes, _ := apiCall(client, "update-provisioned-satellites", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !UpdateProvisionedSatellitesRes.Success {
 		return fmt.Errorf("%s", UpdateProvisionedSatellitesRes.ErrorMsg)
 	}
@@ -1511,7 +1511,7 @@ func createManagementVsxProvisioningTool(d *schema.ResourceData, m interface{})
 		payload["set-vd-params"] = res
 	}
 
-	vsxProvisioningToolRes, err := client.ApiCall("vsx-provisioning-tool", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	vsxProvisioningToolRes, err := apiCall(client, "vsx-provisioning-tool", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	log.Println("vsx-provisioning-tool result is ", vsxProvisioningToolRes)
 
@@ -353,7 +353,7 @@ func createManagementVsxRunOperation(d *schema.ResourceData, m interface{}) erro
 		}
 	}
 
-	VsxRunOperationRes, _ := client.ApiCall("vsx-run-operation", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	VsxRunOperationRes, _ := apiCall(client, "vsx-run-operation", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !VsxRunOperationRes.Success {
 		return fmt.Errorf("%s", VsxRunOperationRes.ErrorMsg)
 	}
@@ -246,7 +246,7 @@ func createManagementDataCenterObject(d *schema.ResourceData, m interface{}) err
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	AddDataCenterObjectRes, err := client.ApiCall("add-data-center-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	AddDataCenterObjectRes, err := apiCall(client, "add-data-center-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -267,7 +267,7 @@ func createManagementDataCenterObject(d *schema.ResourceData, m interface{}) err
 			const maxRetry = 30
 			retry := 1
 			for retry < maxRetry {
-				showDataCenterContentRes, _ := client.ApiCallSimple("show-data-center-content", showDataCenterContentPayload)
+				showDataCenterContentRes, _ := apiCallSimple(client, "show-data-center-content", showDataCenterContentPayload)
 				if showDataCenterContentRes.Success {
 					showDataCenterContentData := showDataCenterContentRes.GetData()
 					if len(showDataCenterContentData["objects"].([]interface{})) > 0 {
@@ -297,7 +297,7 @@ func readManagementDataCenterObject(d *schema.ResourceData, m interface{}) error
 		"details-level": "full",
 	}
 
-	showDataCenterObjRes, err := client.ApiCall("show-data-center-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDataCenterObjRes, err := apiCall(client, "show-data-center-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -514,7 +514,7 @@ func updateManagementDataCenterObject(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update Data Center - Map = ", dataCenter)
 
-	updateDataCenterRes, err := client.ApiCall("set-data-center-object", dataCenter, client.GetSessionID(), true, false)
+	updateDataCenterRes, err := apiCall(client, "set-data-center-object", dataCenter, client.GetSessionID(), true, false)
 	if err != nil || !updateDataCenterRes.Success {
 		if updateDataCenterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataCenterRes.ErrorMsg)
@@ -534,7 +534,7 @@ func deleteManagementDataCenterObject(d *schema.ResourceData, m interface{}) err
 	}
 
 	log.Println("Delete Data Center")
-	deleteLsmClusterRes, err := client.ApiCall("delete-data-center-object", dataCenterPayload, client.GetSessionID(), true, false)
+	deleteLsmClusterRes, err := apiCall(client, "delete-data-center-object", dataCenterPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteLsmClusterRes.Success {
 		if deleteLsmClusterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLsmClusterRes.ErrorMsg)
@@ -158,7 +158,7 @@ func createManagementDataCenterQuery(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Create DataCenterQuery - Map = ", dataCenterQuery)
 
-	addDataCenterQueryRes, err := client.ApiCall("add-data-center-query", dataCenterQuery, client.GetSessionID(), true, client.IsProxyUsed())
+	addDataCenterQueryRes, err := apiCall(client, "add-data-center-query", dataCenterQuery, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDataCenterQueryRes.Success {
 		if addDataCenterQueryRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataCenterQueryRes.ErrorMsg)
@@ -179,7 +179,7 @@ func readManagementDataCenterQuery(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showDataCenterQueryRes, err := client.ApiCall("show-data-center-query", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDataCenterQueryRes, err := apiCall(client, "show-data-center-query", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -368,7 +368,7 @@ func updateManagementDataCenterQuery(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Update DataCenterQuery - Map = ", dataCenterQuery)
 
-	updateDataCenterQueryRes, err := client.ApiCall("set-data-center-query", dataCenterQuery, client.GetSessionID(), true, client.IsProxyUsed())
+	updateDataCenterQueryRes, err := apiCall(client, "set-data-center-query", dataCenterQuery, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDataCenterQueryRes.Success {
 		if updateDataCenterQueryRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataCenterQueryRes.ErrorMsg)
@@ -397,7 +397,7 @@ func deleteManagementDataCenterQuery(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Delete DataCenterQuery")
 
-	deleteDataCenterQueryRes, err := client.ApiCall("delete-data-center-query", dataCenterQueryPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteDataCenterQueryRes, err := apiCall(client, "delete-data-center-query", dataCenterQueryPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteDataCenterQueryRes.Success {
 		if deleteDataCenterQueryRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataCenterQueryRes.ErrorMsg)
@@ -118,7 +118,7 @@ func createManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{}
 
 	log.Println("Create DataTypeCompoundGroup - Map = ", dataTypeCompoundGroup)
 
-	addDataTypeCompoundGroupRes, err := client.ApiCall("add-data-type-compound-group", dataTypeCompoundGroup, client.GetSessionID(), true, false)
+	addDataTypeCompoundGroupRes, err := apiCall(client, "add-data-type-compound-group", dataTypeCompoundGroup, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeCompoundGroupRes.Success {
 		if addDataTypeCompoundGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypeCompoundGroupRes.ErrorMsg)
@@ -139,7 +139,7 @@ func readManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showDataTypeCompoundGroupRes, err := client.ApiCall("show-data-type-compound-group", payload, client.GetSessionID(), true, false)
+	showDataTypeCompoundGroupRes, err := apiCall(client, "show-data-type-compound-group", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -293,7 +293,7 @@ func updateManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{}
 
 	log.Println("Update DataTypeCompoundGroup - Map = ", dataTypeCompoundGroup)
 
-	updateDataTypeCompoundGroupRes, err := client.ApiCall("set-data-type-compound-group", dataTypeCompoundGroup, client.GetSessionID(), true, false)
+	updateDataTypeCompoundGroupRes, err := apiCall(client, "set-data-type-compound-group", dataTypeCompoundGroup, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeCompoundGroupRes.Success {
 		if updateDataTypeCompoundGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypeCompoundGroupRes.ErrorMsg)
@@ -314,7 +314,7 @@ func deleteManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{}
 
 	log.Println("Delete DataTypeCompoundGroup")
 
-	deleteDataTypeCompoundGroupRes, err := client.ApiCall("delete-data-type-compound-group", dataTypeCompoundGroupPayload, client.GetSessionID(), true, false)
+	deleteDataTypeCompoundGroupRes, err := apiCall(client, "delete-data-type-compound-group", dataTypeCompoundGroupPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypeCompoundGroupRes.Success {
 		if deleteDataTypeCompoundGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypeCompoundGroupRes.ErrorMsg)
@@ -154,7 +154,7 @@ func createManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{
 
 	log.Println("Create DataTypeFileAttributes - Map = ", dataTypeFileAttributes)
 
-	addDataTypeFileAttributesRes, err := client.ApiCall("add-data-type-file-attributes", dataTypeFileAttributes, client.GetSessionID(), true, false)
+	addDataTypeFileAttributesRes, err := apiCall(client, "add-data-type-file-attributes", dataTypeFileAttributes, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeFileAttributesRes.Success {
 		if addDataTypeFileAttributesRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypeFileAttributesRes.ErrorMsg)
@@ -175,7 +175,7 @@ func readManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showDataTypeFileAttributesRes, err := client.ApiCall("show-data-type-file-attributes", payload, client.GetSessionID(), true, false)
+	showDataTypeFileAttributesRes, err := apiCall(client, "show-data-type-file-attributes", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -343,7 +343,7 @@ func updateManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{
 
 	log.Println("Update DataTypeFileAttributes - Map = ", dataTypeFileAttributes)
 
-	updateDataTypeFileAttributesRes, err := client.ApiCall("set-data-type-file-attributes", dataTypeFileAttributes, client.GetSessionID(), true, false)
+	updateDataTypeFileAttributesRes, err := apiCall(client, "set-data-type-file-attributes", dataTypeFileAttributes, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeFileAttributesRes.Success {
 		if updateDataTypeFileAttributesRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypeFileAttributesRes.ErrorMsg)
@@ -364,7 +364,7 @@ func deleteManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{
 
 	log.Println("Delete DataTypeFileAttributes")
 
-	deleteDataTypeFileAttributesRes, err := client.ApiCall("delete-data-type-file-attributes", dataTypeFileAttributesPayload, client.GetSessionID(), true, false)
+	deleteDataTypeFileAttributesRes, err := apiCall(client, "delete-data-type-file-attributes", dataTypeFileAttributesPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypeFileAttributesRes.Success {
 		if deleteDataTypeFileAttributesRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypeFileAttributesRes.ErrorMsg)
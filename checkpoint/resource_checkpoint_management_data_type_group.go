@@ -118,7 +118,7 @@ func createManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create DataTypeGroup - Map = ", dataTypeGroup)
 
-	addDataTypeGroupRes, err := client.ApiCall("add-data-type-group", dataTypeGroup, client.GetSessionID(), true, false)
+	addDataTypeGroupRes, err := apiCall(client, "add-data-type-group", dataTypeGroup, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeGroupRes.Success {
 		if addDataTypeGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypeGroupRes.ErrorMsg)
@@ -139,7 +139,7 @@ func readManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showDataTypeGroupRes, err := client.ApiCall("show-data-type-group", payload, client.GetSessionID(), true, false)
+	showDataTypeGroupRes, err := apiCall(client, "show-data-type-group", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -293,7 +293,7 @@ func updateManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update DataTypeGroup - Map = ", dataTypeGroup)
 
-	updateDataTypeGroupRes, err := client.ApiCall("set-data-type-group", dataTypeGroup, client.GetSessionID(), true, false)
+	updateDataTypeGroupRes, err := apiCall(client, "set-data-type-group", dataTypeGroup, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeGroupRes.Success {
 		if updateDataTypeGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypeGroupRes.ErrorMsg)
@@ -314,7 +314,7 @@ func deleteManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete DataTypeGroup")
 
-	deleteDataTypeGroupRes, err := client.ApiCall("delete-data-type-group", dataTypeGroupPayload, client.GetSessionID(), true, false)
+	deleteDataTypeGroupRes, err := apiCall(client, "delete-data-type-group", dataTypeGroupPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypeGroupRes.Success {
 		if deleteDataTypeGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypeGroupRes.ErrorMsg)
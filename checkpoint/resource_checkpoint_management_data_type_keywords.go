@@ -126,7 +126,7 @@ func createManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create DataTypeKeywords - Map = ", dataTypeKeywords)
 
-	addDataTypeKeywordsRes, err := client.ApiCall("add-data-type-keywords", dataTypeKeywords, client.GetSessionID(), true, false)
+	addDataTypeKeywordsRes, err := apiCall(client, "add-data-type-keywords", dataTypeKeywords, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeKeywordsRes.Success {
 		if addDataTypeKeywordsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypeKeywordsRes.ErrorMsg)
@@ -147,7 +147,7 @@ func readManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showDataTypeKeywordsRes, err := client.ApiCall("show-data-type-keywords", payload, client.GetSessionID(), true, false)
+	showDataTypeKeywordsRes, err := apiCall(client, "show-data-type-keywords", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -282,7 +282,7 @@ func updateManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update DataTypeKeywords - Map = ", dataTypeKeywords)
 
-	updateDataTypeKeywordsRes, err := client.ApiCall("set-data-type-keywords", dataTypeKeywords, client.GetSessionID(), true, false)
+	updateDataTypeKeywordsRes, err := apiCall(client, "set-data-type-keywords", dataTypeKeywords, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeKeywordsRes.Success {
 		if updateDataTypeKeywordsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypeKeywordsRes.ErrorMsg)
@@ -303,7 +303,7 @@ func deleteManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete DataTypeKeywords")
 
-	deleteDataTypeKeywordsRes, err := client.ApiCall("delete-data-type-keywords", dataTypeKeywordsPayload, client.GetSessionID(), true, false)
+	deleteDataTypeKeywordsRes, err := apiCall(client, "delete-data-type-keywords", dataTypeKeywordsPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypeKeywordsRes.Success {
 		if deleteDataTypeKeywordsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypeKeywordsRes.ErrorMsg)
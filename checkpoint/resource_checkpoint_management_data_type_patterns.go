@@ -116,7 +116,7 @@ func createManagementDataTypePatterns(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create DataTypePatterns - Map = ", dataTypePatterns)
 
-	addDataTypePatternsRes, err := client.ApiCall("add-data-type-patterns", dataTypePatterns, client.GetSessionID(), true, false)
+	addDataTypePatternsRes, err := apiCall(client, "add-data-type-patterns", dataTypePatterns, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypePatternsRes.Success {
 		if addDataTypePatternsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypePatternsRes.ErrorMsg)
@@ -137,7 +137,7 @@ func readManagementDataTypePatterns(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showDataTypePatternsRes, err := client.ApiCall("show-data-type-patterns", payload, client.GetSessionID(), true, false)
+	showDataTypePatternsRes, err := apiCall(client, "show-data-type-patterns", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -264,7 +264,7 @@ func updateManagementDataTypePatterns(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update DataTypePatterns - Map = ", dataTypePatterns)
 
-	updateDataTypePatternsRes, err := client.ApiCall("set-data-type-patterns", dataTypePatterns, client.GetSessionID(), true, false)
+	updateDataTypePatternsRes, err := apiCall(client, "set-data-type-patterns", dataTypePatterns, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypePatternsRes.Success {
 		if updateDataTypePatternsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypePatternsRes.ErrorMsg)
@@ -285,7 +285,7 @@ func deleteManagementDataTypePatterns(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete DataTypePatterns")
 
-	deleteDataTypePatternsRes, err := client.ApiCall("delete-data-type-patterns", dataTypePatternsPayload, client.GetSessionID(), true, false)
+	deleteDataTypePatternsRes, err := apiCall(client, "delete-data-type-patterns", dataTypePatternsPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypePatternsRes.Success {
 		if deleteDataTypePatternsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypePatternsRes.ErrorMsg)
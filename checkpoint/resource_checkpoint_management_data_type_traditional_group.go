@@ -105,7 +105,7 @@ func createManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interfac
 
 	log.Println("Create DataTypeTraditionalGroup - Map = ", dataTypeTraditionalGroup)
 
-	addDataTypeTraditionalGroupRes, err := client.ApiCall("add-data-type-traditional-group", dataTypeTraditionalGroup, client.GetSessionID(), true, false)
+	addDataTypeTraditionalGroupRes, err := apiCall(client, "add-data-type-traditional-group", dataTypeTraditionalGroup, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeTraditionalGroupRes.Success {
 		if addDataTypeTraditionalGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypeTraditionalGroupRes.ErrorMsg)
@@ -126,7 +126,7 @@ func readManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interface{
 		"uid": d.Id(),
 	}
 
-	showDataTypeTraditionalGroupRes, err := client.ApiCall("show-data-type-traditional-group", payload, client.GetSessionID(), true, false)
+	showDataTypeTraditionalGroupRes, err := apiCall(client, "show-data-type-traditional-group", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -251,7 +251,7 @@ func updateManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interfac
 	}
 	log.Println("Update DataTypeTraditionalGroup - Map = ", dataTypeTraditionalGroup)
 
-	updateDataTypeTraditionalGroupRes, err := client.ApiCall("set-data-type-traditional-group", dataTypeTraditionalGroup, client.GetSessionID(), true, false)
+	updateDataTypeTraditionalGroupRes, err := apiCall(client, "set-data-type-traditional-group", dataTypeTraditionalGroup, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeTraditionalGroupRes.Success {
 		if updateDataTypeTraditionalGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypeTraditionalGroupRes.ErrorMsg)
@@ -272,7 +272,7 @@ func deleteManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interfac
 
 	log.Println("Delete DataTypeTraditionalGroup")
 
-	deleteDataTypeTraditionalGroupRes, err := client.ApiCall("delete-data-type-traditional-group", dataTypeTraditionalGroupPayload, client.GetSessionID(), true, false)
+	deleteDataTypeTraditionalGroupRes, err := apiCall(client, "delete-data-type-traditional-group", dataTypeTraditionalGroupPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypeTraditionalGroupRes.Success {
 		if deleteDataTypeTraditionalGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypeTraditionalGroupRes.ErrorMsg)
@@ -167,7 +167,7 @@ func createManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interfac
 
 	log.Println("Create DataTypeWeightedKeywords - Map = ", dataTypeWeightedKeywords)
 
-	addDataTypeWeightedKeywordsRes, err := client.ApiCall("add-data-type-weighted-keywords", dataTypeWeightedKeywords, client.GetSessionID(), true, false)
+	addDataTypeWeightedKeywordsRes, err := apiCall(client, "add-data-type-weighted-keywords", dataTypeWeightedKeywords, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeWeightedKeywordsRes.Success {
 		if addDataTypeWeightedKeywordsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDataTypeWeightedKeywordsRes.ErrorMsg)
@@ -188,7 +188,7 @@ func readManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interface{
 		"uid": d.Id(),
 	}
 
-	showDataTypeWeightedKeywordsRes, err := client.ApiCall("show-data-type-weighted-keywords", payload, client.GetSessionID(), true, false)
+	showDataTypeWeightedKeywordsRes, err := apiCall(client, "show-data-type-weighted-keywords", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -374,7 +374,7 @@ func updateManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interfac
 
 	log.Println("Update DataTypeWeightedKeywords - Map = ", dataTypeWeightedKeywords)
 
-	updateDataTypeWeightedKeywordsRes, err := client.ApiCall("set-data-type-weighted-keywords", dataTypeWeightedKeywords, client.GetSessionID(), true, false)
+	updateDataTypeWeightedKeywordsRes, err := apiCall(client, "set-data-type-weighted-keywords", dataTypeWeightedKeywords, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeWeightedKeywordsRes.Success {
 		if updateDataTypeWeightedKeywordsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDataTypeWeightedKeywordsRes.ErrorMsg)
@@ -395,7 +395,7 @@ func deleteManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interfac
 
 	log.Println("Delete DataTypeWeightedKeywords")
 
-	deleteDataTypeWeightedKeywordsRes, err := client.ApiCall("delete-data-type-weighted-keywords", dataTypeWeightedKeywordsPayload, client.GetSessionID(), true, false)
+	deleteDataTypeWeightedKeywordsRes, err := apiCall(client, "delete-data-type-weighted-keywords", dataTypeWeightedKeywordsPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteDataTypeWeightedKeywordsRes.Success {
 		if deleteDataTypeWeightedKeywordsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDataTypeWeightedKeywordsRes.ErrorMsg)
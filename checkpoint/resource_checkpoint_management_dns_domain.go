@@ -97,7 +97,7 @@ func createManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create DnsDomain - Map = ", dnsDomain)
 
-	addDnsDomainRes, err := client.ApiCall("add-dns-domain", dnsDomain, client.GetSessionID(), true, client.IsProxyUsed())
+	addDnsDomainRes, err := apiCall(client, "add-dns-domain", dnsDomain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDnsDomainRes.Success {
 		if addDnsDomainRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDnsDomainRes.ErrorMsg)
@@ -118,7 +118,7 @@ func readManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showDnsDomainRes, err := client.ApiCall("show-dns-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDnsDomainRes, err := apiCall(client, "show-dns-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -222,7 +222,7 @@ func updateManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update DnsDomain - Map = ", dnsDomain)
 
-	updateDnsDomainRes, err := client.ApiCall("set-dns-domain", dnsDomain, client.GetSessionID(), true, client.IsProxyUsed())
+	updateDnsDomainRes, err := apiCall(client, "set-dns-domain", dnsDomain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDnsDomainRes.Success {
 		if updateDnsDomainRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDnsDomainRes.ErrorMsg)
@@ -251,7 +251,7 @@ func deleteManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete DnsDomain")
 
-	deleteDnsDomainRes, err := client.ApiCall("delete-dns-domain", dnsDomainPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteDnsDomainRes, err := apiCall(client, "delete-dns-domain", dnsDomainPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteDnsDomainRes.Success {
 		if deleteDnsDomainRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDnsDomainRes.ErrorMsg)
@@ -156,7 +156,7 @@ func createManagementDomain(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Domain - Map = ", domain)
 
-	addDomainRes, err := client.ApiCall("add-domain", domain, client.GetSessionID(), true, client.IsProxyUsed())
+	addDomainRes, err := apiCall(client, "add-domain", domain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -169,7 +169,7 @@ func createManagementDomain(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// add-simple-cluster returns task-id. Call show-simple-cluster for object uid.
-	showDomainRes, err := client.ApiCall("show-domain", map[string]interface{}{"name": d.Get("name")}, client.GetSessionID(), true, client.IsProxyUsed())
+	showDomainRes, err := apiCall(client, "show-domain", map[string]interface{}{"name": d.Get("name")}, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -190,7 +190,7 @@ func readManagementDomain(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showDomainRes, err := client.ApiCall("show-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDomainRes, err := apiCall(client, "show-domain", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -335,7 +335,7 @@ func updateManagementDomain(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Domain - Map = ", domain)
 
-	updateDomainRes, err := client.ApiCall("set-domain", domain, client.GetSessionID(), true, client.IsProxyUsed())
+	updateDomainRes, err := apiCall(client, "set-domain", domain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDomainRes.Success {
 		if updateDomainRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDomainRes.ErrorMsg)
@@ -364,7 +364,7 @@ func deleteManagementDomain(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete Domain")
 
-	deleteDomainRes, err := client.ApiCall("delete-domain", domainPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteDomainRes, err := apiCall(client, "delete-domain", domainPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteDomainRes.Success {
 		if deleteDomainRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDomainRes.ErrorMsg)
@@ -867,7 +867,7 @@ func createManagementDomainPermissionsProfile(d *schema.ResourceData, m interfac
 
 	log.Println("Create DomainPermissionsProfile - Map = ", domainPermissionsProfile)
 
-	addDomainPermissionsProfileRes, err := client.ApiCall("add-domain-permissions-profile", domainPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	addDomainPermissionsProfileRes, err := apiCall(client, "add-domain-permissions-profile", domainPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDomainPermissionsProfileRes.Success {
 		if addDomainPermissionsProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDomainPermissionsProfileRes.ErrorMsg)
@@ -888,7 +888,7 @@ func readManagementDomainPermissionsProfile(d *schema.ResourceData, m interface{
 		"uid": d.Id(),
 	}
 
-	showDomainPermissionsProfileRes, err := client.ApiCall("show-domain-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDomainPermissionsProfileRes, err := apiCall(client, "show-domain-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1653,7 +1653,7 @@ func updateManagementDomainPermissionsProfile(d *schema.ResourceData, m interfac
 
 	log.Println("Update DomainPermissionsProfile - Map = ", domainPermissionsProfile)
 
-	updateDomainPermissionsProfileRes, err := client.ApiCall("set-domain-permissions-profile", domainPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	updateDomainPermissionsProfileRes, err := apiCall(client, "set-domain-permissions-profile", domainPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDomainPermissionsProfileRes.Success {
 		if updateDomainPermissionsProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDomainPermissionsProfileRes.ErrorMsg)
@@ -1682,7 +1682,7 @@ func deleteManagementDomainPermissionsProfile(d *schema.ResourceData, m interfac
 
 	log.Println("Delete DomainPermissionsProfile")
 
-	deleteDomainPermissionsProfileRes, err := client.ApiCall("delete-domain-permissions-profile", domainPermissionsProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteDomainPermissionsProfileRes, err := apiCall(client, "delete-domain-permissions-profile", domainPermissionsProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteDomainPermissionsProfileRes.Success {
 		if deleteDomainPermissionsProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDomainPermissionsProfileRes.ErrorMsg)
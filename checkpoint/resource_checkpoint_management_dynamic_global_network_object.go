@@ -85,7 +85,7 @@ func createManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interf
 
 	log.Println("Create DynamicGlobalNetworkObject - Map = ", dynamicGlobalNetworkObject)
 
-	addDynamicGlobalNetworkObjectRes, err := client.ApiCall("add-dynamic-global-network-object", dynamicGlobalNetworkObject, client.GetSessionID(), true, client.IsProxyUsed())
+	addDynamicGlobalNetworkObjectRes, err := apiCall(client, "add-dynamic-global-network-object", dynamicGlobalNetworkObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDynamicGlobalNetworkObjectRes.Success {
 		if addDynamicGlobalNetworkObjectRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDynamicGlobalNetworkObjectRes.ErrorMsg)
@@ -106,7 +106,7 @@ func readManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interfac
 		"uid": d.Id(),
 	}
 
-	showDynamicGlobalNetworkObjectRes, err := client.ApiCall("show-dynamic-global-network-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDynamicGlobalNetworkObjectRes, err := apiCall(client, "show-dynamic-global-network-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -202,7 +202,7 @@ func updateManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interf
 
 	log.Println("Update DynamicGlobalNetworkObject - Map = ", dynamicGlobalNetworkObject)
 
-	updateDynamicGlobalNetworkObjectRes, err := client.ApiCall("set-dynamic-global-network-object", dynamicGlobalNetworkObject, client.GetSessionID(), true, client.IsProxyUsed())
+	updateDynamicGlobalNetworkObjectRes, err := apiCall(client, "set-dynamic-global-network-object", dynamicGlobalNetworkObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDynamicGlobalNetworkObjectRes.Success {
 		if updateDynamicGlobalNetworkObjectRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDynamicGlobalNetworkObjectRes.ErrorMsg)
@@ -229,7 +229,7 @@ func deleteManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interf
 	}
 	log.Println("Delete DynamicGlobalNetworkObject")
 
-	deleteDynamicGlobalNetworkObjectRes, err := client.ApiCall("delete-dynamic-global-network-object", dynamicGlobalNetworkObjectPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteDynamicGlobalNetworkObjectRes, err := apiCall(client, "delete-dynamic-global-network-object", dynamicGlobalNetworkObjectPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteDynamicGlobalNetworkObjectRes.Success {
 		if deleteDynamicGlobalNetworkObjectRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDynamicGlobalNetworkObjectRes.ErrorMsg)
@@ -88,7 +88,7 @@ func createManagementDynamicObject(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create DynamicObject - Map = ", dynamicObject)
 
-	addDynamicObjectRes, err := client.ApiCall("add-dynamic-object", dynamicObject, client.GetSessionID(), true, client.IsProxyUsed())
+	addDynamicObjectRes, err := apiCall(client, "add-dynamic-object", dynamicObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDynamicObjectRes.Success {
 		if addDynamicObjectRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addDynamicObjectRes.ErrorMsg)
@@ -109,7 +109,7 @@ func readManagementDynamicObject(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showDynamicObjectRes, err := client.ApiCall("show-dynamic-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showDynamicObjectRes, err := apiCall(client, "show-dynamic-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -205,7 +205,7 @@ func updateManagementDynamicObject(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update DynamicObject - Map = ", dynamicObject)
 
-	updateDynamicObjectRes, err := client.ApiCall("set-dynamic-object", dynamicObject, client.GetSessionID(), true, client.IsProxyUsed())
+	updateDynamicObjectRes, err := apiCall(client, "set-dynamic-object", dynamicObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDynamicObjectRes.Success {
 		if updateDynamicObjectRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateDynamicObjectRes.ErrorMsg)
@@ -232,7 +232,7 @@ func deleteManagementDynamicObject(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Delete DynamicObject")
 
-	deleteDynamicObjectRes, err := client.ApiCall("delete-dynamic-object", dynamicObjectPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteDynamicObjectRes, err := apiCall(client, "delete-dynamic-object", dynamicObjectPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteDynamicObjectRes.Success {
 		if deleteDynamicObjectRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteDynamicObjectRes.ErrorMsg)
@@ -208,7 +208,7 @@ func createManagementExceptionGroup(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create ExceptionGroup - Map = ", exceptionGroup)
 
-	addExceptionGroupRes, err := client.ApiCall("add-exception-group", exceptionGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	addExceptionGroupRes, err := apiCall(client, "add-exception-group", exceptionGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addExceptionGroupRes.Success {
 		if addExceptionGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addExceptionGroupRes.ErrorMsg)
@@ -229,7 +229,7 @@ func readManagementExceptionGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showExceptionGroupRes, err := client.ApiCall("show-exception-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showExceptionGroupRes, err := apiCall(client, "show-exception-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -415,7 +415,7 @@ func updateManagementExceptionGroup(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update ExceptionGroup - Map = ", exceptionGroup)
 
-	updateExceptionGroupRes, err := client.ApiCall("set-exception-group", exceptionGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	updateExceptionGroupRes, err := apiCall(client, "set-exception-group", exceptionGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateExceptionGroupRes.Success {
 		if updateExceptionGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateExceptionGroupRes.ErrorMsg)
@@ -444,7 +444,7 @@ func deleteManagementExceptionGroup(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete ExceptionGroup")
 
-	deleteExceptionGroupRes, err := client.ApiCall("delete-exception-group", exceptionGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteExceptionGroupRes, err := apiCall(client, "delete-exception-group", exceptionGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteExceptionGroupRes.Success {
 		if deleteExceptionGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteExceptionGroupRes.ErrorMsg)
@@ -146,7 +146,7 @@ func createManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Create ExternalTrustedCa - Map = ", externalTrustedCa)
 
-	addExternalTrustedCaRes, err := client.ApiCall("add-external-trusted-ca", externalTrustedCa, client.GetSessionID(), true, false)
+	addExternalTrustedCaRes, err := apiCall(client, "add-external-trusted-ca", externalTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !addExternalTrustedCaRes.Success {
 		if addExternalTrustedCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addExternalTrustedCaRes.ErrorMsg)
@@ -167,7 +167,7 @@ func readManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) erro
 		"uid": d.Id(),
 	}
 
-	showExternalTrustedCaRes, err := client.ApiCall("show-external-trusted-ca", payload, client.GetSessionID(), true, false)
+	showExternalTrustedCaRes, err := apiCall(client, "show-external-trusted-ca", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -328,7 +328,7 @@ func updateManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Update ExternalTrustedCa - Map = ", externalTrustedCa)
 
-	updateExternalTrustedCaRes, err := client.ApiCall("set-external-trusted-ca", externalTrustedCa, client.GetSessionID(), true, false)
+	updateExternalTrustedCaRes, err := apiCall(client, "set-external-trusted-ca", externalTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !updateExternalTrustedCaRes.Success {
 		if updateExternalTrustedCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateExternalTrustedCaRes.ErrorMsg)
@@ -349,7 +349,7 @@ func deleteManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Delete ExternalTrustedCa")
 
-	deleteExternalTrustedCaRes, err := client.ApiCall("delete-external-trusted-ca", externalTrustedCaPayload, client.GetSessionID(), true, false)
+	deleteExternalTrustedCaRes, err := apiCall(client, "delete-external-trusted-ca", externalTrustedCaPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteExternalTrustedCaRes.Success {
 		if deleteExternalTrustedCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteExternalTrustedCaRes.ErrorMsg)
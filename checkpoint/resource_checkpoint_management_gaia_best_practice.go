@@ -185,7 +185,7 @@ func createManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create GaiaBestPractice - Map = ", gaiaBestPractice)
 
-	addGaiaBestPracticeRes, err := client.ApiCall("add-gaia-best-practice", gaiaBestPractice, client.GetSessionID(), true, client.IsProxyUsed())
+	addGaiaBestPracticeRes, err := apiCall(client, "add-gaia-best-practice", gaiaBestPractice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGaiaBestPracticeRes.Success {
 		if addGaiaBestPracticeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addGaiaBestPracticeRes.ErrorMsg)
@@ -206,7 +206,7 @@ func readManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showGaiaBestPracticeRes, err := client.ApiCall("show-gaia-best-practice", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGaiaBestPracticeRes, err := apiCall(client, "show-gaia-best-practice", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -383,7 +383,7 @@ func updateManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update GaiaBestPractice - Map = ", gaiaBestPractice)
 
-	updateGaiaBestPracticeRes, err := client.ApiCall("set-gaia-best-practice", gaiaBestPractice, client.GetSessionID(), true, client.IsProxyUsed())
+	updateGaiaBestPracticeRes, err := apiCall(client, "set-gaia-best-practice", gaiaBestPractice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateGaiaBestPracticeRes.Success {
 		if updateGaiaBestPracticeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateGaiaBestPracticeRes.ErrorMsg)
@@ -412,7 +412,7 @@ func deleteManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete GaiaBestPractice")
 
-	deleteGaiaBestPracticeRes, err := client.ApiCall("delete-gaia-best-practice", gaiaBestPracticePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteGaiaBestPracticeRes, err := apiCall(client, "delete-gaia-best-practice", gaiaBestPracticePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteGaiaBestPracticeRes.Success {
 		if deleteGaiaBestPracticeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteGaiaBestPracticeRes.ErrorMsg)
@@ -110,7 +110,7 @@ func createManagementGcpDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Create gcpDataCenterServer - Map = ", gcpDataCenterServer)
 
-	addGcpDataCenterServerRes, err := client.ApiCall("add-data-center-server", gcpDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addGcpDataCenterServerRes, err := apiCall(client, "add-data-center-server", gcpDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -124,7 +124,7 @@ func createManagementGcpDataCenterServer(d *schema.ResourceData, m interface{})
 	payload := map[string]interface{}{
 		"name": gcpDataCenterServer["name"],
 	}
-	showGcpDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGcpDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -141,7 +141,7 @@ func readManagementGcpDataCenterServer(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showGcpDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGcpDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -257,7 +257,7 @@ func updateManagementGcpDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Update gcpDataCenterServer - Map = ", gcpDataCenterServer)
 
-	updateGcpDataCenterServerRes, err := client.ApiCall("set-data-center-server", gcpDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateGcpDataCenterServerRes, err := apiCall(client, "set-data-center-server", gcpDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -288,7 +288,7 @@ func deleteManagementGcpDataCenterServer(d *schema.ResourceData, m interface{})
 	}
 	log.Println("Delete gcpDataCenterServer")
 
-	deleteGcpDataCenterServerRes, err := client.ApiCall("delete-data-center-server", gcpDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteGcpDataCenterServerRes, err := apiCall(client, "delete-data-center-server", gcpDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteGcpDataCenterServerRes.Success {
 		if deleteGcpDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteGcpDataCenterServerRes.ErrorMsg)
@@ -120,7 +120,7 @@ func createManagementGlobalAssignment(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create GlobalAssignment - Map = ", globalAssignment)
 
-	addGlobalAssignmentRes, err := client.ApiCall("add-global-assignment", globalAssignment, client.GetSessionID(), true, client.IsProxyUsed())
+	addGlobalAssignmentRes, err := apiCall(client, "add-global-assignment", globalAssignment, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGlobalAssignmentRes.Success {
 		if addGlobalAssignmentRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addGlobalAssignmentRes.ErrorMsg)
@@ -141,7 +141,7 @@ func readManagementGlobalAssignment(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showGlobalAssignmentRes, err := client.ApiCall("show-global-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGlobalAssignmentRes, err := apiCall(client, "show-global-assignment", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -236,7 +236,7 @@ func updateManagementGlobalAssignment(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update GlobalAssignment - Map = ", globalAssignment)
 
-	updateGlobalAssignmentRes, err := client.ApiCall("set-global-assignment", globalAssignment, client.GetSessionID(), true, client.IsProxyUsed())
+	updateGlobalAssignmentRes, err := apiCall(client, "set-global-assignment", globalAssignment, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateGlobalAssignmentRes.Success {
 		if updateGlobalAssignmentRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateGlobalAssignmentRes.ErrorMsg)
@@ -257,7 +257,7 @@ func deleteManagementGlobalAssignment(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete GlobalAssignment")
 
-	deleteGlobalAssignmentRes, err := client.ApiCall("delete-global-assignment", globalAssignmentPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteGlobalAssignmentRes, err := apiCall(client, "delete-global-assignment", globalAssignmentPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteGlobalAssignmentRes.Success {
 		if deleteGlobalAssignmentRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteGlobalAssignmentRes.ErrorMsg)
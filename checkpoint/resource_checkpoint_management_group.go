@@ -95,7 +95,7 @@ func createManagementGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Group - Map = ", group)
 
-	addGroupRes, err := client.ApiCall("add-group", group, client.GetSessionID(), true, client.IsProxyUsed())
+	addGroupRes, err := apiCall(client, "add-group", group, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGroupRes.Success {
 		if addGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addGroupRes.ErrorMsg)
@@ -116,7 +116,7 @@ func readManagementGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showGroupRes, err := client.ApiCall("show-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGroupRes, err := apiCall(client, "show-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -221,7 +221,7 @@ func updateManagementGroup(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Group - Map = ", group)
-	setGroupRes, _ := client.ApiCall("set-group", group, client.GetSessionID(), true, client.IsProxyUsed())
+	setGroupRes, _ := apiCall(client, "set-group", group, client.GetSessionID(), true, client.IsProxyUsed())
 	if !setGroupRes.Success {
 		return fmt.Errorf("%s", setGroupRes.ErrorMsg)
 	}
@@ -242,7 +242,7 @@ func deleteManagementGroup(d *schema.ResourceData, m interface{}) error {
 		payload["ignore-warnings"] = v.(bool)
 	}
 
-	deleteGroupRes, _ := client.ApiCall("delete-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteGroupRes, _ := apiCall(client, "delete-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !deleteGroupRes.Success {
 		return fmt.Errorf("%s", deleteGroupRes.ErrorMsg)
 	}
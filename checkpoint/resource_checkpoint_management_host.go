@@ -65,14 +65,16 @@ func resourceManagementHost() *schema.Resource {
 							Description: "IPv6 network address.",
 						},
 						"mask_length4": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "IPv4 network mask length.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "IPv4 network mask length.",
+							ValidateFunc: validateIntRange(0, 32),
 						},
 						"mask_length6": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "IPv6 network mask length.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "IPv6 network mask length.",
+							ValidateFunc: validateIntRange(0, 128),
 						},
 						"ignore_warnings": {
 							Type:        schema.TypeBool,
@@ -87,10 +89,11 @@ func resourceManagementHost() *schema.Resource {
 							Description: "Apply changes ignoring errors. You won't be able to publish such a changes. If ignore-warnings flag was omitted - warnings will also be ignored.",
 						},
 						"color": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "black",
-							Description: "Color of the object. Should be one of existing colors.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "black",
+							Description:  "Color of the object. Should be one of existing colors.",
+							ValidateFunc: validateColor(),
 						},
 						"comments": &schema.Schema{
 							Type:        schema.TypeString,
@@ -125,9 +128,10 @@ func resourceManagementHost() *schema.Resource {
 							Description: "IPv6 address.",
 						},
 						"hide_behind": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Hide behind method. This parameter is not required in case \"method\" parameter is \"static\".",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Hide behind method. This parameter is not required in case \"method\" parameter is \"static\".",
+							ValidateFunc: validateStringValue("gateway", "ip-address"),
 						},
 						"install_on": {
 							Type:        schema.TypeString,
@@ -135,9 +139,10 @@ func resourceManagementHost() *schema.Resource {
 							Description: "Which gateway should apply the NAT translation.",
 						},
 						"method": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "NAT translation method.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "NAT translation method.",
+							ValidateFunc: validateStringValue("hide", "static"),
 						},
 					},
 				},
@@ -223,10 +228,11 @@ func resourceManagementHost() *schema.Resource {
 				Default:     false,
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": &schema.Schema{
 				Type:        schema.TypeString,
@@ -398,15 +404,12 @@ func createManagementHost(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Host - Map = ", host)
 
-	addHostRes, err := client.ApiCall("add-host", host, client.GetSessionID(), true, client.IsProxyUsed())
-	if err != nil || !addHostRes.Success {
-		if addHostRes.ErrorMsg != "" {
-			return fmt.Errorf("%s", addHostRes.ErrorMsg)
-		}
-		return fmt.Errorf("%s", err.Error())
+	uid, err := addObject(client, "host", host)
+	if err != nil {
+		return err
 	}
 
-	d.SetId(addHostRes.GetData()["uid"].(string))
+	d.SetId(uid)
 
 	return readManagementHost(d, m)
 }
@@ -419,7 +422,7 @@ func readManagementHost(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showHostRes, err := client.ApiCall("show-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showHostRes, err := apiCall(client, "show-host", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -576,7 +579,7 @@ func readManagementHost(d *schema.ResourceData, m interface{}) error {
 				payload := map[string]interface{}{
 					"uid": v,
 				}
-				showProtectedByRes, err := client.ApiCall("show-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
+				showProtectedByRes, err := apiCall(client, "show-object", payload, client.GetSessionID(), true, client.IsProxyUsed())
 				if err != nil || !showProtectedByRes.Success {
 					if showProtectedByRes.ErrorMsg != "" {
 						return fmt.Errorf("%s", showProtectedByRes.ErrorMsg)
@@ -810,12 +813,8 @@ func updateManagementHost(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Host - Map = ", host)
 	if len(host) != 3 {
-		updateHostRes, err := client.ApiCall("set-host", host, client.GetSessionID(), true, client.IsProxyUsed())
-		if err != nil || !updateHostRes.Success {
-			if updateHostRes.ErrorMsg != "" {
-				return fmt.Errorf("%s", updateHostRes.ErrorMsg)
-			}
-			return fmt.Errorf("%s", err.Error())
+		if err := setObject(client, "host", host); err != nil {
+			return err
 		}
 	} else {
 		// Payload contain only required fields: uid, ignore-warnings and ignore-errors
@@ -839,12 +838,8 @@ func deleteManagementHost(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		hostPayload["ignore-warnings"] = v.(bool)
 	}
-	deleteHostRes, err := client.ApiCall("delete-host", hostPayload, client.GetSessionID(), true, client.IsProxyUsed())
-	if err != nil || !deleteHostRes.Success {
-		if deleteHostRes.ErrorMsg != "" {
-			return fmt.Errorf("%s", deleteHostRes.ErrorMsg)
-		}
-		return fmt.Errorf("%s", err.Error())
+	if err := deleteObject(client, "host", hostPayload); err != nil {
+		return err
 	}
 	d.SetId("")
 
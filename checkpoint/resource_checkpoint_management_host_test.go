@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"os"
 	"testing"
 )
 
@@ -21,14 +20,12 @@ func TestAccCheckpointManagementHost_basic(t *testing.T) {
 	resourceName := "checkpoint_management_host.test"
 	objName := "tfTestManagementHost_" + acctest.RandString(6)
 
-	context := os.Getenv("CHECKPOINT_CONTEXT")
+	context := testAccContext(t)
 	if context != "web_api" {
 		t.Skip("Skipping management test")
-	} else if context == "" {
-		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
 	}
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckpointManagementHostDestroy,
@@ -124,3 +121,30 @@ resource "checkpoint_management_host" "test" {
 }
 `, name, ipv4address, color)
 }
+
+func TestCheckpointManagementHost_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+
+	state := testFakeApply(t, provider, "checkpoint_management_host", nil, map[string]interface{}{
+		"name":         "host1",
+		"ipv4_address": "192.0.2.1",
+		"color":        "blue",
+	})
+	if state.ID == "" || state.Attributes["ipv4_address"] != "192.0.2.1" || state.Attributes["color"] != "blue" {
+		t.Fatalf("unexpected state after create: %v", state)
+	}
+
+	state = testFakeApply(t, provider, "checkpoint_management_host", state, map[string]interface{}{
+		"name":         "host2",
+		"ipv4_address": "192.0.2.2",
+		"color":        "blue",
+	})
+	if state.Attributes["name"] != "host2" || state.Attributes["ipv4_address"] != "192.0.2.2" {
+		t.Fatalf("unexpected state after update: %v", state)
+	}
+
+	testFakeApply(t, provider, "checkpoint_management_host", state, nil)
+	if len(server.objects) != 0 {
+		t.Fatalf("expected host to be deleted, got %v", server.objects)
+	}
+}
@@ -109,7 +109,7 @@ func createManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create HttpsLayer - Map = ", httpsLayer)
 
-	addHttpsLayerRes, err := client.ApiCall("add-https-layer", httpsLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	addHttpsLayerRes, err := apiCall(client, "add-https-layer", httpsLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addHttpsLayerRes.Success {
 		if addHttpsLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addHttpsLayerRes.ErrorMsg)
@@ -130,7 +130,7 @@ func readManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showHttpsLayerRes, err := client.ApiCall("show-https-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showHttpsLayerRes, err := apiCall(client, "show-https-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -238,7 +238,7 @@ func updateManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update HttpsLayer - Map = ", httpsLayer)
 
-	updateHttpsLayerRes, err := client.ApiCall("set-https-layer", httpsLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateHttpsLayerRes, err := apiCall(client, "set-https-layer", httpsLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateHttpsLayerRes.Success {
 		if updateHttpsLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateHttpsLayerRes.ErrorMsg)
@@ -265,7 +265,7 @@ func deleteManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete HttpsLayer")
 
-	deleteHttpsLayerRes, err := client.ApiCall("delete-https-layer", httpsLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteHttpsLayerRes, err := apiCall(client, "delete-https-layer", httpsLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteHttpsLayerRes.Success {
 		if deleteHttpsLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteHttpsLayerRes.ErrorMsg)
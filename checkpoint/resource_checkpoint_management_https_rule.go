@@ -52,9 +52,10 @@ func resourceManagementHttpsRule() *schema.Resource {
 				},
 			},
 			"action": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Rule inspect level. \"Bypass\" or \"Inspect\".",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Rule inspect level. \"Bypass\" or \"Inspect\".",
+				ValidateFunc: validateStringValueIgnoreCase("Bypass", "Inspect"),
 			},
 			"blade": {
 				Type:        schema.TypeSet,
@@ -111,9 +112,10 @@ func resourceManagementHttpsRule() *schema.Resource {
 				Description: "TRUE if \"negate\" value is set for Source.",
 			},
 			"track": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "\"None\",\"Log\",\"Alert\",\"Mail\",\"SNMP trap\",\"Mail\",\"User Alert\", \"User Alert 2\", \"User Alert 3\".",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "\"None\",\"Log\",\"Alert\",\"Mail\",\"SNMP trap\",\"Mail\",\"User Alert\", \"User Alert 2\", \"User Alert 3\".",
+				ValidateFunc: validateStringValueIgnoreCase(threatTrackValues...),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -141,22 +143,22 @@ func resourceManagementHttpsRule() *schema.Resource {
 						"top": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule on top of specific section identified by uid or name. Select value 'top' for entire rule base.",
 						},
 						"above": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule above specific section/rule identified by uid or name.",
 						},
 						"below": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule below specific section/rule identified by uid or name.",
 						},
 						"bottom": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule in the bottom of specific section identified by uid or name. Select value 'bottom' for entire rule base.",
 						},
 					},
 				},
@@ -251,22 +253,30 @@ func createManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if _, ok := d.GetOk("position"); ok {
-		if _, ok := d.GetOk("position.0.top"); ok {
-			httpsRule["position"] = "top"
+		if v, ok := d.GetOk("position.0.top"); ok {
+			if v.(string) == "top" {
+				httpsRule["position"] = "top" // entire rule-base
+			} else {
+				httpsRule["position"] = map[string]interface{}{"top": v.(string)} // section-name
+			}
 		}
 		if v, ok := d.GetOk("position.0.above"); ok {
 			httpsRule["position"] = map[string]interface{}{"above": v.(string)}
 		}
-		if v, ok := d.GetOk("position.0.bottom"); ok {
-			httpsRule["position"] = map[string]interface{}{"bottom": v.(string)}
+		if v, ok := d.GetOk("position.0.below"); ok {
+			httpsRule["position"] = map[string]interface{}{"below": v.(string)}
 		}
-		if _, ok := d.GetOk("position.0.bottom"); ok {
-			httpsRule["position"] = "bottom"
+		if v, ok := d.GetOk("position.0.bottom"); ok {
+			if v.(string) == "bottom" {
+				httpsRule["position"] = "bottom" // entire rule-base
+			} else {
+				httpsRule["position"] = map[string]interface{}{"bottom": v.(string)} // section-name
+			}
 		}
 	}
 	log.Println("Create HttpsRule - Map = ", httpsRule)
 
-	addHttpsRuleRes, err := client.ApiCall("add-https-rule", httpsRule, client.GetSessionID(), true, client.IsProxyUsed())
+	addHttpsRuleRes, err := apiCall(client, "add-https-rule", httpsRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addHttpsRuleRes.Success {
 		if addHttpsRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addHttpsRuleRes.ErrorMsg)
@@ -288,7 +298,7 @@ func readManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	showHttpsRuleRes, err := client.ApiCall("show-https-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showHttpsRuleRes, err := apiCall(client, "show-https-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -572,8 +582,12 @@ func updateManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 
 	if ok := d.HasChange("position"); ok {
 		if _, ok := d.GetOk("position"); ok {
-			if _, ok := d.GetOk("position.0.top"); ok {
-				httpsRule["new-position"] = "top"
+			if v, ok := d.GetOk("position.0.top"); ok {
+				if v.(string) == "top" {
+					httpsRule["new-position"] = "top" // entire rule-base
+				} else {
+					httpsRule["new-position"] = map[string]interface{}{"top": v.(string)} // specific section-name
+				}
 			}
 			if v, ok := d.GetOk("position.0.above"); ok {
 				httpsRule["new-position"] = map[string]interface{}{"above": v.(string)}
@@ -581,15 +595,19 @@ func updateManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 			if v, ok := d.GetOk("position.0.below"); ok {
 				httpsRule["new-position"] = map[string]interface{}{"below": v.(string)}
 			}
-			if _, ok := d.GetOk("position.0.bottom"); ok {
-				httpsRule["new-position"] = "bottom"
+			if v, ok := d.GetOk("position.0.bottom"); ok {
+				if v.(string) == "bottom" {
+					httpsRule["new-position"] = "bottom" // entire rule-base
+				} else {
+					httpsRule["new-position"] = map[string]interface{}{"bottom": v.(string)} // specific section-name
+				}
 			}
 		}
 	}
 
 	log.Println("Update HttpsRule - Map = ", httpsRule)
 
-	updateHttpsRuleRes, err := client.ApiCall("set-https-rule", httpsRule, client.GetSessionID(), true, client.IsProxyUsed())
+	updateHttpsRuleRes, err := apiCall(client, "set-https-rule", httpsRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateHttpsRuleRes.Success {
 		if updateHttpsRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateHttpsRuleRes.ErrorMsg)
@@ -609,7 +627,7 @@ func deleteManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	deleteHttpsRuleRes, err := client.ApiCall("delete-https-rule", httpsRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteHttpsRuleRes, err := apiCall(client, "delete-https-rule", httpsRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteHttpsRuleRes.Success {
 		if deleteHttpsRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteHttpsRuleRes.ErrorMsg)
@@ -157,7 +157,7 @@ func createManagementIdentityProvider(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create IdentityProvider - Map = ", identityProvider)
 
-	addIdentityProviderRes, err := client.ApiCallSimple("add-identity-provider", identityProvider)
+	addIdentityProviderRes, err := apiCallSimple(client, "add-identity-provider", identityProvider)
 	if err != nil || !addIdentityProviderRes.Success {
 		if addIdentityProviderRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addIdentityProviderRes.ErrorMsg)
@@ -178,7 +178,7 @@ func readManagementIdentityProvider(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showIdentityProviderRes, err := client.ApiCallSimple("show-identity-provider", payload)
+	showIdentityProviderRes, err := apiCallSimple(client, "show-identity-provider", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -337,7 +337,7 @@ func updateManagementIdentityProvider(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update IdentityProvider - Map = ", identityProvider)
 
-	updateIdentityProviderRes, err := client.ApiCallSimple("set-identity-provider", identityProvider)
+	updateIdentityProviderRes, err := apiCallSimple(client, "set-identity-provider", identityProvider)
 	if err != nil || !updateIdentityProviderRes.Success {
 		if updateIdentityProviderRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateIdentityProviderRes.ErrorMsg)
@@ -358,7 +358,7 @@ func deleteManagementIdentityProvider(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete IdentityProvider")
 
-	deleteIdentityProviderRes, err := client.ApiCallSimple("delete-identity-provider", identityProviderPayload)
+	deleteIdentityProviderRes, err := apiCallSimple(client, "delete-identity-provider", identityProviderPayload)
 	if err != nil || !deleteIdentityProviderRes.Success {
 		if deleteIdentityProviderRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteIdentityProviderRes.ErrorMsg)
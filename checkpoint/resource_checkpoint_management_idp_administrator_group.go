@@ -148,7 +148,7 @@ func createManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{}
 
 	log.Println("Create IdpAdministratorGroup - Map = ", idpAdministratorGroup)
 
-	addIdpAdministratorGroupRes, err := client.ApiCall("add-idp-administrator-group", idpAdministratorGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	addIdpAdministratorGroupRes, err := apiCall(client, "add-idp-administrator-group", idpAdministratorGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addIdpAdministratorGroupRes.Success {
 		if addIdpAdministratorGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addIdpAdministratorGroupRes.ErrorMsg)
@@ -169,7 +169,7 @@ func readManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showIdpAdministratorGroupRes, err := client.ApiCall("show-idp-administrator-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIdpAdministratorGroupRes, err := apiCall(client, "show-idp-administrator-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -340,7 +340,7 @@ func updateManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{}
 
 	log.Println("Update IdpAdministratorGroup - Map = ", idpAdministratorGroup)
 
-	updateIdpAdministratorGroupRes, err := client.ApiCall("set-idp-administrator-group", idpAdministratorGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	updateIdpAdministratorGroupRes, err := apiCall(client, "set-idp-administrator-group", idpAdministratorGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateIdpAdministratorGroupRes.Success {
 		if updateIdpAdministratorGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateIdpAdministratorGroupRes.ErrorMsg)
@@ -367,7 +367,7 @@ func deleteManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{}
 	}
 	log.Println("Delete IdpAdministratorGroup")
 
-	deleteIdpAdministratorGroupRes, err := client.ApiCall("delete-idp-administrator-group", idpAdministratorGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteIdpAdministratorGroupRes, err := apiCall(client, "delete-idp-administrator-group", idpAdministratorGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteIdpAdministratorGroupRes.Success {
 		if deleteIdpAdministratorGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteIdpAdministratorGroupRes.ErrorMsg)
@@ -220,7 +220,7 @@ func createManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create IfMapServer - Map = ", ifMapServer)
 
-	addIfMapServerRes, err := client.ApiCallSimple("add-if-map-server", ifMapServer)
+	addIfMapServerRes, err := apiCallSimple(client, "add-if-map-server", ifMapServer)
 	if err != nil || !addIfMapServerRes.Success {
 		if addIfMapServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addIfMapServerRes.ErrorMsg)
@@ -241,7 +241,7 @@ func readManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showIfMapServerRes, err := client.ApiCallSimple("show-if-map-server", payload)
+	showIfMapServerRes, err := apiCallSimple(client, "show-if-map-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -475,7 +475,7 @@ func updateManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update IfMapServer - Map = ", ifMapServer)
 
-	updateIfMapServerRes, err := client.ApiCallSimple("set-if-map-server", ifMapServer)
+	updateIfMapServerRes, err := apiCallSimple(client, "set-if-map-server", ifMapServer)
 	if err != nil || !updateIfMapServerRes.Success {
 		if updateIfMapServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateIfMapServerRes.ErrorMsg)
@@ -496,7 +496,7 @@ func deleteManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete IfMapServer")
 
-	deleteIfMapServerRes, err := client.ApiCallSimple("delete-if-map-server", ifMapServerPayload)
+	deleteIfMapServerRes, err := apiCallSimple(client, "delete-if-map-server", ifMapServerPayload)
 	if err != nil || !deleteIfMapServerRes.Success {
 		if deleteIfMapServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteIfMapServerRes.ErrorMsg)
@@ -150,7 +150,7 @@ func createManagementIllumioDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Create illumioDataCenterServer - Map = ", illumioDataCenterServer)
 
-	addIllumioDataCenterServerRes, err := client.ApiCall("add-data-center-server", illumioDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addIllumioDataCenterServerRes, err := apiCall(client, "add-data-center-server", illumioDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -164,7 +164,7 @@ func createManagementIllumioDataCenterServer(d *schema.ResourceData, m interface
 	payload := map[string]interface{}{
 		"name": illumioDataCenterServer["name"],
 	}
-	showIllumioDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIllumioDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -181,7 +181,7 @@ func readManagementIllumioDataCenterServer(d *schema.ResourceData, m interface{}
 		"uid": d.Id(),
 	}
 
-	showIllumioDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIllumioDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -315,7 +315,7 @@ func updateManagementIllumioDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Update illumioDataCenterServer - Map = ", illumioDataCenterServer)
 
-	updateIllumioDataCenterServerRes, err := client.ApiCall("set-data-center-server", illumioDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateIllumioDataCenterServerRes, err := apiCall(client, "set-data-center-server", illumioDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -348,7 +348,7 @@ func deleteManagementIllumioDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Delete illumioDataCenterServer")
 
-	deleteIllumioDataCenterServerRes, err := client.ApiCall("delete-data-center-server", illumioDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteIllumioDataCenterServerRes, err := apiCall(client, "delete-data-center-server", illumioDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteIllumioDataCenterServerRes.Success {
 		if deleteIllumioDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteIllumioDataCenterServerRes.ErrorMsg)
@@ -572,7 +572,7 @@ func createManagementInterface(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Interface - Map = ", interfaceMap)
 
-	addInterfaceRes, err := client.ApiCall("add-interface", interfaceMap, client.GetSessionID(), true, false)
+	addInterfaceRes, err := apiCall(client, "add-interface", interfaceMap, client.GetSessionID(), true, false)
 	if err != nil || !addInterfaceRes.Success {
 		if addInterfaceRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addInterfaceRes.ErrorMsg)
@@ -593,7 +593,7 @@ func readManagementInterface(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showInterfaceRes, err := client.ApiCall("show-interface", payload, client.GetSessionID(), true, false)
+	showInterfaceRes, err := apiCall(client, "show-interface", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1102,7 +1102,7 @@ func updateManagementInterface(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Interface - Map = ", interfaceMap)
 
-	updateInterfaceRes, err := client.ApiCall("set-interface", interfaceMap, client.GetSessionID(), true, false)
+	updateInterfaceRes, err := apiCall(client, "set-interface", interfaceMap, client.GetSessionID(), true, false)
 	if err != nil || !updateInterfaceRes.Success {
 		if updateInterfaceRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateInterfaceRes.ErrorMsg)
@@ -1123,7 +1123,7 @@ func deleteManagementInterface(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete Interface")
 
-	deleteInterfaceRes, err := client.ApiCall("delete-interface", interfacePayload, client.GetSessionID(), true, false)
+	deleteInterfaceRes, err := apiCall(client, "delete-interface", interfacePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteInterfaceRes.Success {
 		if deleteInterfaceRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteInterfaceRes.ErrorMsg)
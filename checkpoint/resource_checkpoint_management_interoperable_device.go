@@ -396,7 +396,7 @@ func createManagementInteroperableDevice(d *schema.ResourceData, m interface{})
 
 	log.Println("Create InteroperableDevice - Map = ", interoperableDevice)
 
-	addInteroperableDeviceRes, err := client.ApiCall("add-interoperable-device", interoperableDevice, client.GetSessionID(), true, client.IsProxyUsed())
+	addInteroperableDeviceRes, err := apiCall(client, "add-interoperable-device", interoperableDevice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addInteroperableDeviceRes.Success {
 		if addInteroperableDeviceRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addInteroperableDeviceRes.ErrorMsg)
@@ -417,7 +417,7 @@ func readManagementInteroperableDevice(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showInteroperableDeviceRes, err := client.ApiCall("show-interoperable-device", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showInteroperableDeviceRes, err := apiCall(client, "show-interoperable-device", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -806,7 +806,7 @@ func updateManagementInteroperableDevice(d *schema.ResourceData, m interface{})
 
 	log.Println("Update InteroperableDevice - Map = ", interoperableDevice)
 
-	updateInteroperableDeviceRes, err := client.ApiCall("set-interoperable-device", interoperableDevice, client.GetSessionID(), true, client.IsProxyUsed())
+	updateInteroperableDeviceRes, err := apiCall(client, "set-interoperable-device", interoperableDevice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateInteroperableDeviceRes.Success {
 		if updateInteroperableDeviceRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateInteroperableDeviceRes.ErrorMsg)
@@ -834,7 +834,7 @@ func deleteManagementInteroperableDevice(d *schema.ResourceData, m interface{})
 	}
 	log.Println("Delete InteroperableDevice")
 
-	deleteInteroperableDeviceRes, err := client.ApiCall("delete-interoperable-device", interoperableDevicePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteInteroperableDeviceRes, err := apiCall(client, "delete-interoperable-device", interoperableDevicePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteInteroperableDeviceRes.Success {
 		if deleteInteroperableDeviceRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteInteroperableDeviceRes.ErrorMsg)
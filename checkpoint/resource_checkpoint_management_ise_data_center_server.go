@@ -153,7 +153,7 @@ func createManagementIseDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Create iseDataCenterServer - Map = ", iseDataCenterServer)
 
-	addIseDataCenterServerRes, err := client.ApiCall("add-data-center-server", iseDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addIseDataCenterServerRes, err := apiCall(client, "add-data-center-server", iseDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -167,7 +167,7 @@ func createManagementIseDataCenterServer(d *schema.ResourceData, m interface{})
 	payload := map[string]interface{}{
 		"name": iseDataCenterServer["name"],
 	}
-	showIseDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIseDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -184,7 +184,7 @@ func readManagementIseDataCenterServer(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showIseDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showIseDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -325,7 +325,7 @@ func updateManagementIseDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Update iseDataCenterServer - Map = ", iseDataCenterServer)
 
-	updateIseDataCenterServerRes, err := client.ApiCall("set-data-center-server", iseDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateIseDataCenterServerRes, err := apiCall(client, "set-data-center-server", iseDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -358,7 +358,7 @@ func deleteManagementIseDataCenterServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Delete iseDataCenterServer")
 
-	deleteIseDataCenterServerRes, err := client.ApiCall("delete-data-center-server", iseDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteIseDataCenterServerRes, err := apiCall(client, "delete-data-center-server", iseDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteIseDataCenterServerRes.Success {
 		if deleteIseDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteIseDataCenterServerRes.ErrorMsg)
@@ -131,7 +131,7 @@ func createManagementKubernetesDataCenterServer(d *schema.ResourceData, m interf
 
 	log.Println("Create kubernetesDataCenterServer - Map = ", kubernetesDataCenterServer)
 
-	addKubernetesDataCenterServerRes, err := client.ApiCall("add-data-center-server", kubernetesDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addKubernetesDataCenterServerRes, err := apiCall(client, "add-data-center-server", kubernetesDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -145,7 +145,7 @@ func createManagementKubernetesDataCenterServer(d *schema.ResourceData, m interf
 	payload := map[string]interface{}{
 		"name": kubernetesDataCenterServer["name"],
 	}
-	showKubernetesDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showKubernetesDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -162,7 +162,7 @@ func readManagementKubernetesDataCenterServer(d *schema.ResourceData, m interfac
 		"uid": d.Id(),
 	}
 
-	showKubernetesDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showKubernetesDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -288,7 +288,7 @@ func updateManagementKubernetesDataCenterServer(d *schema.ResourceData, m interf
 
 	log.Println("Update kubernetesDataCenterServer - Map = ", kubernetesDataCenterServer)
 
-	updateKubernetesDataCenterServerRes, err := client.ApiCall("set-data-center-server", kubernetesDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateKubernetesDataCenterServerRes, err := apiCall(client, "set-data-center-server", kubernetesDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -321,7 +321,7 @@ func deleteManagementKubernetesDataCenterServer(d *schema.ResourceData, m interf
 
 	log.Println("Delete kubernetesDataCenterServer")
 
-	deleteKubernetesDataCenterServerRes, err := client.ApiCall("delete-data-center-server", kubernetesDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteKubernetesDataCenterServerRes, err := apiCall(client, "delete-data-center-server", kubernetesDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteKubernetesDataCenterServerRes.Success {
 		if deleteKubernetesDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteKubernetesDataCenterServerRes.ErrorMsg)
@@ -148,7 +148,7 @@ func createManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create LdapGroup - Map = ", ldapGroup)
 
-	addLdapGroupRes, err := client.ApiCallSimple("add-ldap-group", ldapGroup)
+	addLdapGroupRes, err := apiCallSimple(client, "add-ldap-group", ldapGroup)
 	if err != nil || !addLdapGroupRes.Success {
 		if addLdapGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLdapGroupRes.ErrorMsg)
@@ -169,7 +169,7 @@ func readManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLdapGroupRes, err := client.ApiCallSimple("show-ldap-group", payload)
+	showLdapGroupRes, err := apiCallSimple(client, "show-ldap-group", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -318,7 +318,7 @@ func updateManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update LdapGroup - Map = ", ldapGroup)
 
-	updateLdapGroupRes, err := client.ApiCallSimple("set-ldap-group", ldapGroup)
+	updateLdapGroupRes, err := apiCallSimple(client, "set-ldap-group", ldapGroup)
 	if err != nil || !updateLdapGroupRes.Success {
 		if updateLdapGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLdapGroupRes.ErrorMsg)
@@ -339,7 +339,7 @@ func deleteManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete LdapGroup")
 
-	deleteLdapGroupRes, err := client.ApiCallSimple("delete-ldap-group", ldapGroupPayload)
+	deleteLdapGroupRes, err := apiCallSimple(client, "delete-ldap-group", ldapGroupPayload)
 	if err != nil || !deleteLdapGroupRes.Success {
 		if deleteLdapGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLdapGroupRes.ErrorMsg)
@@ -145,7 +145,7 @@ func createManagementLimit(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Limit - Map = ", limit)
 
-	addLimitRes, err := client.ApiCall("add-limit", limit, client.GetSessionID(), true, false)
+	addLimitRes, err := apiCall(client, "add-limit", limit, client.GetSessionID(), true, false)
 	if err != nil || !addLimitRes.Success {
 		if addLimitRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLimitRes.ErrorMsg)
@@ -166,7 +166,7 @@ func readManagementLimit(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLimitRes, err := client.ApiCall("show-limit", payload, client.GetSessionID(), true, false)
+	showLimitRes, err := apiCall(client, "show-limit", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -310,7 +310,7 @@ func updateManagementLimit(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Limit - Map = ", limit)
 
-	updateLimitRes, err := client.ApiCall("set-limit", limit, client.GetSessionID(), true, false)
+	updateLimitRes, err := apiCall(client, "set-limit", limit, client.GetSessionID(), true, false)
 	if err != nil || !updateLimitRes.Success {
 		if updateLimitRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLimitRes.ErrorMsg)
@@ -331,7 +331,7 @@ func deleteManagementLimit(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete Limit")
 
-	deleteLimitRes, err := client.ApiCall("delete-limit", limitPayload, client.GetSessionID(), true, false)
+	deleteLimitRes, err := apiCall(client, "delete-limit", limitPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteLimitRes.Success {
 		if deleteLimitRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLimitRes.ErrorMsg)
@@ -224,7 +224,7 @@ func readManagementLiteral(d *schema.ResourceData, m interface{}) error {
 		"details-level": "full",
 	}
 
-	response, err := client.ApiCallSimple("show-object", payload)
+	response, err := apiCallSimple(client, "show-object", payload)
 	if err != nil {
 		return fmt.Errorf(err.Error())
 	}
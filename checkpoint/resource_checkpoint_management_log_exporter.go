@@ -202,7 +202,7 @@ func createManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create LogExporter - Map = ", logExporter)
 
-	addLogExporterRes, err := client.ApiCallSimple("add-log-exporter", logExporter)
+	addLogExporterRes, err := apiCallSimple(client, "add-log-exporter", logExporter)
 	if err != nil || !addLogExporterRes.Success {
 		if addLogExporterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLogExporterRes.ErrorMsg)
@@ -223,7 +223,7 @@ func readManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLogExporterRes, err := client.ApiCallSimple("show-log-exporter", payload)
+	showLogExporterRes, err := apiCallSimple(client, "show-log-exporter", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -427,7 +427,7 @@ func updateManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update LogExporter - Map = ", logExporter)
 
-	updateLogExporterRes, err := client.ApiCallSimple("set-log-exporter", logExporter)
+	updateLogExporterRes, err := apiCallSimple(client, "set-log-exporter", logExporter)
 	if err != nil || !updateLogExporterRes.Success {
 		if updateLogExporterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLogExporterRes.ErrorMsg)
@@ -448,7 +448,7 @@ func deleteManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete LogExporter")
 
-	deleteLogExporterRes, err := client.ApiCallSimple("delete-log-exporter", logExporterPayload)
+	deleteLogExporterRes, err := apiCallSimple(client, "delete-log-exporter", logExporterPayload)
 	if err != nil || !deleteLogExporterRes.Success {
 		if deleteLogExporterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLogExporterRes.ErrorMsg)
@@ -153,7 +153,7 @@ func createManagementLogicalServer(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create LogicalServer - Map = ", logicalServer)
 
-	addLogicalServerRes, err := client.ApiCall("add-logical-server", logicalServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addLogicalServerRes, err := apiCall(client, "add-logical-server", logicalServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addLogicalServerRes.Success {
 		if addLogicalServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLogicalServerRes.ErrorMsg)
@@ -174,7 +174,7 @@ func readManagementLogicalServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLogicalServerRes, err := client.ApiCall("show-logical-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLogicalServerRes, err := apiCall(client, "show-logical-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -339,7 +339,7 @@ func updateManagementLogicalServer(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update LogicalServer - Map = ", logicalServer)
 
-	updateLogicalServerRes, err := client.ApiCall("set-logical-server", logicalServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateLogicalServerRes, err := apiCall(client, "set-logical-server", logicalServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateLogicalServerRes.Success {
 		if updateLogicalServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLogicalServerRes.ErrorMsg)
@@ -360,7 +360,7 @@ func deleteManagementLogicalServer(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete LogicalServer")
 
-	deleteLogicalServerRes, err := client.ApiCall("delete-logical-server", logicalServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteLogicalServerRes, err := apiCall(client, "delete-logical-server", logicalServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteLogicalServerRes.Success {
 		if deleteLogicalServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLogicalServerRes.ErrorMsg)
@@ -561,7 +561,7 @@ func createManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create LsmCluster - Map = ", lsmCluster)
 
-	addLsmClusterRes, err := client.ApiCall("add-lsm-cluster", lsmCluster, client.GetSessionID(), true, false)
+	addLsmClusterRes, err := apiCall(client, "add-lsm-cluster", lsmCluster, client.GetSessionID(), true, false)
 	if err != nil || !addLsmClusterRes.Success {
 		if addLsmClusterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLsmClusterRes.ErrorMsg)
@@ -582,7 +582,7 @@ func readManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLsmClusterRes, err := client.ApiCall("show-lsm-cluster", payload, client.GetSessionID(), true, false)
+	showLsmClusterRes, err := apiCall(client, "show-lsm-cluster", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1198,7 +1198,7 @@ func updateManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update LsmCluster - Map = ", lsmCluster)
 
-	updateLsmClusterRes, err := client.ApiCall("set-lsm-cluster", lsmCluster, client.GetSessionID(), true, false)
+	updateLsmClusterRes, err := apiCall(client, "set-lsm-cluster", lsmCluster, client.GetSessionID(), true, false)
 	if err != nil || !updateLsmClusterRes.Success {
 		if updateLsmClusterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLsmClusterRes.ErrorMsg)
@@ -1219,7 +1219,7 @@ func deleteManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete LsmCluster")
 
-	deleteLsmClusterRes, err := client.ApiCall("delete-lsm-cluster", lsmClusterPayload, client.GetSessionID(), true, false)
+	deleteLsmClusterRes, err := apiCall(client, "delete-lsm-cluster", lsmClusterPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteLsmClusterRes.Success {
 		if deleteLsmClusterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLsmClusterRes.ErrorMsg)
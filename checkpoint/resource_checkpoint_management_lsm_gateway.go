@@ -427,7 +427,7 @@ func createManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create LsmGateway - Map = ", lsmGateway)
 
-	addLsmGatewayRes, err := client.ApiCall("add-lsm-gateway", lsmGateway, client.GetSessionID(), true, false)
+	addLsmGatewayRes, err := apiCall(client, "add-lsm-gateway", lsmGateway, client.GetSessionID(), true, false)
 	if err != nil || !addLsmGatewayRes.Success {
 		if addLsmGatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLsmGatewayRes.ErrorMsg)
@@ -448,7 +448,7 @@ func readManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLsmGatewayRes, err := client.ApiCall("show-lsm-gateway", payload, client.GetSessionID(), true, false)
+	showLsmGatewayRes, err := apiCall(client, "show-lsm-gateway", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -915,7 +915,7 @@ func updateManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update LsmGateway - Map = ", lsmGateway)
 
-	updateLsmGatewayRes, err := client.ApiCall("set-lsm-gateway", lsmGateway, client.GetSessionID(), true, false)
+	updateLsmGatewayRes, err := apiCall(client, "set-lsm-gateway", lsmGateway, client.GetSessionID(), true, false)
 	if err != nil || !updateLsmGatewayRes.Success {
 		if updateLsmGatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLsmGatewayRes.ErrorMsg)
@@ -936,7 +936,7 @@ func deleteManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete LsmGateway")
 
-	deleteLsmGatewayRes, err := client.ApiCall("delete-lsm-gateway", lsmGatewayPayload, client.GetSessionID(), true, false)
+	deleteLsmGatewayRes, err := apiCall(client, "delete-lsm-gateway", lsmGatewayPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteLsmGatewayRes.Success {
 		if deleteLsmGatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLsmGatewayRes.ErrorMsg)
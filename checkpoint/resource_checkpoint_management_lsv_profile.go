@@ -168,7 +168,7 @@ func createManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Lsv Profile - Map = ", lsvProfile)
 
-	addLsvProfileRes, err := client.ApiCall("add-lsv-profile", lsvProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	addLsvProfileRes, err := apiCall(client, "add-lsv-profile", lsvProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addLsvProfileRes.Success {
 		if addLsvProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addLsvProfileRes.ErrorMsg)
@@ -188,7 +188,7 @@ func readManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showLsvProfileRes, err := client.ApiCall("show-lsv-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showLsvProfileRes, err := apiCall(client, "show-lsv-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -354,7 +354,7 @@ func updateManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Lsv Profile - Map = ", lsvProfile)
 
-	updateLsvProfileRes, err := client.ApiCall("set-lsv-profile", lsvProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	updateLsvProfileRes, err := apiCall(client, "set-lsv-profile", lsvProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateLsvProfileRes.Success {
 		if updateLsvProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateLsvProfileRes.ErrorMsg)
@@ -377,7 +377,7 @@ func deleteManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		lsvProfilePayload["ignore-warnings"] = v.(bool)
 	}
-	deleteLsvProfileRes, err := client.ApiCall("delete-lsv-profile", lsvProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteLsvProfileRes, err := apiCall(client, "delete-lsv-profile", lsvProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteLsvProfileRes.Success {
 		if deleteLsvProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteLsvProfileRes.ErrorMsg)
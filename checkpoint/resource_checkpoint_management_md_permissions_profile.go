@@ -221,7 +221,7 @@ func createManagementMdPermissionsProfile(d *schema.ResourceData, m interface{})
 
 	log.Println("Create MdPermissionsProfile - Map = ", mdPermissionsProfile)
 
-	addMdPermissionsProfileRes, err := client.ApiCall("add-md-permissions-profile", mdPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	addMdPermissionsProfileRes, err := apiCall(client, "add-md-permissions-profile", mdPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addMdPermissionsProfileRes.Success {
 		if addMdPermissionsProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMdPermissionsProfileRes.ErrorMsg)
@@ -242,7 +242,7 @@ func readManagementMdPermissionsProfile(d *schema.ResourceData, m interface{}) e
 		"uid": d.Id(),
 	}
 
-	showMdPermissionsProfileRes, err := client.ApiCall("show-md-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showMdPermissionsProfileRes, err := apiCall(client, "show-md-permissions-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -467,7 +467,7 @@ func updateManagementMdPermissionsProfile(d *schema.ResourceData, m interface{})
 
 	log.Println("Update MdPermissionsProfile - Map = ", mdPermissionsProfile)
 
-	updateMdPermissionsProfileRes, err := client.ApiCall("set-md-permissions-profile", mdPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	updateMdPermissionsProfileRes, err := apiCall(client, "set-md-permissions-profile", mdPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateMdPermissionsProfileRes.Success {
 		if updateMdPermissionsProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMdPermissionsProfileRes.ErrorMsg)
@@ -494,7 +494,7 @@ func deleteManagementMdPermissionsProfile(d *schema.ResourceData, m interface{})
 	}
 	log.Println("Delete MdPermissionsProfile")
 
-	deleteMdPermissionsProfileRes, err := client.ApiCall("delete-md-permissions-profile", mdPermissionsProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteMdPermissionsProfileRes, err := apiCall(client, "delete-md-permissions-profile", mdPermissionsProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteMdPermissionsProfileRes.Success {
 		if deleteMdPermissionsProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMdPermissionsProfileRes.ErrorMsg)
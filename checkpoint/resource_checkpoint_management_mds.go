@@ -195,7 +195,7 @@ func createManagementMds(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Mds - Map = ", mds)
 
-	addMdsRes, err := client.ApiCall("add-mds", mds, client.GetSessionID(), true, client.IsProxyUsed())
+	addMdsRes, err := apiCall(client, "add-mds", mds, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addMdsRes.Success {
 		if addMdsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMdsRes.ErrorMsg)
@@ -216,7 +216,7 @@ func readManagementMds(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showMdsRes, err := client.ApiCall("show-mds", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showMdsRes, err := apiCall(client, "show-mds", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -410,7 +410,7 @@ func updateManagementMds(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Mds - Map = ", mds)
 
-	updateMdsRes, err := client.ApiCall("set-mds", mds, client.GetSessionID(), true, client.IsProxyUsed())
+	updateMdsRes, err := apiCall(client, "set-mds", mds, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateMdsRes.Success {
 		if updateMdsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMdsRes.ErrorMsg)
@@ -437,7 +437,7 @@ func deleteManagementMds(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete Mds")
 
-	deleteMdsRes, err := client.ApiCall("delete-mds", mdsPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteMdsRes, err := apiCall(client, "delete-mds", mdsPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteMdsRes.Success {
 		if deleteMdsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMdsRes.ErrorMsg)
@@ -163,7 +163,7 @@ func createManagementMobileAccessProfileRule(d *schema.ResourceData, m interface
 
 	log.Println("Create MobileAccessProfileRule - Map = ", mobileAccessProfileRule)
 
-	addMobileAccessProfileRuleRes, err := client.ApiCall("add-mobile-access-profile-rule", mobileAccessProfileRule, client.GetSessionID(), true, false)
+	addMobileAccessProfileRuleRes, err := apiCall(client, "add-mobile-access-profile-rule", mobileAccessProfileRule, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessProfileRuleRes.Success {
 		if addMobileAccessProfileRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMobileAccessProfileRuleRes.ErrorMsg)
@@ -184,7 +184,7 @@ func readManagementMobileAccessProfileRule(d *schema.ResourceData, m interface{}
 		"uid": d.Id(),
 	}
 
-	showMobileAccessProfileRuleRes, err := client.ApiCall("show-mobile-access-profile-rule", payload, client.GetSessionID(), true, false)
+	showMobileAccessProfileRuleRes, err := apiCall(client, "show-mobile-access-profile-rule", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -346,7 +346,7 @@ func updateManagementMobileAccessProfileRule(d *schema.ResourceData, m interface
 
 	log.Println("Update MobileAccessProfileRule - Map = ", mobileAccessProfileRule)
 
-	updateMobileAccessProfileRuleRes, err := client.ApiCall("set-mobile-access-profile-rule", mobileAccessProfileRule, client.GetSessionID(), true, false)
+	updateMobileAccessProfileRuleRes, err := apiCall(client, "set-mobile-access-profile-rule", mobileAccessProfileRule, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessProfileRuleRes.Success {
 		if updateMobileAccessProfileRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMobileAccessProfileRuleRes.ErrorMsg)
@@ -367,7 +367,7 @@ func deleteManagementMobileAccessProfileRule(d *schema.ResourceData, m interface
 
 	log.Println("Delete MobileAccessProfileRule")
 
-	deleteMobileAccessProfileRuleRes, err := client.ApiCall("delete-mobile-access-profile-rule", mobileAccessProfileRulePayload, client.GetSessionID(), true, false)
+	deleteMobileAccessProfileRuleRes, err := apiCall(client, "delete-mobile-access-profile-rule", mobileAccessProfileRulePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteMobileAccessProfileRuleRes.Success {
 		if deleteMobileAccessProfileRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMobileAccessProfileRuleRes.ErrorMsg)
@@ -121,7 +121,7 @@ func createManagementMobileAccessProfileSection(d *schema.ResourceData, m interf
 	}
 	log.Println("Create MobileAccessProfileSection - Map = ", mobileAccessProfileSection)
 
-	addMobileAccessProfileSectionRes, err := client.ApiCall("add-mobile-access-profile-section", mobileAccessProfileSection, client.GetSessionID(), true, false)
+	addMobileAccessProfileSectionRes, err := apiCall(client, "add-mobile-access-profile-section", mobileAccessProfileSection, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessProfileSectionRes.Success {
 		if addMobileAccessProfileSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMobileAccessProfileSectionRes.ErrorMsg)
@@ -142,7 +142,7 @@ func readManagementMobileAccessProfileSection(d *schema.ResourceData, m interfac
 		"uid": d.Id(),
 	}
 
-	showMobileAccessProfileSectionRes, err := client.ApiCall("show-mobile-access-profile-section", payload, client.GetSessionID(), true, false)
+	showMobileAccessProfileSectionRes, err := apiCall(client, "show-mobile-access-profile-section", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -243,7 +243,7 @@ func updateManagementMobileAccessProfileSection(d *schema.ResourceData, m interf
 
 	log.Println("Update MobileAccessProfileSection - Map = ", mobileAccessProfileSection)
 
-	updateMobileAccessProfileSectionRes, err := client.ApiCall("set-mobile-access-profile-section", mobileAccessProfileSection, client.GetSessionID(), true, false)
+	updateMobileAccessProfileSectionRes, err := apiCall(client, "set-mobile-access-profile-section", mobileAccessProfileSection, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessProfileSectionRes.Success {
 		if updateMobileAccessProfileSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMobileAccessProfileSectionRes.ErrorMsg)
@@ -264,7 +264,7 @@ func deleteManagementMobileAccessProfileSection(d *schema.ResourceData, m interf
 
 	log.Println("Delete MobileAccessProfileSection")
 
-	deleteMobileAccessProfileSectionRes, err := client.ApiCall("delete-mobile-access-profile-section", mobileAccessProfileSectionPayload, client.GetSessionID(), true, false)
+	deleteMobileAccessProfileSectionRes, err := apiCall(client, "delete-mobile-access-profile-section", mobileAccessProfileSectionPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteMobileAccessProfileSectionRes.Success {
 		if deleteMobileAccessProfileSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMobileAccessProfileSectionRes.ErrorMsg)
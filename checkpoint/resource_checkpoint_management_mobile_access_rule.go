@@ -175,7 +175,7 @@ func createManagementMobileAccessRule(d *schema.ResourceData, m interface{}) err
 	}
 	log.Println("Create MobileAccessRule - Map = ", mobileAccessRule)
 
-	addMobileAccessRuleRes, err := client.ApiCall("add-mobile-access-rule", mobileAccessRule, client.GetSessionID(), true, false)
+	addMobileAccessRuleRes, err := apiCall(client, "add-mobile-access-rule", mobileAccessRule, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessRuleRes.Success {
 		if addMobileAccessRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMobileAccessRuleRes.ErrorMsg)
@@ -196,7 +196,7 @@ func readManagementMobileAccessRule(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showMobileAccessRuleRes, err := client.ApiCall("show-mobile-access-rule", payload, client.GetSessionID(), true, false)
+	showMobileAccessRuleRes, err := apiCall(client, "show-mobile-access-rule", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -387,7 +387,7 @@ func updateManagementMobileAccessRule(d *schema.ResourceData, m interface{}) err
 	}
 	log.Println("Update MobileAccessRule - Map = ", mobileAccessRule)
 
-	updateMobileAccessRuleRes, err := client.ApiCall("set-mobile-access-rule", mobileAccessRule, client.GetSessionID(), true, false)
+	updateMobileAccessRuleRes, err := apiCall(client, "set-mobile-access-rule", mobileAccessRule, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessRuleRes.Success {
 		if updateMobileAccessRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMobileAccessRuleRes.ErrorMsg)
@@ -408,7 +408,7 @@ func deleteManagementMobileAccessRule(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete MobileAccessRule")
 
-	deleteMobileAccessRuleRes, err := client.ApiCall("delete-mobile-access-rule", mobileAccessRulePayload, client.GetSessionID(), true, false)
+	deleteMobileAccessRuleRes, err := apiCall(client, "delete-mobile-access-rule", mobileAccessRulePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteMobileAccessRuleRes.Success {
 		if deleteMobileAccessRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMobileAccessRuleRes.ErrorMsg)
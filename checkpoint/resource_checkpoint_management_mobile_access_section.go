@@ -122,7 +122,7 @@ func createManagementMobileAccessSection(d *schema.ResourceData, m interface{})
 
 	log.Println("Create MobileAccessSection - Map = ", mobileAccessSection)
 
-	addMobileAccessSectionRes, err := client.ApiCall("add-mobile-access-section", mobileAccessSection, client.GetSessionID(), true, false)
+	addMobileAccessSectionRes, err := apiCall(client, "add-mobile-access-section", mobileAccessSection, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessSectionRes.Success {
 		if addMobileAccessSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMobileAccessSectionRes.ErrorMsg)
@@ -143,7 +143,7 @@ func readManagementMobileAccessSection(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showMobileAccessSectionRes, err := client.ApiCall("show-mobile-access-section", payload, client.GetSessionID(), true, false)
+	showMobileAccessSectionRes, err := apiCall(client, "show-mobile-access-section", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -244,7 +244,7 @@ func updateManagementMobileAccessSection(d *schema.ResourceData, m interface{})
 
 	log.Println("Update MobileAccessSection - Map = ", mobileAccessSection)
 
-	updateMobileAccessSectionRes, err := client.ApiCall("set-mobile-access-section", mobileAccessSection, client.GetSessionID(), true, false)
+	updateMobileAccessSectionRes, err := apiCall(client, "set-mobile-access-section", mobileAccessSection, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessSectionRes.Success {
 		if updateMobileAccessSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMobileAccessSectionRes.ErrorMsg)
@@ -265,7 +265,7 @@ func deleteManagementMobileAccessSection(d *schema.ResourceData, m interface{})
 
 	log.Println("Delete MobileAccessSection")
 
-	deleteMobileAccessSectionRes, err := client.ApiCall("delete-mobile-access-section", mobileAccessSectionPayload, client.GetSessionID(), true, false)
+	deleteMobileAccessSectionRes, err := apiCall(client, "delete-mobile-access-section", mobileAccessSectionPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteMobileAccessSectionRes.Success {
 		if deleteMobileAccessSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMobileAccessSectionRes.ErrorMsg)
@@ -796,7 +796,7 @@ func createManagementMobileProfile(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create MobileProfile - Map = ", mobileProfile)
 
-	addMobileProfileRes, err := client.ApiCall("add-mobile-profile", mobileProfile, client.GetSessionID(), true, false)
+	addMobileProfileRes, err := apiCall(client, "add-mobile-profile", mobileProfile, client.GetSessionID(), true, false)
 	if err != nil || !addMobileProfileRes.Success {
 		if addMobileProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMobileProfileRes.ErrorMsg)
@@ -817,7 +817,7 @@ func readManagementMobileProfile(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showMobileProfileRes, err := client.ApiCall("show-mobile-profile", payload, client.GetSessionID(), true, false)
+	showMobileProfileRes, err := apiCall(client, "show-mobile-profile", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1462,7 +1462,7 @@ func updateManagementMobileProfile(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update MobileProfile - Map = ", mobileProfile)
 
-	updateMobileProfileRes, err := client.ApiCall("set-mobile-profile", mobileProfile, client.GetSessionID(), true, false)
+	updateMobileProfileRes, err := apiCall(client, "set-mobile-profile", mobileProfile, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileProfileRes.Success {
 		if updateMobileProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMobileProfileRes.ErrorMsg)
@@ -1483,7 +1483,7 @@ func deleteManagementMobileProfile(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete MobileProfile")
 
-	deleteMobileProfileRes, err := client.ApiCall("delete-mobile-profile", mobileProfilePayload, client.GetSessionID(), true, false)
+	deleteMobileProfileRes, err := apiCall(client, "delete-mobile-profile", mobileProfilePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteMobileProfileRes.Success {
 		if deleteMobileProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMobileProfileRes.ErrorMsg)
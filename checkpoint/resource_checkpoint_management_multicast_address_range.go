@@ -142,7 +142,7 @@ func createManagementMulticastAddressRange(d *schema.ResourceData, m interface{}
 
 	log.Println("Create MulticastAddressRange - Map = ", multicastAddressRange)
 
-	addMulticastAddressRangeRes, err := client.ApiCall("add-multicast-address-range", multicastAddressRange, client.GetSessionID(), true, client.IsProxyUsed())
+	addMulticastAddressRangeRes, err := apiCall(client, "add-multicast-address-range", multicastAddressRange, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addMulticastAddressRangeRes.Success {
 		if addMulticastAddressRangeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMulticastAddressRangeRes.ErrorMsg)
@@ -163,7 +163,7 @@ func readManagementMulticastAddressRange(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showMulticastAddressRangeRes, err := client.ApiCall("show-multicast-address-range", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showMulticastAddressRangeRes, err := apiCall(client, "show-multicast-address-range", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -307,7 +307,7 @@ func updateManagementMulticastAddressRange(d *schema.ResourceData, m interface{}
 
 	log.Println("Update MulticastAddressRange - Map = ", multicastAddressRange)
 
-	updateMulticastAddressRangeRes, err := client.ApiCall("set-multicast-address-range", multicastAddressRange, client.GetSessionID(), true, client.IsProxyUsed())
+	updateMulticastAddressRangeRes, err := apiCall(client, "set-multicast-address-range", multicastAddressRange, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateMulticastAddressRangeRes.Success {
 		if updateMulticastAddressRangeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMulticastAddressRangeRes.ErrorMsg)
@@ -334,7 +334,7 @@ func deleteManagementMulticastAddressRange(d *schema.ResourceData, m interface{}
 	}
 	log.Println("Delete MulticastAddressRange")
 
-	deleteMulticastAddressRangeRes, err := client.ApiCall("delete-multicast-address-range", multicastAddressRangePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteMulticastAddressRangeRes, err := apiCall(client, "delete-multicast-address-range", multicastAddressRangePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteMulticastAddressRangeRes.Success {
 		if deleteMulticastAddressRangeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMulticastAddressRangeRes.ErrorMsg)
@@ -181,7 +181,7 @@ func createManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{})
 
 	log.Println("Create MultipleKeyExchanges - Map = ", multipleKeyExchanges)
 
-	addMultipleKeyExchangesRes, err := client.ApiCall("add-multiple-key-exchanges", multipleKeyExchanges, client.GetSessionID(), true, false)
+	addMultipleKeyExchangesRes, err := apiCall(client, "add-multiple-key-exchanges", multipleKeyExchanges, client.GetSessionID(), true, false)
 	if err != nil || !addMultipleKeyExchangesRes.Success {
 		if addMultipleKeyExchangesRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addMultipleKeyExchangesRes.ErrorMsg)
@@ -202,7 +202,7 @@ func readManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{}) e
 		"uid": d.Id(),
 	}
 
-	showMultipleKeyExchangesRes, err := client.ApiCall("show-multiple-key-exchanges", payload, client.GetSessionID(), true, false)
+	showMultipleKeyExchangesRes, err := apiCall(client, "show-multiple-key-exchanges", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -421,7 +421,7 @@ func updateManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{})
 
 	log.Println("Update MultipleKeyExchanges - Map = ", multipleKeyExchanges)
 
-	updateMultipleKeyExchangesRes, err := client.ApiCall("set-multiple-key-exchanges", multipleKeyExchanges, client.GetSessionID(), true, false)
+	updateMultipleKeyExchangesRes, err := apiCall(client, "set-multiple-key-exchanges", multipleKeyExchanges, client.GetSessionID(), true, false)
 	if err != nil || !updateMultipleKeyExchangesRes.Success {
 		if updateMultipleKeyExchangesRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateMultipleKeyExchangesRes.ErrorMsg)
@@ -442,7 +442,7 @@ func deleteManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{})
 
 	log.Println("Delete MultipleKeyExchanges")
 
-	deleteMultipleKeyExchangesRes, err := client.ApiCall("delete-multiple-key-exchanges", multipleKeyExchangesPayload, client.GetSessionID(), true, false)
+	deleteMultipleKeyExchangesRes, err := apiCall(client, "delete-multiple-key-exchanges", multipleKeyExchangesPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteMultipleKeyExchangesRes.Success {
 		if deleteMultipleKeyExchangesRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteMultipleKeyExchangesRes.ErrorMsg)
@@ -90,10 +90,11 @@ func resourceManagementNatRule() *schema.Resource {
 				},
 			},
 			"method": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Nat method.",
-				Default:     "static",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Nat method.",
+				Default:      "static",
+				ValidateFunc: validateStringValue("static", "hide", "nat64", "nat46", "cgnat"),
 			},
 			"original_destination": {
 				Type:        schema.TypeString,
@@ -247,7 +248,7 @@ func createManagementNatRule(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create NAT Rule - Map = ", natRule)
 
-	addNatRuleRes, err := client.ApiCall("add-nat-rule", natRule, client.GetSessionID(), true, client.IsProxyUsed())
+	addNatRuleRes, err := apiCall(client, "add-nat-rule", natRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNatRuleRes.Success {
 		if addNatRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addNatRuleRes.ErrorMsg)
@@ -269,7 +270,7 @@ func readManagementNatRule(d *schema.ResourceData, m interface{}) error {
 		"package": d.Get("package"),
 	}
 
-	showNatRuleRes, err := client.ApiCall("show-nat-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNatRuleRes, err := apiCall(client, "show-nat-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -445,7 +446,7 @@ func updateManagementNatRule(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update NAT Rule - Map = ", natRule)
 
-	updateNatRuleRes, err := client.ApiCall("set-nat-rule", natRule, client.GetSessionID(), true, client.IsProxyUsed())
+	updateNatRuleRes, err := apiCall(client, "set-nat-rule", natRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateNatRuleRes.Success {
 		if updateNatRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateNatRuleRes.ErrorMsg)
@@ -464,7 +465,7 @@ func deleteManagementNatRule(d *schema.ResourceData, m interface{}) error {
 		"package": d.Get("package"),
 	}
 
-	deleteAccessRuleRes, err := client.ApiCall("delete-nat-rule", natRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteAccessRuleRes, err := apiCall(client, "delete-nat-rule", natRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteAccessRuleRes.Success {
 		if deleteAccessRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteAccessRuleRes.ErrorMsg)
@@ -129,7 +129,7 @@ func createManagementNatSection(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create NAT section - Map = ", natSection)
 
-	addNatSectionRes, err := client.ApiCall("add-nat-section", natSection, client.GetSessionID(), true, client.IsProxyUsed())
+	addNatSectionRes, err := apiCall(client, "add-nat-section", natSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNatSectionRes.Success {
 		if addNatSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addNatSectionRes.ErrorMsg)
@@ -151,7 +151,7 @@ func readManagementNatSection(d *schema.ResourceData, m interface{}) error {
 		"package": d.Get("package"),
 	}
 
-	showNatSectionRes, err := client.ApiCall("show-nat-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNatSectionRes, err := apiCall(client, "show-nat-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -196,7 +196,7 @@ func updateManagementNatSection(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update NAT section - Map = ", natSection)
 
-	updateNatSectionRes, err := client.ApiCall("set-nat-section", natSection, client.GetSessionID(), true, client.IsProxyUsed())
+	updateNatSectionRes, err := apiCall(client, "set-nat-section", natSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateNatSectionRes.Success {
 		if updateNatSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateNatSectionRes.ErrorMsg)
@@ -218,7 +218,7 @@ func deleteManagementNatSection(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete NAT section")
 
-	deleteNatSectionRes, err := client.ApiCall("delete-nat-section", natSectionPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteNatSectionRes, err := apiCall(client, "delete-nat-section", natSectionPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteNatSectionRes.Success {
 		if deleteNatSectionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteNatSectionRes.ErrorMsg)
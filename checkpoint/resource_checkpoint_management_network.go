@@ -42,14 +42,16 @@ func resourceManagementNetwork() *schema.Resource {
 				Description: "IPv6 network address.",
 			},
 			"mask_length4": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "IPv4 network mask length.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "IPv4 network mask length.",
+				ValidateFunc: validateIntRange(0, 32),
 			},
 			"mask_length6": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "IPv6 network mask length.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "IPv6 network mask length.",
+				ValidateFunc: validateIntRange(0, 128),
 			},
 			"subnet_mask": {
 				Type:        schema.TypeString,
@@ -79,9 +81,10 @@ func resourceManagementNetwork() *schema.Resource {
 							Description: "IPv6 address.",
 						},
 						"hide_behind": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Hide behind method. This parameter is not required in case \"method\" parameter is \"static\".",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Hide behind method. This parameter is not required in case \"method\" parameter is \"static\".",
+							ValidateFunc: validateStringValue("gateway", "ip-address"),
 						},
 						"install_on": {
 							Type:        schema.TypeString,
@@ -89,9 +92,10 @@ func resourceManagementNetwork() *schema.Resource {
 							Description: "Which gateway should apply the NAT translation.",
 						},
 						"method": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "NAT translation method.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "NAT translation method.",
+							ValidateFunc: validateStringValue("hide", "static"),
 						},
 					},
 				},
@@ -105,16 +109,18 @@ func resourceManagementNetwork() *schema.Resource {
 				},
 			},
 			"broadcast": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Allow broadcast address inclusion.",
-				Default:     "allow",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Allow broadcast address inclusion.",
+				Default:      "allow",
+				ValidateFunc: validateStringValue("allow", "disallow"),
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -210,15 +216,12 @@ func createManagementNetwork(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Network - Map = ", network)
 
-	addNetworkRes, err := client.ApiCall("add-network", network, client.GetSessionID(), true, client.IsProxyUsed())
-	if err != nil || !addNetworkRes.Success {
-		if addNetworkRes.ErrorMsg != "" {
-			return fmt.Errorf("%s", addNetworkRes.ErrorMsg)
-		}
-		return fmt.Errorf("%s", err.Error())
+	uid, err := addObject(client, "network", network)
+	if err != nil {
+		return err
 	}
 
-	d.SetId(addNetworkRes.GetData()["uid"].(string))
+	d.SetId(uid)
 
 	return readManagementNetwork(d, m)
 }
@@ -231,7 +234,7 @@ func readManagementNetwork(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showNetworkRes, err := client.ApiCall("show-network", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNetworkRes, err := apiCall(client, "show-network", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -435,12 +438,8 @@ func updateManagementNetwork(d *schema.ResourceData, m interface{}) error {
 	log.Println("Update Network - Map = ", network)
 
 	if len(network) != 3 {
-		setNetworkRes, err := client.ApiCall("set-network", network, client.GetSessionID(), true, client.IsProxyUsed())
-		if err != nil {
-			return fmt.Errorf("%s", err.Error())
-		}
-		if !setNetworkRes.Success {
-			return fmt.Errorf("%s", setNetworkRes.ErrorMsg)
+		if err := setObject(client, "network", network); err != nil {
+			return err
 		}
 	} else {
 		// Payload contain only required fields: uid, ignore-warnings and ignore-errors
@@ -462,9 +461,8 @@ func deleteManagementNetwork(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		payload["ignore-warnings"] = v.(bool)
 	}
-	deleteNetworkRes, _ := client.ApiCall("delete-network", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !deleteNetworkRes.Success {
-		return fmt.Errorf("%s", deleteNetworkRes.ErrorMsg)
+	if err := deleteObject(client, "network", payload); err != nil {
+		return err
 	}
 	d.SetId("")
 
@@ -259,7 +259,7 @@ func createManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create NetworkFeed - Map = ", networkFeed)
 
-	addNetworkFeedRes, err := client.ApiCall("add-network-feed", networkFeed, client.GetSessionID(), true, client.IsProxyUsed())
+	addNetworkFeedRes, err := apiCall(client, "add-network-feed", networkFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNetworkFeedRes.Success {
 		if addNetworkFeedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addNetworkFeedRes.ErrorMsg)
@@ -280,7 +280,7 @@ func readManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showNetworkFeedRes, err := client.ApiCall("show-network-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNetworkFeedRes, err := apiCall(client, "show-network-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -556,7 +556,7 @@ func updateManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update NetworkFeed - Map = ", networkFeed)
 
-	updateNetworkFeedRes, err := client.ApiCall("set-network-feed", networkFeed, client.GetSessionID(), true, client.IsProxyUsed())
+	updateNetworkFeedRes, err := apiCall(client, "set-network-feed", networkFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateNetworkFeedRes.Success {
 		if updateNetworkFeedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateNetworkFeedRes.ErrorMsg)
@@ -583,7 +583,7 @@ func deleteManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete NetworkFeed")
 
-	deleteNetworkFeedRes, err := client.ApiCall("delete-network-feed", networkFeedPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteNetworkFeedRes, err := apiCall(client, "delete-network-feed", networkFeedPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteNetworkFeedRes.Success {
 		if deleteNetworkFeedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteNetworkFeedRes.ErrorMsg)
@@ -204,7 +204,7 @@ func createManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create NetworkProbe - Map = ", networkProbe)
 
-	addNetworkProbeRes, err := client.ApiCall("add-network-probe", networkProbe, client.GetSessionID(), true, false)
+	addNetworkProbeRes, err := apiCall(client, "add-network-probe", networkProbe, client.GetSessionID(), true, false)
 	if err != nil || !addNetworkProbeRes.Success {
 		if addNetworkProbeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addNetworkProbeRes.ErrorMsg)
@@ -225,7 +225,7 @@ func readManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showNetworkProbeRes, err := client.ApiCall("show-network-probe", payload, client.GetSessionID(), true, false)
+	showNetworkProbeRes, err := apiCall(client, "show-network-probe", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -442,7 +442,7 @@ func updateManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update NetworkProbe - Map = ", networkProbe)
 
-	updateNetworkProbeRes, err := client.ApiCall("set-network-probe", networkProbe, client.GetSessionID(), true, false)
+	updateNetworkProbeRes, err := apiCall(client, "set-network-probe", networkProbe, client.GetSessionID(), true, false)
 	if err != nil || !updateNetworkProbeRes.Success {
 		if updateNetworkProbeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateNetworkProbeRes.ErrorMsg)
@@ -463,7 +463,7 @@ func deleteManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete NetworkProbe")
 
-	deleteNetworkProbeRes, err := client.ApiCall("delete-network-probe", networkProbePayload, client.GetSessionID(), true, false)
+	deleteNetworkProbeRes, err := apiCall(client, "delete-network-probe", networkProbePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteNetworkProbeRes.Success {
 		if deleteNetworkProbeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteNetworkProbeRes.ErrorMsg)
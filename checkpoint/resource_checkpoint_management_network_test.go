@@ -6,7 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"os"
+	"strings"
 	"testing"
 )
 
@@ -20,14 +20,12 @@ func TestAccCheckpointManagementNetwork_basic(t *testing.T) {
 	resourceName := "checkpoint_management_network.test"
 	objName := "tfTestManagementNetwork_" + acctest.RandString(6)
 
-	context := os.Getenv("CHECKPOINT_CONTEXT")
+	context := testAccContext(t)
 	if context != "web_api" {
 		t.Skip("Skipping management test")
-	} else if context == "" {
-		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
 	}
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckpointNetworkDestroy,
@@ -125,3 +123,25 @@ resource "checkpoint_management_network" "test" {
 }
 `, name, subnet4, masklen4)
 }
+
+func TestCheckpointManagementNetwork_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{"objects_batch_size": 10})
+
+	state := testFakeApply(t, provider, "checkpoint_management_network", nil, map[string]interface{}{
+		"name":         "net1",
+		"subnet4":      "192.0.2.0",
+		"mask_length4": 24,
+	})
+	if state.ID == "" || state.Attributes["subnet4"] != "192.0.2.0" || state.Attributes["mask_length4"] != "24" {
+		t.Fatalf("unexpected state after create: %v", state)
+	}
+
+	testFakeApply(t, provider, "checkpoint_management_network", state, nil)
+	if len(server.objects) != 0 {
+		t.Fatalf("expected network to be deleted, got %v", server.objects)
+	}
+	commands := strings.Join(server.commands(), ",")
+	if !strings.Contains(commands, "add-objects-batch") || !strings.Contains(commands, "delete-objects-batch") {
+		t.Fatalf("expected network changes to be batched, got %s", commands)
+	}
+}
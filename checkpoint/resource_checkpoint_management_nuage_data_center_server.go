@@ -160,7 +160,7 @@ func createManagementNuageDataCenterServer(d *schema.ResourceData, m interface{}
 
 	log.Println("Create nuageDataCenterServer - Map = ", nuageDataCenterServer)
 
-	addNuageDataCenterServerRes, err := client.ApiCall("add-data-center-server", nuageDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addNuageDataCenterServerRes, err := apiCall(client, "add-data-center-server", nuageDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -174,7 +174,7 @@ func createManagementNuageDataCenterServer(d *schema.ResourceData, m interface{}
 	payload := map[string]interface{}{
 		"name": nuageDataCenterServer["name"],
 	}
-	showNuageDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNuageDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -191,7 +191,7 @@ func readManagementNuageDataCenterServer(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showNuageDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNuageDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -333,7 +333,7 @@ func updateManagementNuageDataCenterServer(d *schema.ResourceData, m interface{}
 
 	log.Println("Update nuageDataCenterServer - Map = ", nuageDataCenterServer)
 
-	updateNuageDataCenterServerRes, err := client.ApiCall("set-data-center-server", nuageDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateNuageDataCenterServerRes, err := apiCall(client, "set-data-center-server", nuageDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -365,7 +365,7 @@ func deleteManagementNuageDataCenterServer(d *schema.ResourceData, m interface{}
 	}
 	log.Println("Delete nuageDataCenterServer")
 
-	deleteNuageDataCenterServerRes, err := client.ApiCall("delete-data-center-server", nuageDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteNuageDataCenterServerRes, err := apiCall(client, "delete-data-center-server", nuageDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil || !deleteNuageDataCenterServerRes.Success {
 		if deleteNuageDataCenterServerRes.ErrorMsg != "" {
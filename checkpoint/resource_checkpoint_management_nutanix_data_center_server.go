@@ -147,7 +147,7 @@ func createManagementNutanixDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Create Nutanix Data Center Server - Map = ", nutanixDataCenterServer)
 
-	addNutanixDataCenterServerRes, err := client.ApiCall("add-data-center-server", nutanixDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addNutanixDataCenterServerRes, err := apiCall(client, "add-data-center-server", nutanixDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -161,7 +161,7 @@ func createManagementNutanixDataCenterServer(d *schema.ResourceData, m interface
 	payload := map[string]interface{}{
 		"name": nutanixDataCenterServer["name"],
 	}
-	showNutanixDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNutanixDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -179,7 +179,7 @@ func readManagementNutanixDataCenterServer(d *schema.ResourceData, m interface{}
 		"uid": d.Id(),
 	}
 
-	showNutanixDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showNutanixDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -315,7 +315,7 @@ func updateManagementNutanixDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Update nutanixDataCenterServer - Map = ", nutanixDataCenterServer)
 
-	updateNutanixDataCenterServerRes, err := client.ApiCall("set-data-center-server", nutanixDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateNutanixDataCenterServerRes, err := apiCall(client, "set-data-center-server", nutanixDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -347,7 +347,7 @@ func deleteManagementNutanixDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Delete nutanixDataCenterServer")
 
-	deleteNutanixDataCenterServerRes, err := client.ApiCall("delete-data-center-server", nutanixDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteNutanixDataCenterServerRes, err := apiCall(client, "delete-data-center-server", nutanixDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteNutanixDataCenterServerRes.Success {
 		if deleteNutanixDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteNutanixDataCenterServerRes.ErrorMsg)
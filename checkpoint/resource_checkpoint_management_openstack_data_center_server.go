@@ -150,7 +150,7 @@ func createManagementOpenStackDataCenterServer(d *schema.ResourceData, m interfa
 
 	log.Println("Create openstackDataCenterServer - Map = ", openstackDataCenterServer)
 
-	addOpenStackDataCenterServerRes, err := client.ApiCall("add-data-center-server", openstackDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addOpenStackDataCenterServerRes, err := apiCall(client, "add-data-center-server", openstackDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -164,7 +164,7 @@ func createManagementOpenStackDataCenterServer(d *schema.ResourceData, m interfa
 	payload := map[string]interface{}{
 		"name": openstackDataCenterServer["name"],
 	}
-	showOpenStackDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOpenStackDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -181,7 +181,7 @@ func readManagementOpenStackDataCenterServer(d *schema.ResourceData, m interface
 		"uid": d.Id(),
 	}
 
-	showOpenStackDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOpenStackDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -319,7 +319,7 @@ func updateManagementOpenStackDataCenterServer(d *schema.ResourceData, m interfa
 
 	log.Println("Update openstackDataCenterServer - Map = ", openstackDataCenterServer)
 
-	updateOpenStackDataCenterServerRes, err := client.ApiCall("set-data-center-server", openstackDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateOpenStackDataCenterServerRes, err := apiCall(client, "set-data-center-server", openstackDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -352,7 +352,7 @@ func deleteManagementOpenStackDataCenterServer(d *schema.ResourceData, m interfa
 
 	log.Println("Delete openstackDataCenterServer")
 
-	deleteOpenStackDataCenterServerRes, err := client.ApiCall("delete-data-center-server", openstackDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteOpenStackDataCenterServerRes, err := apiCall(client, "delete-data-center-server", openstackDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteOpenStackDataCenterServerRes.Success {
 		if deleteOpenStackDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteOpenStackDataCenterServerRes.ErrorMsg)
@@ -209,7 +209,7 @@ func createManagementOpsecApplication(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create OpsecApplication - Map = ", opsecApplication)
 
-	addOpsecApplicationRes, err := client.ApiCall("add-opsec-application", opsecApplication, client.GetSessionID(), true, client.IsProxyUsed())
+	addOpsecApplicationRes, err := apiCall(client, "add-opsec-application", opsecApplication, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addOpsecApplicationRes.Success {
 		if addOpsecApplicationRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addOpsecApplicationRes.ErrorMsg)
@@ -230,7 +230,7 @@ func readManagementOpsecApplication(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showOpsecApplicationRes, err := client.ApiCall("show-opsec-application", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOpsecApplicationRes, err := apiCall(client, "show-opsec-application", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -432,7 +432,7 @@ func updateManagementOpsecApplication(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update OpsecApplication - Map = ", opsecApplication)
 
-	updateOpsecApplicationRes, err := client.ApiCall("set-opsec-application", opsecApplication, client.GetSessionID(), true, client.IsProxyUsed())
+	updateOpsecApplicationRes, err := apiCall(client, "set-opsec-application", opsecApplication, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateOpsecApplicationRes.Success {
 		if updateOpsecApplicationRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateOpsecApplicationRes.ErrorMsg)
@@ -461,7 +461,7 @@ func deleteManagementOpsecApplication(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Delete OpsecApplication")
 
-	deleteOpsecApplicationRes, err := client.ApiCall("delete-opsec-application", opsecApplicationPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteOpsecApplicationRes, err := apiCall(client, "delete-opsec-application", opsecApplicationPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteOpsecApplicationRes.Success {
 		if deleteOpsecApplicationRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteOpsecApplicationRes.ErrorMsg)
@@ -384,7 +384,7 @@ func createManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create OpsecTrustedCa - Map = ", opsecTrustedCa)
 
-	addOpsecTrustedCaRes, err := client.ApiCall("add-opsec-trusted-ca", opsecTrustedCa, client.GetSessionID(), true, false)
+	addOpsecTrustedCaRes, err := apiCall(client, "add-opsec-trusted-ca", opsecTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !addOpsecTrustedCaRes.Success {
 		if addOpsecTrustedCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addOpsecTrustedCaRes.ErrorMsg)
@@ -405,7 +405,7 @@ func readManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showOpsecTrustedCaRes, err := client.ApiCall("show-opsec-trusted-ca", payload, client.GetSessionID(), true, false)
+	showOpsecTrustedCaRes, err := apiCall(client, "show-opsec-trusted-ca", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -821,7 +821,7 @@ func updateManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update OpsecTrustedCa - Map = ", opsecTrustedCa)
 
-	updateOpsecTrustedCaRes, err := client.ApiCall("set-opsec-trusted-ca", opsecTrustedCa, client.GetSessionID(), true, false)
+	updateOpsecTrustedCaRes, err := apiCall(client, "set-opsec-trusted-ca", opsecTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !updateOpsecTrustedCaRes.Success {
 		if updateOpsecTrustedCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateOpsecTrustedCaRes.ErrorMsg)
@@ -842,7 +842,7 @@ func deleteManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete OpsecTrustedCa")
 
-	deleteOpsecTrustedCaRes, err := client.ApiCall("delete-opsec-trusted-ca", opsecTrustedCaPayload, client.GetSessionID(), true, false)
+	deleteOpsecTrustedCaRes, err := apiCall(client, "delete-opsec-trusted-ca", opsecTrustedCaPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteOpsecTrustedCaRes.Success {
 		if deleteOpsecTrustedCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteOpsecTrustedCaRes.ErrorMsg)
@@ -143,7 +143,7 @@ func createManagementOracleCloudDataCenterServer(d *schema.ResourceData, m inter
 
 	log.Println("Create oracleCloudDataCenterServer - Map = ", oracleCloudDataCenterServer)
 
-	addOracleCloudDataCenterServerRes, err := client.ApiCall("add-data-center-server", oracleCloudDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addOracleCloudDataCenterServerRes, err := apiCall(client, "add-data-center-server", oracleCloudDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -158,7 +158,7 @@ func createManagementOracleCloudDataCenterServer(d *schema.ResourceData, m inter
 		"name": oracleCloudDataCenterServer["name"],
 	}
 
-	showOracleCloudDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOracleCloudDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -176,7 +176,7 @@ func readManagementOracleCloudDataCenterServer(d *schema.ResourceData, m interfa
 		"uid": d.Id(),
 	}
 
-	showOracleCloudDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showOracleCloudDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -310,7 +310,7 @@ func updateManagementOracleCloudDataCenterServer(d *schema.ResourceData, m inter
 
 	log.Println("Update oracleCloudDataCenterServer - Map = ", oracleCloudDataCenterServer)
 
-	updateOracleCloudDataCenterServerRes, err := client.ApiCall("set-data-center-server", oracleCloudDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateOracleCloudDataCenterServerRes, err := apiCall(client, "set-data-center-server", oracleCloudDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -341,7 +341,7 @@ func deleteManagementOracleCloudDataCenterServer(d *schema.ResourceData, m inter
 		oracleCloudDataCenterServerPayload["ignore-errors"] = v.(bool)
 	}
 
-	deleteOracleCloudDataCenterServerRes, err := client.ApiCall("delete-data-center-server", oracleCloudDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteOracleCloudDataCenterServerRes, err := apiCall(client, "delete-data-center-server", oracleCloudDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteOracleCloudDataCenterServerRes.Success {
 		if deleteOracleCloudDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteOracleCloudDataCenterServerRes.ErrorMsg)
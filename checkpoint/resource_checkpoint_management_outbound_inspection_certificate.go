@@ -140,7 +140,7 @@ func createManagementOutboundInspectionCertificate(d *schema.ResourceData, m int
 
 	log.Println("Create OutboundInspectionCertificate - Map = ", outboundInspectionCertificate)
 
-	addOutboundInspectionCertificateRes, err := client.ApiCall("add-outbound-inspection-certificate", outboundInspectionCertificate, client.GetSessionID(), true, false)
+	addOutboundInspectionCertificateRes, err := apiCall(client, "add-outbound-inspection-certificate", outboundInspectionCertificate, client.GetSessionID(), true, false)
 	if err != nil || !addOutboundInspectionCertificateRes.Success {
 		if addOutboundInspectionCertificateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addOutboundInspectionCertificateRes.ErrorMsg)
@@ -161,7 +161,7 @@ func readManagementOutboundInspectionCertificate(d *schema.ResourceData, m inter
 		"uid": d.Id(),
 	}
 
-	showOutboundInspectionCertificateRes, err := client.ApiCall("show-outbound-inspection-certificate", payload, client.GetSessionID(), true, false)
+	showOutboundInspectionCertificateRes, err := apiCall(client, "show-outbound-inspection-certificate", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -309,7 +309,7 @@ func updateManagementOutboundInspectionCertificate(d *schema.ResourceData, m int
 
 	log.Println("Update OutboundInspectionCertificate - Map = ", outboundInspectionCertificate)
 
-	updateOutboundInspectionCertificateRes, err := client.ApiCall("set-outbound-inspection-certificate", outboundInspectionCertificate, client.GetSessionID(), true, false)
+	updateOutboundInspectionCertificateRes, err := apiCall(client, "set-outbound-inspection-certificate", outboundInspectionCertificate, client.GetSessionID(), true, false)
 	if err != nil || !updateOutboundInspectionCertificateRes.Success {
 		if updateOutboundInspectionCertificateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateOutboundInspectionCertificateRes.ErrorMsg)
@@ -330,7 +330,7 @@ func deleteManagementOutboundInspectionCertificate(d *schema.ResourceData, m int
 
 	log.Println("Delete OutboundInspectionCertificate")
 
-	deleteOutboundInspectionCertificateRes, err := client.ApiCall("delete-outbound-inspection-certificate", outboundInspectionCertificatePayload, client.GetSessionID(), true, false)
+	deleteOutboundInspectionCertificateRes, err := apiCall(client, "delete-outbound-inspection-certificate", outboundInspectionCertificatePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteOutboundInspectionCertificateRes.Success {
 		if deleteOutboundInspectionCertificateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteOutboundInspectionCertificateRes.ErrorMsg)
@@ -125,7 +125,7 @@ func createManagementOverrideCategorization(d *schema.ResourceData, m interface{
 
 	log.Println("Create OverrideCategorization - Map = ", overrideCategorization)
 
-	addOverrideCategorizationRes, err := client.ApiCall("add-override-categorization", overrideCategorization, client.GetSessionID(), true, false)
+	addOverrideCategorizationRes, err := apiCall(client, "add-override-categorization", overrideCategorization, client.GetSessionID(), true, false)
 	if err != nil || !addOverrideCategorizationRes.Success {
 		if addOverrideCategorizationRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addOverrideCategorizationRes.ErrorMsg)
@@ -150,7 +150,7 @@ func readManagementOverrideCategorization(d *schema.ResourceData, m interface{})
 		payload["uid"] = d.Id()
 	}
 
-	showOverrideCategorizationRes, err := client.ApiCall("show-override-categorization", payload, client.GetSessionID(), true, false)
+	showOverrideCategorizationRes, err := apiCall(client, "show-override-categorization", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -293,7 +293,7 @@ func updateManagementOverrideCategorization(d *schema.ResourceData, m interface{
 
 	log.Println("Update OverrideCategorization - Map = ", overrideCategorization)
 
-	updateOverrideCategorizationRes, err := client.ApiCall("set-override-categorization", overrideCategorization, client.GetSessionID(), true, false)
+	updateOverrideCategorizationRes, err := apiCall(client, "set-override-categorization", overrideCategorization, client.GetSessionID(), true, false)
 	if err != nil || !updateOverrideCategorizationRes.Success {
 		if updateOverrideCategorizationRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateOverrideCategorizationRes.ErrorMsg)
@@ -314,7 +314,7 @@ func deleteManagementOverrideCategorization(d *schema.ResourceData, m interface{
 
 	log.Println("Delete OverrideCategorization")
 
-	deleteOverrideCategorizationRes, err := client.ApiCall("delete-override-categorization", overrideCategorizationPayload, client.GetSessionID(), true, false)
+	deleteOverrideCategorizationRes, err := apiCall(client, "delete-override-categorization", overrideCategorizationPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteOverrideCategorizationRes.Success {
 		if deleteOverrideCategorizationRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteOverrideCategorizationRes.ErrorMsg)
@@ -188,7 +188,7 @@ func createManagementPackage(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Package - Map = ", _package)
 
-	addPackageRes, err := client.ApiCall("add-package", _package, client.GetSessionID(), true, client.IsProxyUsed())
+	addPackageRes, err := apiCall(client, "add-package", _package, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addPackageRes.Success {
 		if addPackageRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addPackageRes.ErrorMsg)
@@ -209,7 +209,7 @@ func readManagementPackage(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showPackageRes, err := client.ApiCall("show-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showPackageRes, err := apiCall(client, "show-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -437,7 +437,7 @@ func updateManagementPackage(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Package - Map = ", _package)
-	updatePackageRes, err := client.ApiCall("set-package", _package, client.GetSessionID(), true, client.IsProxyUsed())
+	updatePackageRes, err := apiCall(client, "set-package", _package, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updatePackageRes.Success {
 		if updatePackageRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updatePackageRes.ErrorMsg)
@@ -463,7 +463,7 @@ func deleteManagementPackage(d *schema.ResourceData, m interface{}) error {
 		packagePayload["ignore-warnings"] = v.(bool)
 	}
 
-	deletePackageRes, err := client.ApiCall("delete-package", packagePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deletePackageRes, err := apiCall(client, "delete-package", packagePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deletePackageRes.Success {
 		if deletePackageRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deletePackageRes.ErrorMsg)
@@ -205,7 +205,7 @@ func createManagementPasscodeProfile(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Create PasscodeProfile - Map = ", passcodeProfile)
 
-	addPasscodeProfileRes, err := client.ApiCall("add-passcode-profile", passcodeProfile, client.GetSessionID(), true, false)
+	addPasscodeProfileRes, err := apiCall(client, "add-passcode-profile", passcodeProfile, client.GetSessionID(), true, false)
 	if err != nil || !addPasscodeProfileRes.Success {
 		if addPasscodeProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addPasscodeProfileRes.ErrorMsg)
@@ -226,7 +226,7 @@ func readManagementPasscodeProfile(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showPasscodeProfileRes, err := client.ApiCall("show-passcode-profile", payload, client.GetSessionID(), true, false)
+	showPasscodeProfileRes, err := apiCall(client, "show-passcode-profile", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -418,7 +418,7 @@ func updateManagementPasscodeProfile(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Update PasscodeProfile - Map = ", passcodeProfile)
 
-	updatePasscodeProfileRes, err := client.ApiCall("set-passcode-profile", passcodeProfile, client.GetSessionID(), true, false)
+	updatePasscodeProfileRes, err := apiCall(client, "set-passcode-profile", passcodeProfile, client.GetSessionID(), true, false)
 	if err != nil || !updatePasscodeProfileRes.Success {
 		if updatePasscodeProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updatePasscodeProfileRes.ErrorMsg)
@@ -439,7 +439,7 @@ func deleteManagementPasscodeProfile(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Delete PasscodeProfile")
 
-	deletePasscodeProfileRes, err := client.ApiCall("delete-passcode-profile", passcodeProfilePayload, client.GetSessionID(), true, false)
+	deletePasscodeProfileRes, err := apiCall(client, "delete-passcode-profile", passcodeProfilePayload, client.GetSessionID(), true, false)
 	if err != nil || !deletePasscodeProfileRes.Success {
 		if deletePasscodeProfileRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deletePasscodeProfileRes.ErrorMsg)
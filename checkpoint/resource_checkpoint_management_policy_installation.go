@@ -0,0 +1,642 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Install options of the policy installation, the arguments of install-policy. A change of an option
+// installs the policy on all targets.
+var policyInstallationOptions = map[string]string{
+	"access":                                 "access",
+	"desktop_security":                       "desktop-security",
+	"qos":                                    "qos",
+	"threat_prevention":                      "threat-prevention",
+	"install_on_all_cluster_members_or_fail": "install-on-all-cluster-members-or-fail",
+	"ignore_warnings":                        "ignore-warnings",
+}
+
+func resourceManagementPolicyInstallation() *schema.Resource {
+	return &schema.Resource{
+		Create:        createManagementPolicyInstallation,
+		Read:          readManagementPolicyInstallation,
+		Update:        updateManagementPolicyInstallation,
+		Delete:        deleteManagementPolicyInstallation,
+		CustomizeDiff: customizeDiffManagementPolicyInstallation,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"policy_package": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Policy Package to be installed.",
+			},
+			"targets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Targets to install the policy on. Targets may be identified by their name, or object unique identifier.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the Access Control policy. By default, the value is true if Access Control policy is enabled on the input policy package, otherwise false.",
+			},
+			"desktop_security": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the Desktop Security policy. By default, the value is true if desktop security policy is enabled on the input policy package, otherwise false.",
+			},
+			"qos": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the QoS policy. By default, the value is true if Quality-of-Service policy is enabled on the input policy package, otherwise false.",
+			},
+			"threat_prevention": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the Threat Prevention policy. By default, the value is true if Threat Prevention policy is enabled on the input policy package, otherwise false.",
+			},
+			"install_on_all_cluster_members_or_fail": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Relevant for the gateway clusters. If true, the policy is installed on all the cluster members. If the installation on a cluster member fails, don't install on that cluster.",
+			},
+			"ignore_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Install policy ignoring policy mismatch warnings.",
+			},
+			"max_revision_lookups": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultPolicyInstallationRevisionLookups,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of revisions whose changes are read (show-changes) on each refresh. The changes of a revision are read once per provider run. Targets installed before the last revision read are installed again.",
+			},
+			"latest_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest published revision (published session UID) which changed the Policy Package, its layers, their rules or the objects the rules use. When none changed them since the package was installed on the targets, the revision installed first.",
+			},
+			"installed": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Installed policy per target.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target as configured in targets.",
+						},
+						"target_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target name.",
+						},
+						"target_uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target unique identifier.",
+						},
+						"policy_package": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installed Policy Package. Empty when no policy is installed.",
+						},
+						"installation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installation date in ISO 8601 format.",
+						},
+						"revision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installed revision, the latest revision published before the installation.",
+						},
+						"up_to_date": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the target runs the Policy Package, and no revision changed the package, its layers, their rules or the objects the rules use since its installation.",
+						},
+					},
+				},
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last install-policy asynchronous task unique identifier.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last install-policy task status.",
+			},
+			"targets_status": installTargetsStatusSchema(),
+		},
+	}
+}
+
+func createManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	err := installPolicyOnTargets(d, client, d.Get("targets").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate))
+	if d.Id() == "" {
+		return err
+	}
+	if readErr := readManagementPolicyInstallation(d, m); readErr != nil && err == nil {
+		return readErr
+	}
+	return err
+}
+
+func readManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	installed, latestRevision, err := policyInstallationState(client, d.Get("policy_package").(string), d.Get("targets").(*schema.Set).List(), d.Get("max_revision_lookups").(int))
+	if err != nil {
+		return err
+	}
+	_ = d.Set("latest_revision", latestRevision)
+	_ = d.Set("installed", installed)
+	return nil
+}
+
+func updateManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	targets := d.Get("targets").(*schema.Set).List()
+	if !d.HasChange("policy_package") && !policyInstallationOptionsChanged(d) {
+		// only targets which don't run the latest revision of the package are installed
+		installed, _, err := policyInstallationState(client, d.Get("policy_package").(string), targets, d.Get("max_revision_lookups").(int))
+		if err != nil {
+			return err
+		}
+		targets = outdatedTargets(installed)
+	}
+
+	if len(targets) > 0 {
+		if err := installPolicyOnTargets(d, client, targets, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return readManagementPolicyInstallation(d, m)
+}
+
+func deleteManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	// installed policies can't be uninstalled, the installation is only removed from the state
+	log.Printf("[INFO] policy %s stays installed on the targets", d.Get("policy_package").(string))
+	d.SetId("")
+	return nil
+}
+
+// customizeDiffManagementPolicyInstallation plans an install when targets of the installation don't
+// run the latest revision of the package, as read on refresh, or when the installation changed.
+func customizeDiffManagementPolicyInstallation(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	keys := []string{"policy_package", "targets"}
+	for option := range policyInstallationOptions {
+		keys = append(keys, option)
+	}
+	installed, _ := diff.Get("installed").([]interface{})
+	if len(outdatedTargets(installed)) == 0 && !diff.HasChanges(keys...) {
+		return nil
+	}
+	for _, key := range []string{"installed", "task_id", "status", "targets_status"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func policyInstallationOptionsChanged(d *schema.ResourceData) bool {
+	for option := range policyInstallationOptions {
+		if d.HasChange(option) {
+			return true
+		}
+	}
+	return false
+}
+
+// outdatedTargets returns the targets of the installed policies which aren't up to date.
+func outdatedTargets(installed []interface{}) []interface{} {
+	targets := make([]interface{}, 0)
+	for _, v := range installed {
+		target, ok := v.(map[string]interface{})
+		if ok && !target["up_to_date"].(bool) {
+			targets = append(targets, target["target"])
+		}
+	}
+	return targets
+}
+
+// installPolicyOnTargets installs the policy on the targets and sets the task status in the state.
+func installPolicyOnTargets(d *schema.ResourceData, client *checkpoint.ApiClient, targets []interface{}, timeout time.Duration) error {
+	payload := map[string]interface{}{
+		"policy-package": d.Get("policy_package").(string),
+		"targets":        targets,
+	}
+	for option, parameter := range policyInstallationOptions {
+		if v, ok := d.GetOkExists(option); ok {
+			payload[parameter] = v.(bool)
+		}
+	}
+
+	installPolicyRes, err := apiCall(client, "install-policy", payload, client.GetSessionID(), false, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf("failed to install policy: %s", err)
+	}
+	if !installPolicyRes.Success {
+		return fmt.Errorf("%s", installPolicyRes.ErrorMsg)
+	}
+
+	taskRes, err := HandleTaskCreate(context.Background(), client, "install-policy", installPolicyRes, true, timeout)
+	if err != nil {
+		return fmt.Errorf("install-policy task polling failed: %s", err)
+	}
+
+	if d.Id() == "" {
+		d.SetId("policy-installation-" + acctest.RandString(10))
+	}
+	_ = d.Set("task_id", taskRes.TaskID)
+	_ = d.Set("status", taskRes.Status)
+
+	targetsStatus := make([]interface{}, 0)
+	if taskRes.Completed {
+		if taskData, err := showTaskFull(client, taskRes.TaskID); err == nil {
+			targetsStatus = taskTargetsStatus(taskData)
+		} else {
+			log.Printf("[WARN] failed to read install-policy task details: %s", err)
+		}
+	}
+	_ = d.Set("targets_status", targetsStatus)
+
+	if !taskRes.IsSuccess() {
+		return fmt.Errorf("%s", taskFailureMessage("install-policy", taskRes, targetsStatus))
+	}
+	return nil
+}
+
+// defaultPolicyInstallationRevisionLookups is the default maximum number of revisions whose changes
+// are read on each read of a policy installation. Each lookup is a show-changes task.
+const defaultPolicyInstallationRevisionLookups = 50
+
+// Policy objects changed by each published revision, by client and revision. Published revisions don't
+// change, so their changes are read once.
+var revisionPolicyChanges sync.Map
+
+type revisionKey struct {
+	client   *checkpoint.ApiClient
+	revision string
+}
+
+// policyInstallationState returns the installed policy of the targets and the latest revision of the
+// package. The installed revision of a target is the latest revision published before its policy was
+// installed, since install-policy installs the published revision. A target is up to date when no
+// revision changed the package, its layers, their rules or the objects the rules use since the
+// installation. Up to maxLookups revisions which weren't read before are read.
+func policyInstallationState(client *checkpoint.ApiClient, policyPackage string, targets []interface{}, maxLookups int) ([]interface{}, string, error) {
+	policyObjects, err := packagePolicyObjects(client, policyPackage)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read policy package %s: %s", policyPackage, err)
+	}
+
+	sessions, err := showSessions(client, true)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read revisions: %s", err)
+	}
+	revisions := make([]map[string]interface{}, 0)
+	for _, session := range sessions {
+		if getString(session, "state") == "published" {
+			revisions = append(revisions, session)
+		}
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisionPublishTime(revisions[i]) > revisionPublishTime(revisions[j])
+	})
+
+	gateways, err := showGatewaysAndServers(client)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read installed policies: %s", err)
+	}
+
+	configured := make([]string, 0, len(targets))
+	for _, target := range targets {
+		configured = append(configured, target.(string))
+	}
+	sort.Strings(configured)
+
+	installed := make([]interface{}, 0, len(configured))
+	installationTimes := make([]float64, 0, len(configured))
+	firstInstallation := -1.0
+	for _, target := range configured {
+		gateway := gateways[target]
+		if gateway == nil {
+			return nil, "", fmt.Errorf("target %s not found in show-gateways-and-servers", target)
+		}
+		installedPackage, installationDate, installationTime := gatewayInstalledPolicy(gateway)
+		revision := ""
+		if installedPackage != "" {
+			for _, r := range revisions {
+				if revisionPublishTime(r) <= installationTime {
+					revision = getString(r, "uid")
+					break
+				}
+			}
+		}
+		if installedPackage == policyPackage && (firstInstallation < 0 || installationTime < firstInstallation) {
+			firstInstallation = installationTime
+		}
+		installationTimes = append(installationTimes, installationTime)
+		installed = append(installed, map[string]interface{}{
+			"target":            target,
+			"target_name":       getString(gateway, "name"),
+			"target_uid":        getString(gateway, "uid"),
+			"policy_package":    installedPackage,
+			"installation_date": installationDate,
+			"revision":          revision,
+		})
+	}
+
+	latestRevision, changedSince, err := latestPolicyRevision(client, revisions, policyObjects, firstInstallation, maxLookups)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read revision changes: %s", err)
+	}
+	for i, v := range installed {
+		target := v.(map[string]interface{})
+		target["up_to_date"] = target["policy_package"] == policyPackage && installationTimes[i] >= changedSince
+		if latestRevision == "" && target["up_to_date"].(bool) && installationTimes[i] == firstInstallation {
+			// the package didn't change since it was first installed on the targets
+			latestRevision = target["revision"].(string)
+		}
+	}
+	return installed, latestRevision, nil
+}
+
+// latestPolicyRevision returns the latest revision which changed the policy objects of a package, and
+// the time since which installations of the package are up to date. Revisions are read from the latest
+// one until one changed the policy, or until the first installation of the package on the targets,
+// with up to maxLookups revisions read. When no revision changed the policy, an empty revision is
+// returned, and when the lookups end before, installations before the last revision read aren't up
+// to date.
+func latestPolicyRevision(client *checkpoint.ApiClient, revisions []map[string]interface{}, policyObjects map[string]bool, firstInstallation float64, maxLookups int) (string, float64, error) {
+	lookups := 0
+	for _, revision := range revisions {
+		publishTime := revisionPublishTime(revision)
+		if firstInstallation >= 0 && publishTime <= firstInstallation {
+			return "", 0, nil
+		}
+		key := revisionKey{client: client, revision: getString(revision, "uid")}
+		changes, ok := revisionPolicyChanges.Load(key)
+		if !ok {
+			if lookups == maxLookups {
+				log.Printf("[WARN] changes of revisions before %s weren't read, targets installed before it are reinstalled", key.revision)
+				return "", publishTime, nil
+			}
+			lookups++
+			details, err := showChanges(client, map[string]interface{}{"from-session": key.revision, "to-session": key.revision})
+			if err != nil {
+				return "", 0, err
+			}
+			changes = changedPolicyObjects(details)
+			revisionPolicyChanges.Store(key, changes)
+		}
+		for uid := range changes.(map[string]bool) {
+			if policyObjects[uid] {
+				return key.revision, publishTime, nil
+			}
+		}
+	}
+	return "", 0, nil
+}
+
+// Rulebase commands of the layers of a package, by the package field of the layers.
+var packageLayerRulebases = map[string]string{
+	"access-layers": "show-access-rulebase",
+	"threat-layers": "show-threat-rulebase",
+}
+
+// packagePolicyObjects returns the unique identifiers of the package, of its access and threat
+// layers, of their rules and sections, and of the objects the rules use, with the members of the
+// groups among them.
+func packagePolicyObjects(client *checkpoint.ApiClient, policyPackage string) (map[string]bool, error) {
+	res, err := apiCall(client, "show-package", map[string]interface{}{"name": policyPackage, "details-level": "full"}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return nil, fmt.Errorf("%s", res.ErrorMsg)
+	}
+	objects := map[string]bool{getString(res.GetData(), "uid"): true}
+	groups := make(map[string]bool) // group uid to whether its members are yet to be read
+	for key, command := range packageLayerRulebases {
+		layers, _ := res.GetData()[key].([]interface{})
+		for _, layer := range layers {
+			layer, ok := layer.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			objects[getString(layer, "uid")] = true
+			if err := addRulebaseObjects(client, command, getString(layer, "uid"), objects, groups); err != nil {
+				return nil, fmt.Errorf("failed to read layer %s: %s", getString(layer, "name"), err)
+			}
+		}
+	}
+	for pending := true; pending; {
+		pending = false
+		for uid, unread := range groups {
+			if !unread {
+				continue
+			}
+			groups[uid], pending = false, true
+			if err := addGroupMembers(client, uid, objects, groups); err != nil {
+				return nil, fmt.Errorf("failed to read group %s: %s", uid, err)
+			}
+		}
+	}
+	return objects, nil
+}
+
+// addRulebaseObjects adds the rules and sections of a layer and the objects they use to objects, and
+// the groups among the objects to groups.
+func addRulebaseObjects(client *checkpoint.ApiClient, command string, layer string, objects map[string]bool, groups map[string]bool) error {
+	const limit = 500
+	for offset := 0; ; offset += limit {
+		payload := map[string]interface{}{
+			"uid":           layer,
+			"offset":        offset,
+			"limit":         limit,
+			"details-level": "standard",
+		}
+		res, err := apiCall(client, command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+		if err != nil {
+			return err
+		}
+		if !res.Success {
+			return fmt.Errorf("%s", res.ErrorMsg)
+		}
+		data := res.GetData()
+		addObjectUids(data["rulebase"], objects)
+		dictionary, _ := data["objects-dictionary"].([]interface{})
+		for _, object := range dictionary {
+			if object, ok := object.(map[string]interface{}); ok {
+				addPolicyObject(object, objects, groups)
+			}
+		}
+
+		to, _ := data["to"].(float64)
+		total, _ := data["total"].(float64)
+		if int(to) >= int(total) || int(to) < offset+1 {
+			return nil
+		}
+	}
+}
+
+// addGroupMembers adds the members of a group to objects, and the groups among them to groups.
+func addGroupMembers(client *checkpoint.ApiClient, uid string, objects map[string]bool, groups map[string]bool) error {
+	res, err := apiCall(client, "show-object", map[string]interface{}{"uid": uid, "details-level": "full"}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return err
+	}
+	if !res.Success {
+		return fmt.Errorf("%s", res.ErrorMsg)
+	}
+	group, _ := res.GetData()["object"].(map[string]interface{})
+	// members of groups, and the included and excluded groups of groups with exclusion
+	for _, key := range []string{"members", "include", "except"} {
+		switch v := group[key].(type) {
+		case map[string]interface{}:
+			addPolicyObject(v, objects, groups)
+		case []interface{}:
+			for _, member := range v {
+				switch member := member.(type) {
+				case map[string]interface{}:
+					addPolicyObject(member, objects, groups)
+				case string:
+					objects[member] = true
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// addPolicyObject adds an object to objects, and to groups when it's a group whose members weren't
+// read yet.
+func addPolicyObject(object map[string]interface{}, objects map[string]bool, groups map[string]bool) {
+	uid := getString(object, "uid")
+	if uid == "" {
+		return
+	}
+	if _, ok := groups[uid]; !ok && strings.Contains(getString(object, "type"), "group") {
+		groups[uid] = true
+	}
+	objects[uid] = true
+}
+
+// addObjectUids adds the unique identifiers of the rules and sections of a rulebase, and of the
+// objects their fields refer to, to objects.
+func addObjectUids(value interface{}, objects map[string]bool) {
+	switch v := value.(type) {
+	case string:
+		if uidPattern.MatchString(v) {
+			objects[v] = true
+		}
+	case []interface{}:
+		for _, item := range v {
+			addObjectUids(item, objects)
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			addObjectUids(item, objects)
+		}
+	}
+}
+
+// changedPolicyObjects returns the policy objects changed by the show-changes details: the changed
+// packages and layers, and the layers and packages of the changed rules and sections.
+func changedPolicyObjects(details interface{}) map[string]bool {
+	objects := make(map[string]bool)
+	walkChanges(details, func(kind string, before map[string]interface{}, after map[string]interface{}) {
+		for _, object := range []map[string]interface{}{before, after} {
+			if object == nil {
+				continue
+			}
+			objects[getString(object, "uid")] = true
+			for _, key := range []string{"layer", "package"} {
+				switch v := object[key].(type) {
+				case string:
+					objects[v] = true
+				case map[string]interface{}:
+					objects[getString(v, "uid")] = true
+				}
+			}
+		}
+	})
+	return objects
+}
+
+// showGatewaysAndServers returns the gateways and servers by name and by uid, all pages of
+// show-gateways-and-servers.
+func showGatewaysAndServers(client *checkpoint.ApiClient) (map[string]map[string]interface{}, error) {
+	const limit = 500
+	gateways := make(map[string]map[string]interface{})
+	for offset := 0; ; offset += limit {
+		payload := map[string]interface{}{
+			"limit":         limit,
+			"offset":        offset,
+			"details-level": "full",
+		}
+		res, err := apiCall(client, "show-gateways-and-servers", payload, client.GetSessionID(), false, client.IsProxyUsed())
+		if err != nil {
+			return nil, err
+		}
+		if !res.Success {
+			return nil, fmt.Errorf("%s", res.ErrorMsg)
+		}
+		objects, _ := res.GetData()["objects"].([]interface{})
+		for _, object := range objects {
+			if gateway, ok := object.(map[string]interface{}); ok {
+				gateways[getString(gateway, "name")] = gateway
+				gateways[getString(gateway, "uid")] = gateway
+			}
+		}
+		total, _ := res.GetData()["total"].(float64)
+		to, _ := res.GetData()["to"].(float64)
+		if len(objects) == 0 || to >= total {
+			return gateways, nil
+		}
+	}
+}
+
+// gatewayInstalledPolicy returns the installed Policy Package of the gateway and its installation
+// date, in ISO 8601 format and in milliseconds. The Access Control policy is preferred, gateways
+// with only Threat Prevention installed report the Threat Prevention policy.
+func gatewayInstalledPolicy(gateway map[string]interface{}) (string, string, float64) {
+	policy, _ := gateway["policy"].(map[string]interface{})
+	for _, prefix := range []string{"access-policy", "threat-policy"} {
+		if installed, _ := policy[prefix+"-installed"].(bool); !installed {
+			continue
+		}
+		date, _ := policy[prefix+"-installation-date"].(map[string]interface{})
+		posix, _ := date["posix"].(float64)
+		return getString(policy, prefix+"-name"), getString(date, "iso-8601"), posix
+	}
+	return "", "", 0
+}
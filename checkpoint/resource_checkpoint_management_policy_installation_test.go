@@ -0,0 +1,180 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPolicyInstallation_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	now := time.Now()
+	_, layer := server.add("access-layer", map[string]interface{}{"name": "Network"})
+	server.add("package", map[string]interface{}{
+		"name":          "Standard",
+		"access-layers": []interface{}{map[string]interface{}{"uid": layer["uid"], "name": "Network"}},
+	})
+	server.addRevision("r1", fakeServerUsername, now.Add(-2*time.Hour))
+	server.changes["r1"] = []interface{}{map[string]interface{}{"uid": "rule1", "type": "access-rule", "layer": layer["uid"]}}
+	server.add("simple-gateway", map[string]interface{}{"name": "gw1"})
+	server.add("simple-gateway", map[string]interface{}{"name": "gw2"})
+
+	config := map[string]interface{}{
+		"policy_package": "Standard",
+		"targets":        []interface{}{"gw1", "gw2"},
+	}
+	state := testFakeApply(t, provider, "checkpoint_management_policy_installation", nil, config)
+	if state.Attributes["latest_revision"] != "r1" || state.Attributes["targets_status.#"] != "2" {
+		t.Fatalf("expected the installation on both targets, got %v", state.Attributes)
+	}
+	for i := 0; i < 2; i++ {
+		prefix := fmt.Sprintf("installed.%d.", i)
+		if state.Attributes[prefix+"revision"] != "r1" || state.Attributes[prefix+"up_to_date"] != "true" || state.Attributes[prefix+"policy_package"] != "Standard" {
+			t.Errorf("expected target %d to run the latest revision, got %v", i, state.Attributes)
+		}
+	}
+
+	// gw1 was installed before r2 was published, which didn't change the package
+	gw1 := server.find("simple-gateway", map[string]interface{}{"name": "gw1"})
+	gw2 := server.find("simple-gateway", map[string]interface{}{"name": "gw2"})
+	gw1["policy"].(map[string]interface{})["access-policy-installation-date"] = map[string]interface{}{"posix": now.Add(-90 * time.Minute).UnixMilli()}
+	server.addRevision("r2", fakeServerUsername, now.Add(-time.Hour))
+	server.changes["r2"] = []interface{}{map[string]interface{}{"uid": "h1", "type": "host"}}
+	gw2Installed := gw2["policy"].(map[string]interface{})["access-policy-installation-date"]
+
+	r := provider.ResourcesMap["checkpoint_management_policy_installation"]
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, provider.Meta())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if state.Attributes["latest_revision"] != "r1" || state.Attributes["installed.0.up_to_date"] != "true" || state.Attributes["installed.1.up_to_date"] != "true" {
+		t.Fatalf("expected a revision which didn't change the package not to outdate the targets, got %v", state.Attributes)
+	}
+
+	// r3 changed a rule of the package layer after gw1 was installed
+	server.addRevision("r3", fakeServerUsername, now.Add(-30*time.Minute))
+	server.changes["r3"] = []interface{}{map[string]interface{}{"uid": "rule2", "type": "access-rule", "layer": map[string]interface{}{"uid": layer["uid"], "name": "Network"}}}
+	state, diags = r.RefreshWithoutUpgrade(context.Background(), state, provider.Meta())
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if state.Attributes["latest_revision"] != "r3" || state.Attributes["installed.0.revision"] != "r1" || state.Attributes["installed.0.up_to_date"] != "false" || state.Attributes["installed.1.up_to_date"] != "true" {
+		t.Fatalf("expected gw1 to run r1 and gw2 the latest revision, got %v", state.Attributes)
+	}
+
+	state = testFakeApply(t, provider, "checkpoint_management_policy_installation", state, config)
+	if state.Attributes["installed.0.revision"] != "r3" || state.Attributes["installed.0.up_to_date"] != "true" {
+		t.Errorf("expected gw1 to be installed with the latest revision, got %v", state.Attributes)
+	}
+	if state.Attributes["targets_status.#"] != "1" || state.Attributes["targets_status.0.target_name"] != "gw1" {
+		t.Errorf("expected the policy to be installed only on the outdated target, got %v", state.Attributes)
+	}
+	if gw2["policy"].(map[string]interface{})["access-policy-installation-date"].(map[string]interface{})["posix"] != gw2Installed.(map[string]interface{})["posix"] {
+		t.Errorf("expected gw2 not to be installed again")
+	}
+
+	// no changes are planned once all targets run the latest revision, and the changes of the
+	// revisions are read once
+	calls := testCountCommand(server.commands(), "show-changes")
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), provider.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes, got %v", diff)
+	}
+	if _, diags = r.RefreshWithoutUpgrade(context.Background(), state, provider.Meta()); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if n := testCountCommand(server.commands(), "show-changes"); n != calls {
+		t.Errorf("expected the changes of the revisions to be read once, got %d more show-changes", n-calls)
+	}
+}
+
+func TestPolicyInstallationRevisionLookups_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	now := time.Now()
+	server.add("package", map[string]interface{}{"name": "Standard"})
+	for i := 0; i < defaultPolicyInstallationRevisionLookups+10; i++ {
+		server.addRevision(fmt.Sprintf("r%d", i), fakeServerUsername, now.Add(-time.Duration(i+1)*time.Minute))
+	}
+	_, gateway := server.add("simple-gateway", map[string]interface{}{"name": "gw1"})
+	gateway["policy"] = map[string]interface{}{
+		"access-policy-installed": true,
+		"access-policy-name":      "Other",
+		"access-policy-installation-date": map[string]interface{}{
+			"posix": now.Add(-time.Hour).UnixMilli(),
+		},
+	}
+
+	installed, latestRevision, err := policyInstallationState(provider.Meta().(*checkpoint.ApiClient), "Standard", []interface{}{"gw1"}, defaultPolicyInstallationRevisionLookups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := testCountCommand(server.commands(), "show-changes"); n != defaultPolicyInstallationRevisionLookups {
+		t.Errorf("expected %d revisions to be read, got %d", defaultPolicyInstallationRevisionLookups, n)
+	}
+	if latestRevision != "" || installed[0].(map[string]interface{})["up_to_date"] != false {
+		t.Errorf("expected no latest revision and the target to be outdated, got %q and %v", latestRevision, installed)
+	}
+}
+
+func TestPolicyInstallationRuleObjects_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", nil)
+	now := time.Now()
+	_, layer := server.add("access-layer", map[string]interface{}{"name": "Network"})
+	server.add("package", map[string]interface{}{
+		"name":          "Standard",
+		"access-layers": []interface{}{map[string]interface{}{"uid": layer["uid"], "name": "Network"}},
+	})
+	_, host := server.add("host", map[string]interface{}{"name": "h1"})
+	_, group := server.add("group", map[string]interface{}{"name": "g1", "members": []interface{}{"h1"}})
+	_, unused := server.add("host", map[string]interface{}{"name": "h2"})
+	server.add("access-rule", map[string]interface{}{"name": "rule1", "layer": layer["uid"], "source": []interface{}{group["uid"]}})
+	_, gateway := server.add("simple-gateway", map[string]interface{}{"name": "gw1"})
+	gateway["policy"] = map[string]interface{}{
+		"access-policy-installed": true,
+		"access-policy-name":      "Standard",
+		"access-policy-installation-date": map[string]interface{}{
+			"posix": now.Add(-time.Hour).UnixMilli(),
+		},
+	}
+	server.addRevision("r1", fakeServerUsername, now.Add(-2*time.Hour))
+	client := provider.Meta().(*checkpoint.ApiClient)
+
+	// a host no rule uses changed after the installation
+	server.addRevision("r2", fakeServerUsername, now.Add(-30*time.Minute))
+	server.changes["r2"] = []interface{}{map[string]interface{}{"uid": unused["uid"], "type": "host"}}
+	installed, _, err := policyInstallationState(client, "Standard", []interface{}{"gw1"}, defaultPolicyInstallationRevisionLookups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if installed[0].(map[string]interface{})["up_to_date"] != true {
+		t.Errorf("expected a change of an unused object not to outdate the target, got %v", installed)
+	}
+
+	// a member of a group a rule uses changed after the installation
+	server.addRevision("r3", fakeServerUsername, now.Add(-10*time.Minute))
+	server.changes["r3"] = []interface{}{map[string]interface{}{"uid": host["uid"], "type": "host"}}
+	installed, latestRevision, err := policyInstallationState(client, "Standard", []interface{}{"gw1"}, defaultPolicyInstallationRevisionLookups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latestRevision != "r3" || installed[0].(map[string]interface{})["up_to_date"] != false {
+		t.Errorf("expected a change of a group member to outdate the target, got %q and %v", latestRevision, installed)
+	}
+}
+
+func testCountCommand(calls []string, command string) int {
+	n := 0
+	for _, call := range calls {
+		if call == command {
+			n++
+		}
+	}
+	return n
+}
@@ -153,7 +153,7 @@ func createManagementProxmoxDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Create proxmoxDataCenterServer - Map = ", proxmoxDataCenterServer)
 
-	addProxmoxDataCenterServerRes, err := client.ApiCall("add-data-center-server", proxmoxDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addProxmoxDataCenterServerRes, err := apiCall(client, "add-data-center-server", proxmoxDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -167,7 +167,7 @@ func createManagementProxmoxDataCenterServer(d *schema.ResourceData, m interface
 	payload := map[string]interface{}{
 		"name": proxmoxDataCenterServer["name"],
 	}
-	showProxmoxDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showProxmoxDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -184,7 +184,7 @@ func readManagementProxmoxDataCenterServer(d *schema.ResourceData, m interface{}
 		"uid": d.Id(),
 	}
 
-	showProxmoxDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showProxmoxDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -324,7 +324,7 @@ func updateManagementProxmoxDataCenterServer(d *schema.ResourceData, m interface
 
 	log.Println("Update proxmoxDataCenterServer - Map = ", proxmoxDataCenterServer)
 
-	updateProxmoxDataCenterServerRes, err := client.ApiCall("set-data-center-server", proxmoxDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateProxmoxDataCenterServerRes, err := apiCall(client, "set-data-center-server", proxmoxDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -355,7 +355,7 @@ func deleteManagementProxmoxDataCenterServer(d *schema.ResourceData, m interface
 	}
 	log.Println("Delete proxmoxDataCenterServer")
 
-	deleteProxmoxDataCenterServerRes, err := client.ApiCall("delete-data-center-server", proxmoxDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteProxmoxDataCenterServerRes, err := apiCall(client, "delete-data-center-server", proxmoxDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteProxmoxDataCenterServerRes.Success {
 		if deleteProxmoxDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteProxmoxDataCenterServerRes.ErrorMsg)
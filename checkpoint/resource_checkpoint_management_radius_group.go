@@ -98,7 +98,7 @@ func createManagementRadiusGroup(d *schema.ResourceData, m interface{}) error {
 		radiusGroup["ignore-errors"] = v.(bool)
 	}
 
-	addRadiusGroupRes, err := client.ApiCall("add-radius-group", radiusGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	addRadiusGroupRes, err := apiCall(client, "add-radius-group", radiusGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addRadiusGroupRes.Success {
 		if addRadiusGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addRadiusGroupRes.ErrorMsg)
@@ -117,7 +117,7 @@ func readManagementRadiusGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showRadiusGroupRes, err := client.ApiCall("show-radius-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRadiusGroupRes, err := apiCall(client, "show-radius-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -221,7 +221,7 @@ func updateManagementRadiusGroup(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Radius Group - Map = ", radiusGroup)
-	setRadiusGroupRes, _ := client.ApiCall("set-radius-group", radiusGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	setRadiusGroupRes, _ := apiCall(client, "set-radius-group", radiusGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if !setRadiusGroupRes.Success {
 		return fmt.Errorf("%s", setRadiusGroupRes.ErrorMsg)
 	}
@@ -235,7 +235,7 @@ func deleteManagementRadiusGroup(d *schema.ResourceData, m interface{}) error {
 		"uid":             d.Id(),
 		"ignore-warnings": "true",
 	}
-	deleteRadiusGroupRes, _ := client.ApiCall("delete-radius-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteRadiusGroupRes, _ := apiCall(client, "delete-radius-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !deleteRadiusGroupRes.Success {
 		return fmt.Errorf("%s", deleteRadiusGroupRes.ErrorMsg)
 	}
@@ -182,7 +182,7 @@ func createManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Radius Server - Map = ", radiusServer)
 
-	addRadiusServerRes, err := client.ApiCall("add-radius-server", radiusServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addRadiusServerRes, err := apiCall(client, "add-radius-server", radiusServer, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil || !addRadiusServerRes.Success {
 		if addRadiusServerRes.ErrorMsg != "" {
@@ -203,7 +203,7 @@ func readManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showRadiusServerRes, err := client.ApiCall("show-radius-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRadiusServerRes, err := apiCall(client, "show-radius-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -385,7 +385,7 @@ func updateManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Radius Server - Map = ", radiusServer)
-	updateRadiusServerRes, err := client.ApiCall("set-radius-server", radiusServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateRadiusServerRes, err := apiCall(client, "set-radius-server", radiusServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateRadiusServerRes.Success {
 		if updateRadiusServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateRadiusServerRes.ErrorMsg)
@@ -404,7 +404,7 @@ func deleteManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 		"ignore-warnings": "true",
 	}
 
-	deleteRadiusServerRes, err := client.ApiCall("delete-radius-server", radiusServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteRadiusServerRes, err := apiCall(client, "delete-radius-server", radiusServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteRadiusServerRes.Success {
 		if deleteRadiusServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteRadiusServerRes.ErrorMsg)
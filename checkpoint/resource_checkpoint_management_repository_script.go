@@ -108,7 +108,7 @@ func createManagementRepositoryScript(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create RepositoryScript - Map = ", repositoryScript)
 
-	addRepositoryScriptRes, err := client.ApiCall("add-repository-script", repositoryScript, client.GetSessionID(), true, client.IsProxyUsed())
+	addRepositoryScriptRes, err := apiCall(client, "add-repository-script", repositoryScript, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addRepositoryScriptRes.Success {
 		if addRepositoryScriptRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addRepositoryScriptRes.ErrorMsg)
@@ -129,7 +129,7 @@ func readManagementRepositoryScript(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showRepositoryScriptRes, err := client.ApiCall("show-repository-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showRepositoryScriptRes, err := apiCall(client, "show-repository-script", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -241,7 +241,7 @@ func updateManagementRepositoryScript(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update RepositoryScript - Map = ", repositoryScript)
 
-	updateRepositoryScriptRes, err := client.ApiCall("set-repository-script", repositoryScript, client.GetSessionID(), true, client.IsProxyUsed())
+	updateRepositoryScriptRes, err := apiCall(client, "set-repository-script", repositoryScript, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateRepositoryScriptRes.Success {
 		if updateRepositoryScriptRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateRepositoryScriptRes.ErrorMsg)
@@ -269,7 +269,7 @@ func deleteManagementRepositoryScript(d *schema.ResourceData, m interface{}) err
 	}
 	log.Println("Delete RepositoryScript")
 
-	deleteRepositoryScriptRes, err := client.ApiCall("delete-repository-script", repositoryScriptPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteRepositoryScriptRes, err := apiCall(client, "delete-repository-script", repositoryScriptPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteRepositoryScriptRes.Success {
 		if deleteRepositoryScriptRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteRepositoryScriptRes.ErrorMsg)
@@ -160,7 +160,7 @@ func createManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ResourceCifs - Map = ", resourceCifs)
 
-	addResourceCifsRes, err := client.ApiCall("add-resource-cifs", resourceCifs, client.GetSessionID(), true, false)
+	addResourceCifsRes, err := apiCall(client, "add-resource-cifs", resourceCifs, client.GetSessionID(), true, false)
 	if err != nil || !addResourceCifsRes.Success {
 		if addResourceCifsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceCifsRes.ErrorMsg)
@@ -181,7 +181,7 @@ func readManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showResourceCifsRes, err := client.ApiCall("show-resource-cifs", payload, client.GetSessionID(), true, false)
+	showResourceCifsRes, err := apiCall(client, "show-resource-cifs", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -364,7 +364,7 @@ func updateManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ResourceCifs - Map = ", resourceCifs)
 
-	updateResourceCifsRes, err := client.ApiCall("set-resource-cifs", resourceCifs, client.GetSessionID(), true, false)
+	updateResourceCifsRes, err := apiCall(client, "set-resource-cifs", resourceCifs, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceCifsRes.Success {
 		if updateResourceCifsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceCifsRes.ErrorMsg)
@@ -385,7 +385,7 @@ func deleteManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ResourceCifs")
 
-	deleteResourceCifsRes, err := client.ApiCall("delete-resource-cifs", resourceCifsPayload, client.GetSessionID(), true, false)
+	deleteResourceCifsRes, err := apiCall(client, "delete-resource-cifs", resourceCifsPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteResourceCifsRes.Success {
 		if deleteResourceCifsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceCifsRes.ErrorMsg)
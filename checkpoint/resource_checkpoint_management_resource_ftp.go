@@ -174,7 +174,7 @@ func createManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ResourceFtp - Map = ", resourceFtp)
 
-	addResourceFtpRes, err := client.ApiCall("add-resource-ftp", resourceFtp, client.GetSessionID(), true, false)
+	addResourceFtpRes, err := apiCall(client, "add-resource-ftp", resourceFtp, client.GetSessionID(), true, false)
 	if err != nil || !addResourceFtpRes.Success {
 		if addResourceFtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceFtpRes.ErrorMsg)
@@ -195,7 +195,7 @@ func readManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showResourceFtpRes, err := client.ApiCall("show-resource-ftp", payload, client.GetSessionID(), true, false)
+	showResourceFtpRes, err := apiCall(client, "show-resource-ftp", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -377,7 +377,7 @@ func updateManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ResourceFtp - Map = ", resourceFtp)
 
-	updateResourceFtpRes, err := client.ApiCall("set-resource-ftp", resourceFtp, client.GetSessionID(), true, false)
+	updateResourceFtpRes, err := apiCall(client, "set-resource-ftp", resourceFtp, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceFtpRes.Success {
 		if updateResourceFtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceFtpRes.ErrorMsg)
@@ -398,7 +398,7 @@ func deleteManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ResourceFtp")
 
-	deleteResourceFtpRes, err := client.ApiCall("delete-resource-ftp", resourceFtpPayload, client.GetSessionID(), true, false)
+	deleteResourceFtpRes, err := apiCall(client, "delete-resource-ftp", resourceFtpPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteResourceFtpRes.Success {
 		if deleteResourceFtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceFtpRes.ErrorMsg)
@@ -105,7 +105,7 @@ func createManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ResourceMms - Map = ", resourceMms)
 
-	addResourceMmsRes, err := client.ApiCallSimple("add-resource-mms", resourceMms)
+	addResourceMmsRes, err := apiCallSimple(client, "add-resource-mms", resourceMms)
 	if err != nil || !addResourceMmsRes.Success {
 		if addResourceMmsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceMmsRes.ErrorMsg)
@@ -126,7 +126,7 @@ func readManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showResourceMmsRes, err := client.ApiCallSimple("show-resource-mms", payload)
+	showResourceMmsRes, err := apiCallSimple(client, "show-resource-mms", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -235,7 +235,7 @@ func updateManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ResourceMms - Map = ", resourceMms)
 
-	updateResourceMmsRes, err := client.ApiCallSimple("set-resource-mms", resourceMms)
+	updateResourceMmsRes, err := apiCallSimple(client, "set-resource-mms", resourceMms)
 	if err != nil || !updateResourceMmsRes.Success {
 		if updateResourceMmsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceMmsRes.ErrorMsg)
@@ -256,7 +256,7 @@ func deleteManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ResourceMms")
 
-	deleteResourceMmsRes, err := client.ApiCallSimple("delete-resource-mms", resourceMmsPayload)
+	deleteResourceMmsRes, err := apiCallSimple(client, "delete-resource-mms", resourceMmsPayload)
 	if err != nil || !deleteResourceMmsRes.Success {
 		if deleteResourceMmsRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceMmsRes.ErrorMsg)
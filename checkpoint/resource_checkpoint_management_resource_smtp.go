@@ -499,7 +499,7 @@ func createManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ResourceSmtp - Map = ", resourceSmtp)
 
-	addResourceSmtpRes, err := client.ApiCall("add-resource-smtp", resourceSmtp, client.GetSessionID(), true, false)
+	addResourceSmtpRes, err := apiCall(client, "add-resource-smtp", resourceSmtp, client.GetSessionID(), true, false)
 	if err != nil || !addResourceSmtpRes.Success {
 		if addResourceSmtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceSmtpRes.ErrorMsg)
@@ -520,7 +520,7 @@ func readManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showResourceSmtpRes, err := client.ApiCall("show-resource-smtp", payload, client.GetSessionID(), true, false)
+	showResourceSmtpRes, err := apiCall(client, "show-resource-smtp", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -979,7 +979,7 @@ func updateManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ResourceSmtp - Map = ", resourceSmtp)
 
-	updateResourceSmtpRes, err := client.ApiCall("set-resource-smtp", resourceSmtp, client.GetSessionID(), true, false)
+	updateResourceSmtpRes, err := apiCall(client, "set-resource-smtp", resourceSmtp, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceSmtpRes.Success {
 		if updateResourceSmtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceSmtpRes.ErrorMsg)
@@ -1000,7 +1000,7 @@ func deleteManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ResourceSmtp")
 
-	deleteResourceSmtpRes, err := client.ApiCall("delete-resource-smtp", resourceSmtpPayload, client.GetSessionID(), true, false)
+	deleteResourceSmtpRes, err := apiCall(client, "delete-resource-smtp", resourceSmtpPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteResourceSmtpRes.Success {
 		if deleteResourceSmtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceSmtpRes.ErrorMsg)
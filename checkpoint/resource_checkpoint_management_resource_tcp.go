@@ -222,7 +222,7 @@ func createManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ResourceTcp - Map = ", resourceTcp)
 
-	addResourceTcpRes, err := client.ApiCallSimple("add-resource-tcp", resourceTcp)
+	addResourceTcpRes, err := apiCallSimple(client, "add-resource-tcp", resourceTcp)
 	if err != nil || !addResourceTcpRes.Success {
 		if addResourceTcpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceTcpRes.ErrorMsg)
@@ -243,7 +243,7 @@ func readManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showResourceTcpRes, err := client.ApiCallSimple("show-resource-tcp", payload)
+	showResourceTcpRes, err := apiCallSimple(client, "show-resource-tcp", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -460,7 +460,7 @@ func updateManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ResourceTcp - Map = ", resourceTcp)
 
-	updateResourceTcpRes, err := client.ApiCallSimple("set-resource-tcp", resourceTcp)
+	updateResourceTcpRes, err := apiCallSimple(client, "set-resource-tcp", resourceTcp)
 	if err != nil || !updateResourceTcpRes.Success {
 		if updateResourceTcpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceTcpRes.ErrorMsg)
@@ -481,7 +481,7 @@ func deleteManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ResourceTcp")
 
-	deleteResourceTcpRes, err := client.ApiCallSimple("delete-resource-tcp", resourceTcpPayload)
+	deleteResourceTcpRes, err := apiCallSimple(client, "delete-resource-tcp", resourceTcpPayload)
 	if err != nil || !deleteResourceTcpRes.Success {
 		if deleteResourceTcpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceTcpRes.ErrorMsg)
@@ -617,7 +617,7 @@ func createManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ResourceUri - Map = ", resourceUri)
 
-	addResourceUriRes, err := client.ApiCall("add-resource-uri", resourceUri, client.GetSessionID(), true, false)
+	addResourceUriRes, err := apiCall(client, "add-resource-uri", resourceUri, client.GetSessionID(), true, false)
 	if err != nil || !addResourceUriRes.Success {
 		if addResourceUriRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceUriRes.ErrorMsg)
@@ -638,7 +638,7 @@ func readManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showResourceUriRes, err := client.ApiCall("show-resource-uri", payload, client.GetSessionID(), true, false)
+	showResourceUriRes, err := apiCall(client, "show-resource-uri", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1179,7 +1179,7 @@ func updateManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ResourceUri - Map = ", resourceUri)
 
-	updateResourceUriRes, err := client.ApiCall("set-resource-uri", resourceUri, client.GetSessionID(), true, false)
+	updateResourceUriRes, err := apiCall(client, "set-resource-uri", resourceUri, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceUriRes.Success {
 		if updateResourceUriRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceUriRes.ErrorMsg)
@@ -1200,7 +1200,7 @@ func deleteManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ResourceUri")
 
-	deleteResourceUriRes, err := client.ApiCall("delete-resource-uri", resourceUriPayload, client.GetSessionID(), true, false)
+	deleteResourceUriRes, err := apiCall(client, "delete-resource-uri", resourceUriPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteResourceUriRes.Success {
 		if deleteResourceUriRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceUriRes.ErrorMsg)
@@ -93,7 +93,7 @@ func createManagementResourceUriForQos(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Create ResourceUriForQos - Map = ", resourceUriForQos)
 
-	addResourceUriForQosRes, err := client.ApiCallSimple("add-resource-uri-for-qos", resourceUriForQos)
+	addResourceUriForQosRes, err := apiCallSimple(client, "add-resource-uri-for-qos", resourceUriForQos)
 	if err != nil || !addResourceUriForQosRes.Success {
 		if addResourceUriForQosRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addResourceUriForQosRes.ErrorMsg)
@@ -114,7 +114,7 @@ func readManagementResourceUriForQos(d *schema.ResourceData, m interface{}) erro
 		"uid": d.Id(),
 	}
 
-	showResourceUriForQosRes, err := client.ApiCallSimple("show-resource-uri-for-qos", payload)
+	showResourceUriForQosRes, err := apiCallSimple(client, "show-resource-uri-for-qos", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -215,7 +215,7 @@ func updateManagementResourceUriForQos(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Update ResourceUriForQos - Map = ", resourceUriForQos)
 
-	updateResourceUriForQosRes, err := client.ApiCallSimple("set-resource-uri-for-qos", resourceUriForQos)
+	updateResourceUriForQosRes, err := apiCallSimple(client, "set-resource-uri-for-qos", resourceUriForQos)
 	if err != nil || !updateResourceUriForQosRes.Success {
 		if updateResourceUriForQosRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateResourceUriForQosRes.ErrorMsg)
@@ -236,7 +236,7 @@ func deleteManagementResourceUriForQos(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Delete ResourceUriForQos")
 
-	deleteResourceUriForQosRes, err := client.ApiCallSimple("delete-resource-uri-for-qos", resourceUriForQosPayload)
+	deleteResourceUriForQosRes, err := apiCallSimple(client, "delete-resource-uri-for-qos", resourceUriForQosPayload)
 	if err != nil || !deleteResourceUriForQosRes.Success {
 		if deleteResourceUriForQosRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteResourceUriForQosRes.ErrorMsg)
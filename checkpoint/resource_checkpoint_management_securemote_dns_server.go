@@ -137,7 +137,7 @@ func createManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Create SecuremoteDnsServer - Map = ", securemoteDnsServer)
 
-	addSecuremoteDnsServerRes, err := client.ApiCallSimple("add-securemote-dns-server", securemoteDnsServer)
+	addSecuremoteDnsServerRes, err := apiCallSimple(client, "add-securemote-dns-server", securemoteDnsServer)
 	if err != nil || !addSecuremoteDnsServerRes.Success {
 		if addSecuremoteDnsServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSecuremoteDnsServerRes.ErrorMsg)
@@ -158,7 +158,7 @@ func readManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{}) er
 		"uid": d.Id(),
 	}
 
-	showSecuremoteDnsServerRes, err := client.ApiCallSimple("show-securemote-dns-server", payload)
+	showSecuremoteDnsServerRes, err := apiCallSimple(client, "show-securemote-dns-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -316,7 +316,7 @@ func updateManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{})
 
 	log.Println("Update SecuremoteDnsServer - Map = ", securemoteDnsServer)
 	if len(securemoteDnsServer) != 3 {
-		updateSecuremoteDnsServerRes, err := client.ApiCallSimple("set-securemote-dns-server", securemoteDnsServer)
+		updateSecuremoteDnsServerRes, err := apiCallSimple(client, "set-securemote-dns-server", securemoteDnsServer)
 		if err != nil || !updateSecuremoteDnsServerRes.Success {
 			if updateSecuremoteDnsServerRes.ErrorMsg != "" {
 				return fmt.Errorf("%s", updateSecuremoteDnsServerRes.ErrorMsg)
@@ -345,7 +345,7 @@ func deleteManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{})
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		securemoteDnsServerPayload["ignore-warnings"] = v.(bool)
 	}
-	deleteSecuremoteDnsServerRes, err := client.ApiCallSimple("delete-securemote-dns-server", securemoteDnsServerPayload)
+	deleteSecuremoteDnsServerRes, err := apiCallSimple(client, "delete-securemote-dns-server", securemoteDnsServerPayload)
 	if err != nil || !deleteSecuremoteDnsServerRes.Success {
 		if deleteSecuremoteDnsServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSecuremoteDnsServerRes.ErrorMsg)
@@ -103,7 +103,7 @@ func createManagementSecuridServer(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create SecuridServer - Map = ", securidServer)
 
-	addSecuridServerRes, err := client.ApiCallSimple("add-securid-server", securidServer)
+	addSecuridServerRes, err := apiCallSimple(client, "add-securid-server", securidServer)
 	if err != nil || !addSecuridServerRes.Success {
 		if addSecuridServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSecuridServerRes.ErrorMsg)
@@ -124,7 +124,7 @@ func readManagementSecuridServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showSecuridServerRes, err := client.ApiCallSimple("show-securid-server", payload)
+	showSecuridServerRes, err := apiCallSimple(client, "show-securid-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -233,7 +233,7 @@ func updateManagementSecuridServer(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update SecuridServer - Map = ", securidServer)
 
-	updateSecuridServerRes, err := client.ApiCallSimple("set-securid-server", securidServer)
+	updateSecuridServerRes, err := apiCallSimple(client, "set-securid-server", securidServer)
 	if err != nil || !updateSecuridServerRes.Success {
 		if updateSecuridServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateSecuridServerRes.ErrorMsg)
@@ -261,7 +261,7 @@ func deleteManagementSecuridServer(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete SecuridServer")
 
-	deleteSecuridServerRes, err := client.ApiCallSimple("delete-securid-server", securidServerPayload)
+	deleteSecuridServerRes, err := apiCallSimple(client, "delete-securid-server", securidServerPayload)
 	if err != nil || !deleteSecuridServerRes.Success {
 		if deleteSecuridServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSecuridServerRes.ErrorMsg)
@@ -88,7 +88,7 @@ func createManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create SecurityZone - Map = ", securityZone)
 
-	addSecurityZoneRes, err := client.ApiCall("add-security-zone", securityZone, client.GetSessionID(), true, client.IsProxyUsed())
+	addSecurityZoneRes, err := apiCall(client, "add-security-zone", securityZone, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addSecurityZoneRes.Success {
 		if addSecurityZoneRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSecurityZoneRes.ErrorMsg)
@@ -109,7 +109,7 @@ func readManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showSecurityZoneRes, err := client.ApiCall("show-security-zone", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showSecurityZoneRes, err := apiCall(client, "show-security-zone", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -205,7 +205,7 @@ func updateManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update SecurityZone - Map = ", securityZone)
 
-	updateSecurityZoneRes, err := client.ApiCall("set-security-zone", securityZone, client.GetSessionID(), true, client.IsProxyUsed())
+	updateSecurityZoneRes, err := apiCall(client, "set-security-zone", securityZone, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateSecurityZoneRes.Success {
 		if updateSecurityZoneRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateSecurityZoneRes.ErrorMsg)
@@ -232,7 +232,7 @@ func deleteManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete SecurityZone")
 
-	deleteSecurityZoneRes, err := client.ApiCall("delete-security-zone", securityZonePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteSecurityZoneRes, err := apiCall(client, "delete-security-zone", securityZonePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteSecurityZoneRes.Success {
 		if deleteSecurityZoneRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSecurityZoneRes.ErrorMsg)
@@ -78,7 +78,7 @@ func createManagementServerCertificate(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Create ServerCertificate - Map = ", serverCertificate)
 
-	addServerCertificateRes, err := client.ApiCall("add-server-certificate", serverCertificate, client.GetSessionID(), true, false)
+	addServerCertificateRes, err := apiCall(client, "add-server-certificate", serverCertificate, client.GetSessionID(), true, false)
 	if err != nil || !addServerCertificateRes.Success {
 		if addServerCertificateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServerCertificateRes.ErrorMsg)
@@ -99,7 +99,7 @@ func readManagementServerCertificate(d *schema.ResourceData, m interface{}) erro
 		"uid": d.Id(),
 	}
 
-	showServerCertificateRes, err := client.ApiCall("show-server-certificate", payload, client.GetSessionID(), true, false)
+	showServerCertificateRes, err := apiCall(client, "show-server-certificate", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -160,7 +160,7 @@ func updateManagementServerCertificate(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Update ServerCertificate - Map = ", serverCertificate)
 
-	updateServerCertificateRes, err := client.ApiCall("set-server-certificate", serverCertificate, client.GetSessionID(), true, false)
+	updateServerCertificateRes, err := apiCall(client, "set-server-certificate", serverCertificate, client.GetSessionID(), true, false)
 	if err != nil || !updateServerCertificateRes.Success {
 		if updateServerCertificateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateServerCertificateRes.ErrorMsg)
@@ -181,7 +181,7 @@ func deleteManagementServerCertificate(d *schema.ResourceData, m interface{}) er
 
 	log.Println("Delete ServerCertificate")
 
-	deleteServerCertificateRes, err := client.ApiCall("delete-server-certificate", serverCertificatePayload, client.GetSessionID(), true, false)
+	deleteServerCertificateRes, err := apiCall(client, "delete-server-certificate", serverCertificatePayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteServerCertificateRes.Success {
 		if deleteServerCertificateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteServerCertificateRes.ErrorMsg)
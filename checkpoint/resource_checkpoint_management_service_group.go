@@ -39,10 +39,11 @@ func resourceManagementServiceGroup() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -95,7 +96,7 @@ func createManagementServiceGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Service Group - Map = ", serviceGroup)
 
-	addServiceGroupRes, err := client.ApiCall("add-service-group", serviceGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceGroupRes, err := apiCall(client, "add-service-group", serviceGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceGroupRes.Success {
 		if addServiceGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceGroupRes.ErrorMsg)
@@ -116,7 +117,7 @@ func readManagementServiceGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceGroupRes, err := client.ApiCall("show-service-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceGroupRes, err := apiCall(client, "show-service-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -221,7 +222,7 @@ func updateManagementServiceGroup(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Service Group - Map = ", serviceGroup)
-	setserviceGroupRes, _ := client.ApiCall("set-service-group", serviceGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	setserviceGroupRes, _ := apiCall(client, "set-service-group", serviceGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if !setserviceGroupRes.Success {
 		return fmt.Errorf("%s", setserviceGroupRes.ErrorMsg)
 	}
@@ -240,7 +241,7 @@ func deleteManagementServiceGroup(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		payload["ignore-warnings"] = v.(bool)
 	}
-	deleteServiceGroupRes, _ := client.ApiCall("delete-service-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceGroupRes, _ := apiCall(client, "delete-service-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !deleteServiceGroupRes.Success {
 		return fmt.Errorf("%s", deleteServiceGroupRes.ErrorMsg)
 	}
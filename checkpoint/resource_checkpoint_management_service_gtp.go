@@ -572,7 +572,7 @@ func createManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ServiceGtp - Map = ", serviceGtp)
 
-	addServiceGtpRes, err := client.ApiCall("add-service-gtp", serviceGtp, client.GetSessionID(), true, false)
+	addServiceGtpRes, err := apiCall(client, "add-service-gtp", serviceGtp, client.GetSessionID(), true, false)
 	if err != nil || !addServiceGtpRes.Success {
 		if addServiceGtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceGtpRes.ErrorMsg)
@@ -593,7 +593,7 @@ func readManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceGtpRes, err := client.ApiCall("show-service-gtp", payload, client.GetSessionID(), true, false)
+	showServiceGtpRes, err := apiCall(client, "show-service-gtp", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1111,7 +1111,7 @@ func updateManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ServiceGtp - Map = ", serviceGtp)
 
-	updateServiceGtpRes, err := client.ApiCall("set-service-gtp", serviceGtp, client.GetSessionID(), true, false)
+	updateServiceGtpRes, err := apiCall(client, "set-service-gtp", serviceGtp, client.GetSessionID(), true, false)
 	if err != nil || !updateServiceGtpRes.Success {
 		if updateServiceGtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateServiceGtpRes.ErrorMsg)
@@ -1138,7 +1138,7 @@ func deleteManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete ServiceGtp")
 
-	deleteServiceGtpRes, err := client.ApiCall("delete-service-gtp", serviceGtpPayload, client.GetSessionID(), true, false)
+	deleteServiceGtpRes, err := apiCall(client, "delete-service-gtp", serviceGtpPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteServiceGtpRes.Success {
 		if deleteServiceGtpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteServiceGtpRes.ErrorMsg)
@@ -49,10 +49,11 @@ func resourceManagementServiceIcmp() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -118,7 +119,7 @@ func createManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ServiceIcmp - Map = ", serviceIcmp)
 
-	addServiceIcmpRes, err := client.ApiCall("add-service-icmp", serviceIcmp, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceIcmpRes, err := apiCall(client, "add-service-icmp", serviceIcmp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceIcmpRes.Success {
 		if addServiceIcmpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceIcmpRes.ErrorMsg)
@@ -139,7 +140,7 @@ func readManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceIcmpRes, err := client.ApiCall("show-service-icmp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceIcmpRes, err := apiCall(client, "show-service-icmp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -259,7 +260,7 @@ func updateManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ServiceIcmp - Map = ", serviceIcmp)
 
-	updateServiceIcmpRes, err := client.ApiCall("set-service-icmp", serviceIcmp, client.GetSessionID(), true, client.IsProxyUsed())
+	updateServiceIcmpRes, err := apiCall(client, "set-service-icmp", serviceIcmp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceIcmpRes.Success {
 		if updateServiceIcmpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateServiceIcmpRes.ErrorMsg)
@@ -286,7 +287,7 @@ func deleteManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete ServiceIcmp")
 
-	deleteServiceIcmpRes, err := client.ApiCall("delete-service-icmp", serviceIcmpPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceIcmpRes, err := apiCall(client, "delete-service-icmp", serviceIcmpPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteServiceIcmpRes.Success {
 		if deleteServiceIcmpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteServiceIcmpRes.ErrorMsg)
@@ -46,10 +46,11 @@ func resourceManagementServiceIcmp6() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -115,7 +116,7 @@ func createManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ServiceIcmp6 - Map = ", serviceIcmp6)
 
-	addServiceIcmp6Res, err := client.ApiCall("add-service-icmp6", serviceIcmp6, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceIcmp6Res, err := apiCall(client, "add-service-icmp6", serviceIcmp6, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceIcmp6Res.Success {
 		if addServiceIcmp6Res.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceIcmp6Res.ErrorMsg)
@@ -136,7 +137,7 @@ func readManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceIcmp6Res, err := client.ApiCall("show-service-icmp6", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceIcmp6Res, err := apiCall(client, "show-service-icmp6", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -256,7 +257,7 @@ func updateManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ServiceIcmp6 - Map = ", serviceIcmp6)
 
-	updateServiceIcmp6Res, err := client.ApiCall("set-service-icmp6", serviceIcmp6, client.GetSessionID(), true, client.IsProxyUsed())
+	updateServiceIcmp6Res, err := apiCall(client, "set-service-icmp6", serviceIcmp6, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceIcmp6Res.Success {
 		if updateServiceIcmp6Res.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateServiceIcmp6Res.ErrorMsg)
@@ -283,7 +284,7 @@ func deleteManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete ServiceIcmp6")
 
-	deleteServiceIcmp6Res, err := client.ApiCall("delete-service-icmp6", serviceIcmp6Payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceIcmp6Res, err := apiCall(client, "delete-service-icmp6", serviceIcmp6Payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteServiceIcmp6Res.Success {
 		if deleteServiceIcmp6Res.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteServiceIcmp6Res.ErrorMsg)
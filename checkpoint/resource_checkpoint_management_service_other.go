@@ -127,10 +127,11 @@ func resourceManagementServiceOther() *schema.Resource {
 				Default:     true,
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -248,7 +249,7 @@ func createManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ServiceOther - Map = ", serviceOther)
 
-	addServiceOtherRes, err := client.ApiCall("add-service-other", serviceOther, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceOtherRes, err := apiCall(client, "add-service-other", serviceOther, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceOtherRes.Success {
 		if addServiceOtherRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceOtherRes.ErrorMsg)
@@ -269,7 +270,7 @@ func readManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceOtherRes, err := client.ApiCall("show-service-other", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceOtherRes, err := apiCall(client, "show-service-other", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -497,7 +498,7 @@ func updateManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ServiceOther - Map = ", serviceOther)
 
-	updateServiceOtherRes, err := client.ApiCall("set-service-other", serviceOther, client.GetSessionID(), true, client.IsProxyUsed())
+	updateServiceOtherRes, err := apiCall(client, "set-service-other", serviceOther, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceOtherRes.Success {
 		if updateServiceOtherRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateServiceOtherRes.ErrorMsg)
@@ -525,7 +526,7 @@ func deleteManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete ServiceOther")
 
-	deleteServiceOtherRes, err := client.ApiCall("delete-service-other", serviceOtherPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceOtherRes, err := apiCall(client, "delete-service-other", serviceOtherPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteServiceOtherRes.Success {
 		if deleteServiceOtherRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteServiceOtherRes.ErrorMsg)
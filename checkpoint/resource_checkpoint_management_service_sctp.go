@@ -76,9 +76,10 @@ func resourceManagementServiceSctp() *schema.Resource {
 				Description: "Indicates whether this service is used when 'Any' is set as the rule's service and there are several service objects with the same source port and protocol.",
 			},
 			"port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Port number. To specify a port range add a hyphen between the lowest and the highest port numbers, for example 44-45.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Port number. To specify a port range add a hyphen between the lowest and the highest port numbers, for example 44-45.",
+				ValidateFunc: validatePort(),
 			},
 			"session_timeout": {
 				Type:        schema.TypeInt,
@@ -86,9 +87,10 @@ func resourceManagementServiceSctp() *schema.Resource {
 				Description: "Time (in seconds) before the session times out.",
 			},
 			"source_port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Source port number. To specify a port range add a hyphen between the lowest and the highest port numbers, for example 44-45.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Source port number. To specify a port range add a hyphen between the lowest and the highest port numbers, for example 44-45.",
+				ValidateFunc: validatePort(),
 			},
 			"sync_connections_on_cluster": {
 				Type:        schema.TypeBool,
@@ -110,10 +112,11 @@ func resourceManagementServiceSctp() *schema.Resource {
 				Default:     true,
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -219,7 +222,7 @@ func createManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create ServiceSctp - Map = ", serviceSctp)
 
-	addServiceSctpRes, err := client.ApiCall("add-service-sctp", serviceSctp, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceSctpRes, err := apiCall(client, "add-service-sctp", serviceSctp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceSctpRes.Success {
 		if addServiceSctpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceSctpRes.ErrorMsg)
@@ -240,7 +243,7 @@ func readManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceSctpRes, err := client.ApiCall("show-service-sctp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceSctpRes, err := apiCall(client, "show-service-sctp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -444,7 +447,7 @@ func updateManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update ServiceSctp - Map = ", serviceSctp)
 
-	updateServiceSctpRes, err := client.ApiCall("set-service-sctp", serviceSctp, client.GetSessionID(), true, client.IsProxyUsed())
+	updateServiceSctpRes, err := apiCall(client, "set-service-sctp", serviceSctp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceSctpRes.Success {
 		if updateServiceSctpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateServiceSctpRes.ErrorMsg)
@@ -471,7 +474,7 @@ func deleteManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete ServiceSctp")
 
-	deleteServiceSctpRes, err := client.ApiCall("delete-service-sctp", serviceSctpPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceSctpRes, err := apiCall(client, "delete-service-sctp", serviceSctpPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteServiceSctpRes.Success {
 		if deleteServiceSctpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteServiceSctpRes.ErrorMsg)
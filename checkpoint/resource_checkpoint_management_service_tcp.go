@@ -32,9 +32,10 @@ func resourceManagementServiceTcp() *schema.Resource {
 				Description: "Object name. Should be unique in the domain.",
 			},
 			"port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The number of the port used to provide this service. To specify a port range, place a hyphen between the lowest and highest port numbers, for example 44-55.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The number of the port used to provide this service. To specify a port range, place a hyphen between the lowest and highest port numbers, for example 44-55.",
+				ValidateFunc: validatePort(),
 			},
 			"aggressive_aging": {
 				Type:        schema.TypeList,
@@ -100,9 +101,10 @@ func resourceManagementServiceTcp() *schema.Resource {
 				Default:     3600,
 			},
 			"source_port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Port number for the client side service. If specified, only those Source port Numbers will be Accepted, Dropped, or Rejected during packet inspection. Otherwise, the source port is not inspected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Port number for the client side service. If specified, only those Source port Numbers will be Accepted, Dropped, or Rejected during packet inspection. Otherwise, the source port is not inspected.",
+				ValidateFunc: validatePort(),
 			},
 			"sync_connections_on_cluster": {
 				Type:        schema.TypeBool,
@@ -125,10 +127,11 @@ func resourceManagementServiceTcp() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -233,7 +236,7 @@ func createManagementServiceTcp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Service Tcp - Map = ", serviceTcp)
 
-	addServiceTcpRes, err := client.ApiCall("add-service-tcp", serviceTcp, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceTcpRes, err := apiCall(client, "add-service-tcp", serviceTcp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceTcpRes.Success {
 		if addServiceTcpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceTcpRes.ErrorMsg)
@@ -254,7 +257,7 @@ func readManagementServiceTcp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceTcpRes, err := client.ApiCall("show-service-tcp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceTcpRes, err := apiCall(client, "show-service-tcp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -499,7 +502,7 @@ func updateManagementServiceTcp(d *schema.ResourceData, m interface{}) error {
 	log.Println("Update Service Tcp - Map = ", serviceTcp)
 
 	if len(serviceTcp) != 3 {
-		setServiceTcpRes, err := client.ApiCall("set-service-tcp", serviceTcp, client.GetSessionID(), true, client.IsProxyUsed())
+		setServiceTcpRes, err := apiCall(client, "set-service-tcp", serviceTcp, client.GetSessionID(), true, client.IsProxyUsed())
 		if err != nil {
 			fmt.Errorf("%s", err.Error())
 		}
@@ -526,7 +529,7 @@ func deleteManagementServiceTcp(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		payload["ignore-warnings"] = v.(bool)
 	}
-	deleteServiceTcpRes, _ := client.ApiCall("delete-service-tcp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceTcpRes, _ := apiCall(client, "delete-service-tcp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !deleteServiceTcpRes.Success {
 		return fmt.Errorf("%s", deleteServiceTcpRes.ErrorMsg)
 	}
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"os"
 	"testing"
 )
 
@@ -15,14 +14,12 @@ func TestAccCheckpointManagementServiceTcp_basic(t *testing.T) {
 	resourceName := "checkpoint_management_service_tcp.test"
 	objName := "tfTestManagementServiceTcp_" + acctest.RandString(6)
 
-	context := os.Getenv("CHECKPOINT_CONTEXT")
+	context := testAccContext(t)
 	if context != "web_api" {
 		t.Skip("Skipping management test")
-	} else if context == "" {
-		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
 	}
 
-	resource.Test(t, resource.TestCase{
+	testAccTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckpointServiceTcpDestroy,
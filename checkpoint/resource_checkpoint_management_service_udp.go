@@ -90,9 +90,10 @@ func resourceManagementServiceUdp() *schema.Resource {
 				Description: "Indicates whether this service is a Data Domain service which has been overridden.",
 			},
 			"port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The number of the port used to provide this service. To specify a port range, place a hyphen between the lowest and highest port numbers, for example 44-55.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The number of the port used to provide this service. To specify a port range, place a hyphen between the lowest and highest port numbers, for example 44-55.",
+				ValidateFunc: validatePort(),
 			},
 			"protocol": {
 				Type:        schema.TypeString,
@@ -106,9 +107,10 @@ func resourceManagementServiceUdp() *schema.Resource {
 				Default:     40,
 			},
 			"source_port": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Port number for the client side service. If specified, only those Source port Numbers will be Accepted, Dropped, or Rejected during packet inspection. Otherwise, the source port is not inspected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Port number for the client side service. If specified, only those Source port Numbers will be Accepted, Dropped, or Rejected during packet inspection. Otherwise, the source port is not inspected.",
+				ValidateFunc: validatePort(),
 			},
 			"sync_connections_on_cluster": {
 				Type:        schema.TypeBool,
@@ -131,10 +133,11 @@ func resourceManagementServiceUdp() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -242,7 +245,7 @@ func createManagementServiceUdp(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Service Udp - Map = ", serviceUdp)
 
-	addServiceUdpRes, err := client.ApiCall("add-service-udp", serviceUdp, client.GetSessionID(), true, client.IsProxyUsed())
+	addServiceUdpRes, err := apiCall(client, "add-service-udp", serviceUdp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceUdpRes.Success {
 		if addServiceUdpRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addServiceUdpRes.ErrorMsg)
@@ -263,7 +266,7 @@ func readManagementServiceUdp(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showServiceUdpRes, err := client.ApiCall("show-service-udp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showServiceUdpRes, err := apiCall(client, "show-service-udp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -522,7 +525,7 @@ func updateManagementServiceUdp(d *schema.ResourceData, m interface{}) error {
 	log.Println("Update Service Udp - Map = ", serviceUdp)
 
 	if len(serviceUdp) != 3 {
-		setServiceUdpRes, err := client.ApiCall("set-service-udp", serviceUdp, client.GetSessionID(), true, client.IsProxyUsed())
+		setServiceUdpRes, err := apiCall(client, "set-service-udp", serviceUdp, client.GetSessionID(), true, client.IsProxyUsed())
 		if err != nil {
 			fmt.Errorf("%s", err.Error())
 		}
@@ -549,7 +552,7 @@ func deleteManagementServiceUdp(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		payload["ignore-warnings"] = v.(bool)
 	}
-	deleteServiceUdpRes, _ := client.ApiCall("delete-service-udp", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteServiceUdpRes, _ := apiCall(client, "delete-service-udp", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !deleteServiceUdpRes.Success {
 		return fmt.Errorf("%s", deleteServiceUdpRes.ErrorMsg)
 	}
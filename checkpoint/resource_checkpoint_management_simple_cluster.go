@@ -792,9 +792,10 @@ func resourceManagementSimpleCluster() *schema.Resource {
 							Description: "IPv6 address.",
 						},
 						"hide_behind": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Hide behind method. This parameter is forbidden in case \"method\" parameter is \"static\".",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Hide behind method. This parameter is forbidden in case \"method\" parameter is \"static\".",
+							ValidateFunc: validateStringValue("gateway", "ip-address"),
 						},
 						"install_on": {
 							Type:        schema.TypeString,
@@ -802,9 +803,10 @@ func resourceManagementSimpleCluster() *schema.Resource {
 							Description: "Which gateway should apply the NAT translation.",
 						},
 						"method": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "NAT translation method.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "NAT translation method.",
+							ValidateFunc: validateStringValue("hide", "static"),
 						},
 					},
 				},
@@ -1102,9 +1104,10 @@ func resourceManagementSimpleCluster() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"action": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "If packets will be rejected (the Prevent option) or whether the packets will be monitored (the Detect option).",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Description:  "If packets will be rejected (the Prevent option) or whether the packets will be monitored (the Detect option).",
+										ValidateFunc: validateStringValue("prevent", "detect"),
 									},
 								},
 							},
@@ -1146,10 +1149,11 @@ func resourceManagementSimpleCluster() *schema.Resource {
 							},
 						},
 						"topology": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Topology.",
-							Default:     "automatic",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Topology.",
+							Default:      "automatic",
+							ValidateFunc: validateStringValue("automatic", "external", "internal"),
 						},
 						"topology_settings": {
 							Type:        schema.TypeList,
@@ -1182,10 +1186,11 @@ func resourceManagementSimpleCluster() *schema.Resource {
 							Description: "Shows the automatic topology calculation.",
 						},
 						"color": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "black",
-							Description: "Color of the object. Should be one of existing colors.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "black",
+							Description:  "Color of the object. Should be one of existing colors.",
+							ValidateFunc: validateColor(),
 						},
 						"comments": {
 							Type:        schema.TypeString,
@@ -1819,10 +1824,11 @@ func resourceManagementSimpleCluster() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -2980,7 +2986,7 @@ func createManagementSimpleCluster(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create Simple Cluster - Map = ", cluster)
 
-	addClusterRes, err := client.ApiCall("add-simple-cluster", cluster, client.GetSessionID(), true, client.IsProxyUsed())
+	addClusterRes, err := apiCall(client, "add-simple-cluster", cluster, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -2993,7 +2999,7 @@ func createManagementSimpleCluster(d *schema.ResourceData, m interface{}) error
 	}
 
 	// add-simple-cluster returns task-id. Call show-simple-cluster for object uid.
-	showClusterRes, err := client.ApiCall("show-simple-cluster", map[string]interface{}{"name": d.Get("name")}, client.GetSessionID(), true, client.IsProxyUsed())
+	showClusterRes, err := apiCall(client, "show-simple-cluster", map[string]interface{}{"name": d.Get("name")}, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -3013,7 +3019,7 @@ func readManagementSimpleCluster(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showClusterRes, err := client.ApiCall("show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showClusterRes, err := apiCall(client, "show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -3033,7 +3039,7 @@ func readManagementSimpleCluster(d *schema.ResourceData, m interface{}) error {
 			totalInterfaces := int(total.(float64))
 			if totalInterfaces > 50 {
 				payload["limit-interfaces"] = totalInterfaces
-				showClusterRes, err := client.ApiCall("show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
+				showClusterRes, err := apiCall(client, "show-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
 				if err != nil {
 					return fmt.Errorf("%s", err.Error())
 				}
@@ -5316,7 +5322,7 @@ func updateManagementSimpleCluster(d *schema.ResourceData, m interface{}) error
 	log.Println("Update Simple Cluster - Map = ", cluster)
 
 	if len(cluster) != 3 {
-		updateSimpleClusterRes, err := client.ApiCall("set-simple-cluster", cluster, client.GetSessionID(), true, client.IsProxyUsed())
+		updateSimpleClusterRes, err := apiCall(client, "set-simple-cluster", cluster, client.GetSessionID(), true, client.IsProxyUsed())
 		if err != nil {
 			return fmt.Errorf("%s", err.Error())
 		}
@@ -5350,7 +5356,7 @@ func deleteManagementSimpleCluster(d *schema.ResourceData, m interface{}) error
 	if v, ok := d.GetOkExists("ignore_errors"); ok {
 		payload["ignore-errors"] = v
 	}
-	deleteClusterRes, err := client.ApiCall("delete-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteClusterRes, err := apiCall(client, "delete-simple-cluster", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteClusterRes.Success {
 		if deleteClusterRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteClusterRes.ErrorMsg)
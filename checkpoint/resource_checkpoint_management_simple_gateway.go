@@ -782,9 +782,10 @@ func resourceManagementSimpleGateway() *schema.Resource {
 							Description: "IPv6 address.",
 						},
 						"hide_behind": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Hide behind method. This parameter is forbidden in case \"method\" parameter is \"static\".",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Hide behind method. This parameter is forbidden in case \"method\" parameter is \"static\".",
+							ValidateFunc: validateStringValue("gateway", "ip-address"),
 						},
 						"install_on": {
 							Type:        schema.TypeString,
@@ -792,9 +793,10 @@ func resourceManagementSimpleGateway() *schema.Resource {
 							Description: "Which gateway should apply the NAT translation.",
 						},
 						"method": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "NAT translation method.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "NAT translation method.",
+							ValidateFunc: validateStringValue("hide", "static"),
 						},
 					},
 				},
@@ -1087,9 +1089,10 @@ func resourceManagementSimpleGateway() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"action": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "If packets will be rejected (the Prevent option) or whether the packets will be monitored (the Detect option).",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Description:  "If packets will be rejected (the Prevent option) or whether the packets will be monitored (the Detect option).",
+										ValidateFunc: validateStringValue("prevent", "detect"),
 									},
 								},
 							},
@@ -1121,10 +1124,11 @@ func resourceManagementSimpleGateway() *schema.Resource {
 							},
 						},
 						"topology": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Topology.",
-							Default:     "automatic",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Topology.",
+							Default:      "automatic",
+							ValidateFunc: validateStringValue("automatic", "external", "internal"),
 						},
 						"topology_settings": {
 							Type:        schema.TypeList,
@@ -1157,10 +1161,11 @@ func resourceManagementSimpleGateway() *schema.Resource {
 							Description: "Shows the automatic topology calculation.",
 						},
 						"color": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "black",
-							Description: "Color of the object. Should be one of existing colors.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "black",
+							Description:  "Color of the object. Should be one of existing colors.",
+							ValidateFunc: validateColor(),
 						},
 						"comments": {
 							Type:        schema.TypeString,
@@ -1960,10 +1965,11 @@ func resourceManagementSimpleGateway() *schema.Resource {
 				},
 			},
 			"color": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Color of the object. Should be one of existing colors.",
-				Default:     "black",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Color of the object. Should be one of existing colors.",
+				Default:      "black",
+				ValidateFunc: validateColor(),
 			},
 			"comments": {
 				Type:        schema.TypeString,
@@ -3209,7 +3215,7 @@ func createManagementSimpleGateway(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create Simple Gateway - Map = ", gateway)
 
-	addGatewayRes, err := client.ApiCall("add-simple-gateway", gateway, client.GetSessionID(), true, client.IsProxyUsed())
+	addGatewayRes, err := apiCall(client, "add-simple-gateway", gateway, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGatewayRes.Success {
 		if addGatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addGatewayRes.ErrorMsg)
@@ -3229,7 +3235,7 @@ func readManagementSimpleGateway(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showGatewayRes, err := client.ApiCall("show-simple-gateway", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showGatewayRes, err := apiCall(client, "show-simple-gateway", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -5633,7 +5639,7 @@ func updateManagementSimpleGateway(d *schema.ResourceData, m interface{}) error
 	log.Println("Update Simple Gateway - Map = ", gateway)
 
 	if len(gateway) != 2 {
-		updateSimpleGatewayRes, err := client.ApiCall("set-simple-gateway", gateway, client.GetSessionID(), true, client.IsProxyUsed())
+		updateSimpleGatewayRes, err := apiCall(client, "set-simple-gateway", gateway, client.GetSessionID(), true, client.IsProxyUsed())
 		if err != nil || !updateSimpleGatewayRes.Success {
 			if updateSimpleGatewayRes.ErrorMsg != "" {
 				return fmt.Errorf("%s", updateSimpleGatewayRes.ErrorMsg)
@@ -5663,7 +5669,7 @@ func deleteManagementSimpleGateway(d *schema.ResourceData, m interface{}) error
 	if v, ok := d.GetOk("ignore_errors"); ok {
 		gatewayPayload["ignore-errors"] = v
 	}
-	deleteGatewayRes, err := client.ApiCall("delete-simple-gateway", gatewayPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteGatewayRes, err := apiCall(client, "delete-simple-gateway", gatewayPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteGatewayRes.Success {
 		if deleteGatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteGatewayRes.ErrorMsg)
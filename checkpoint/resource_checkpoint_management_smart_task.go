@@ -398,7 +398,7 @@ func createManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create SmartTask - Map = ", smartTask)
 
-	addSmartTaskRes, err := client.ApiCall("add-smart-task", smartTask, client.GetSessionID(), true, false)
+	addSmartTaskRes, err := apiCall(client, "add-smart-task", smartTask, client.GetSessionID(), true, false)
 	if err != nil || !addSmartTaskRes.Success {
 		if addSmartTaskRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSmartTaskRes.ErrorMsg)
@@ -419,7 +419,7 @@ func readManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showSmartTaskRes, err := client.ApiCall("show-smart-task", payload, client.GetSessionID(), true, false)
+	showSmartTaskRes, err := apiCall(client, "show-smart-task", payload, client.GetSessionID(), true, false)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -784,7 +784,7 @@ func updateManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update SmartTask - Map = ", smartTask)
 
-	updateSmartTaskRes, err := client.ApiCall("set-smart-task", smartTask, client.GetSessionID(), true, false)
+	updateSmartTaskRes, err := apiCall(client, "set-smart-task", smartTask, client.GetSessionID(), true, false)
 	if err != nil || !updateSmartTaskRes.Success {
 		if updateSmartTaskRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateSmartTaskRes.ErrorMsg)
@@ -811,7 +811,7 @@ func deleteManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete SmartTask")
 
-	deleteSmartTaskRes, err := client.ApiCall("delete-smart-task", smartTaskPayload, client.GetSessionID(), true, false)
+	deleteSmartTaskRes, err := apiCall(client, "delete-smart-task", smartTaskPayload, client.GetSessionID(), true, false)
 	if err != nil || !deleteSmartTaskRes.Success {
 		if deleteSmartTaskRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSmartTaskRes.ErrorMsg)
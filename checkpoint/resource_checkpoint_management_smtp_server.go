@@ -155,7 +155,7 @@ func createManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create SmtpServer - Map = ", smtpServer)
 
-	addSmtpServerRes, err := client.ApiCall("add-smtp-server", smtpServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addSmtpServerRes, err := apiCall(client, "add-smtp-server", smtpServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addSmtpServerRes.Success {
 		if addSmtpServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSmtpServerRes.ErrorMsg)
@@ -176,7 +176,7 @@ func readManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showSmtpServerRes, err := client.ApiCall("show-smtp-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showSmtpServerRes, err := apiCall(client, "show-smtp-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -345,7 +345,7 @@ func updateManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update SmtpServer - Map = ", smtpServer)
 
-	updateSmtpServerRes, err := client.ApiCall("set-smtp-server", smtpServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateSmtpServerRes, err := apiCall(client, "set-smtp-server", smtpServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateSmtpServerRes.Success {
 		if updateSmtpServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateSmtpServerRes.ErrorMsg)
@@ -373,7 +373,7 @@ func deleteManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete SmtpServer")
 
-	deleteSmtpServerRes, err := client.ApiCall("delete-smtp-server", smtpServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteSmtpServerRes, err := apiCall(client, "delete-smtp-server", smtpServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteSmtpServerRes.Success {
 		if deleteSmtpServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSmtpServerRes.ErrorMsg)
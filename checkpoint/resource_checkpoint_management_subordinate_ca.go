@@ -303,7 +303,7 @@ func createManagementSubordinateCa(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create SubordinateCa - Map = ", subordinateCa)
 
-	addSubordinateCaRes, err := client.ApiCall("add-subordinate-ca", subordinateCa, client.GetSessionID(), true, client.IsProxyUsed())
+	addSubordinateCaRes, err := apiCall(client, "add-subordinate-ca", subordinateCa, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addSubordinateCaRes.Success {
 		if addSubordinateCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSubordinateCaRes.ErrorMsg)
@@ -324,7 +324,7 @@ func readManagementSubordinateCa(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showSubordinateCaRes, err := client.ApiCall("show-subordinate-ca", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showSubordinateCaRes, err := apiCall(client, "show-subordinate-ca", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -618,7 +618,7 @@ func updateManagementSubordinateCa(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update SubordinateCa - Map = ", subordinateCa)
 
-	updateSubordinateCaRes, err := client.ApiCall("set-subordinate-ca", subordinateCa, client.GetSessionID(), true, client.IsProxyUsed())
+	updateSubordinateCaRes, err := apiCall(client, "set-subordinate-ca", subordinateCa, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateSubordinateCaRes.Success {
 		if updateSubordinateCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateSubordinateCaRes.ErrorMsg)
@@ -639,7 +639,7 @@ func deleteManagementSubordinateCa(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Delete SubordinateCa")
 
-	deleteSubordinateCaRes, err := client.ApiCall("delete-subordinate-ca", subordinateCaPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteSubordinateCaRes, err := apiCall(client, "delete-subordinate-ca", subordinateCaPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteSubordinateCaRes.Success {
 		if deleteSubordinateCaRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSubordinateCaRes.ErrorMsg)
@@ -112,7 +112,7 @@ func createManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create SyslogServer - Map = ", syslogServer)
 
-	addSyslogServerRes, err := client.ApiCallSimple("add-syslog-server", syslogServer)
+	addSyslogServerRes, err := apiCallSimple(client, "add-syslog-server", syslogServer)
 	if err != nil || !addSyslogServerRes.Success {
 		if addSyslogServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addSyslogServerRes.ErrorMsg)
@@ -133,7 +133,7 @@ func readManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showSyslogServerRes, err := client.ApiCallSimple("show-syslog-server", payload)
+	showSyslogServerRes, err := apiCallSimple(client, "show-syslog-server", payload)
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -250,7 +250,7 @@ func updateManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update SyslogServer - Map = ", syslogServer)
 
-	updateSyslogServerRes, err := client.ApiCallSimple("set-syslog-server", syslogServer)
+	updateSyslogServerRes, err := apiCallSimple(client, "set-syslog-server", syslogServer)
 	if err != nil || !updateSyslogServerRes.Success {
 		if updateSyslogServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateSyslogServerRes.ErrorMsg)
@@ -278,7 +278,7 @@ func deleteManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete SyslogServer")
 
-	deleteSyslogServerRes, err := client.ApiCallSimple("delete-syslog-server", syslogServerPayload)
+	deleteSyslogServerRes, err := apiCallSimple(client, "delete-syslog-server", syslogServerPayload)
 	if err != nil || !deleteSyslogServerRes.Success {
 		if deleteSyslogServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteSyslogServerRes.ErrorMsg)
@@ -100,7 +100,7 @@ func createManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Tacacs Group - Map = ", tacacsGroupPayload)
 
-	addTacacsGroupRes, err := client.ApiCall("add-tacacs-group", tacacsGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	addTacacsGroupRes, err := apiCall(client, "add-tacacs-group", tacacsGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTacacsGroupRes.Success {
 		if addTacacsGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addTacacsGroupRes.ErrorMsg)
@@ -121,7 +121,7 @@ func readManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showTacacsGroupRes, err := client.ApiCall("show-tacacs-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTacacsGroupRes, err := apiCall(client, "show-tacacs-group", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -234,7 +234,7 @@ func updateManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Tacacs Group - Map = ", tacacsGroup)
 
-	updateTacacsGroupRes, err := client.ApiCall("set-tacacs-group", tacacsGroup, client.GetSessionID(), true, client.IsProxyUsed())
+	updateTacacsGroupRes, err := apiCall(client, "set-tacacs-group", tacacsGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTacacsGroupRes.Success {
 		if updateTacacsGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateTacacsGroupRes.ErrorMsg)
@@ -256,7 +256,7 @@ func deleteManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete Tacacs Group")
 
-	deleteTacacsGroupRes, err := client.ApiCall("delete-tacacs-group", tacacsGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteTacacsGroupRes, err := apiCall(client, "delete-tacacs-group", tacacsGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteTacacsGroupRes.Success {
 		if deleteTacacsGroupRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteTacacsGroupRes.ErrorMsg)
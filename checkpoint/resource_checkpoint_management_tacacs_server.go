@@ -144,7 +144,7 @@ func createManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Tacacs Server - Map = ", tacacsServer)
 
-	addTacacsServerRes, err := client.ApiCall("add-tacacs-server", tacacsServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addTacacsServerRes, err := apiCall(client, "add-tacacs-server", tacacsServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTacacsServerRes.Success {
 		if addTacacsServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addTacacsServerRes.ErrorMsg)
@@ -165,7 +165,7 @@ func readManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showTacacsServerRes, err := client.ApiCall("show-tacacs-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTacacsServerRes, err := apiCall(client, "show-tacacs-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -297,7 +297,7 @@ func updateManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update TacacsServer - Map = ", tacacsServer)
 
-	updateTacacsServerRes, err := client.ApiCall("set-tacacs-server", tacacsServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateTacacsServerRes, err := apiCall(client, "set-tacacs-server", tacacsServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTacacsServerRes.Success {
 		if updateTacacsServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateTacacsServerRes.ErrorMsg)
@@ -319,7 +319,7 @@ func deleteManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Delete TacacsServer")
 
-	deleteTacacsServerRes, err := client.ApiCall("delete-tacacs-server", tacacsServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteTacacsServerRes, err := apiCall(client, "delete-tacacs-server", tacacsServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteTacacsServerRes.Success {
 		if deleteTacacsServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteTacacsServerRes.ErrorMsg)
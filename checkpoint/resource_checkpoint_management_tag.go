@@ -88,7 +88,7 @@ func createManagementTag(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Tag - Map = ", tag)
 
-	addTagRes, err := client.ApiCall("add-tag", tag, client.GetSessionID(), true, client.IsProxyUsed())
+	addTagRes, err := apiCall(client, "add-tag", tag, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTagRes.Success {
 		if addTagRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addTagRes.ErrorMsg)
@@ -109,7 +109,7 @@ func readManagementTag(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showTagRes, err := client.ApiCall("show-tag", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTagRes, err := apiCall(client, "show-tag", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -205,7 +205,7 @@ func updateManagementTag(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Tag - Map = ", tag)
 
-	updateTagRes, err := client.ApiCall("set-tag", tag, client.GetSessionID(), true, client.IsProxyUsed())
+	updateTagRes, err := apiCall(client, "set-tag", tag, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTagRes.Success {
 		if updateTagRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateTagRes.ErrorMsg)
@@ -232,7 +232,7 @@ func deleteManagementTag(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete Tag")
 
-	deleteTagRes, err := client.ApiCall("delete-tag", tagPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteTagRes, err := apiCall(client, "delete-tag", tagPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteTagRes.Success {
 		if deleteTagRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteTagRes.ErrorMsg)
@@ -338,7 +338,7 @@ func createManagementThreatException(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Create Threat Exception - Map = ", threatException)
 
-	addThreatExceptionRes, err := client.ApiCall("add-threat-exception", threatException, client.GetSessionID(), true, client.IsProxyUsed())
+	addThreatExceptionRes, err := apiCall(client, "add-threat-exception", threatException, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addThreatExceptionRes.Success {
 		if addThreatExceptionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addThreatExceptionRes.ErrorMsg)
@@ -376,7 +376,7 @@ func readManagementThreatException(d *schema.ResourceData, m interface{}) error
 		payload["rule-name"] = v.(string)
 	}
 
-	showThreatRuleRes, err := client.ApiCall("show-threat-exception", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatRuleRes, err := apiCall(client, "show-threat-exception", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -692,7 +692,7 @@ func updateManagementThreatException(d *schema.ResourceData, m interface{}) erro
 
 	log.Println("Update Threat Exception - Map = ", threatException)
 
-	updateThreatExceptionRes, err := client.ApiCall("set-threat-exception", threatException, client.GetSessionID(), true, client.IsProxyUsed())
+	updateThreatExceptionRes, err := apiCall(client, "set-threat-exception", threatException, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatExceptionRes.Success {
 		if updateThreatExceptionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateThreatExceptionRes.ErrorMsg)
@@ -728,7 +728,7 @@ func deleteManagementThreatException(d *schema.ResourceData, m interface{}) erro
 	/**
 	adambar - we did not add ignore-warnings/erros since it is not supported by the API in the delete method.
 	*/
-	deleteThreatExceptionRes, err := client.ApiCall("delete-threat-exception", threatExceptionPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteThreatExceptionRes, err := apiCall(client, "delete-threat-exception", threatExceptionPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteThreatExceptionRes.Success {
 		if deleteThreatExceptionRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteThreatExceptionRes.ErrorMsg)
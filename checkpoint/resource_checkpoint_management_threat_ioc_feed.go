@@ -287,7 +287,7 @@ func createManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create ThreatIocFeed - Map = ", threatIocFeed)
 
-	addThreatIocFeedRes, err := client.ApiCall("add-threat-ioc-feed", threatIocFeed, client.GetSessionID(), true, client.IsProxyUsed())
+	addThreatIocFeedRes, err := apiCall(client, "add-threat-ioc-feed", threatIocFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addThreatIocFeedRes.Success {
 		if addThreatIocFeedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addThreatIocFeedRes.ErrorMsg)
@@ -308,7 +308,7 @@ func readManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showThreatIocFeedRes, err := client.ApiCall("show-threat-ioc-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatIocFeedRes, err := apiCall(client, "show-threat-ioc-feed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -596,7 +596,7 @@ func updateManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update ThreatIocFeed - Map = ", threatIocFeed)
 
-	updateThreatIocFeedRes, err := client.ApiCall("set-threat-ioc-feed", threatIocFeed, client.GetSessionID(), true, client.IsProxyUsed())
+	updateThreatIocFeedRes, err := apiCall(client, "set-threat-ioc-feed", threatIocFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatIocFeedRes.Success {
 		if updateThreatIocFeedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateThreatIocFeedRes.ErrorMsg)
@@ -623,7 +623,7 @@ func deleteManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Delete ThreatIocFeed")
 
-	deleteThreatIocFeedRes, err := client.ApiCall("delete-threat-ioc-feed", threatIocFeedPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteThreatIocFeedRes, err := apiCall(client, "delete-threat-ioc-feed", threatIocFeedPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteThreatIocFeedRes.Success {
 		if deleteThreatIocFeedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteThreatIocFeedRes.ErrorMsg)
@@ -108,7 +108,7 @@ func createManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Threat Layer - Map = ", threatLayer)
 
-	addThreatLayerRes, err := client.ApiCall("add-threat-layer", threatLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	addThreatLayerRes, err := apiCall(client, "add-threat-layer", threatLayer, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil || !addThreatLayerRes.Success {
 		if addThreatLayerRes.ErrorMsg != "" {
@@ -129,7 +129,7 @@ func readManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showThreatLayerRes, err := client.ApiCall("show-threat-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatLayerRes, err := apiCall(client, "show-threat-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -228,7 +228,7 @@ func updateManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Update Threat Layer - Map = ", threatLayer)
-	updateThreatLayerRes, err := client.ApiCall("set-threat-layer", threatLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateThreatLayerRes, err := apiCall(client, "set-threat-layer", threatLayer, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil || !updateThreatLayerRes.Success {
 		if updateThreatLayerRes.ErrorMsg != "" {
@@ -253,7 +253,7 @@ func deleteManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOkExists("ignore_errors"); ok {
 		threatLayerPayload["ignore-errors"] = v.(bool)
 	}
-	deleteThreatLayerRes, err := client.ApiCall("delete-threat-layer", threatLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteThreatLayerRes, err := apiCall(client, "delete-threat-layer", threatLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteThreatLayerRes.Success {
 		if deleteThreatLayerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteThreatLayerRes.ErrorMsg)
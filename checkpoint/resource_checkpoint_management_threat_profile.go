@@ -716,7 +716,7 @@ func createManagementThreatProfile(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create Threat Profile - Map = ", threatProfile)
 
-	threatProfileRes, err := client.ApiCall("add-threat-profile", threatProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	threatProfileRes, err := apiCall(client, "add-threat-profile", threatProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -728,7 +728,7 @@ func createManagementThreatProfile(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("%s", msg)
 	}
 
-	showThreatProfileRes, err := client.ApiCall("show-threat-profile", map[string]interface{}{"name": d.Get("name")}, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatProfileRes, err := apiCall(client, "show-threat-profile", map[string]interface{}{"name": d.Get("name")}, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -749,7 +749,7 @@ func readManagementThreatProfile(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showThreatProfileRes, err := client.ApiCall("show-threat-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatProfileRes, err := apiCall(client, "show-threat-profile", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1371,7 +1371,7 @@ func updateManagementThreatProfile(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update Threat Profile - Map = ", threatProfile)
 
-	threatProfileRes, err := client.ApiCall("set-threat-profile", threatProfile, client.GetSessionID(), true, client.IsProxyUsed())
+	threatProfileRes, err := apiCall(client, "set-threat-profile", threatProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1401,7 +1401,7 @@ func deleteManagementThreatProfile(d *schema.ResourceData, m interface{}) error
 	if v, ok := d.GetOkExists("ignore_warnings"); ok {
 		threatProfilePayload["ignore-warnings"] = v.(bool)
 	}
-	deleteThreatProfileRes, err := client.ApiCall("delete-threat-profile", threatProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteThreatProfileRes, err := apiCall(client, "delete-threat-profile", threatProfilePayload, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
@@ -152,10 +152,11 @@ func resourceManagementThreatRule() *schema.Resource {
 				Default:     false,
 			},
 			"track": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Packet tracking.",
-				Default:     "Log",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Packet tracking.",
+				Default:      "Log",
+				ValidateFunc: validateStringValueIgnoreCase(threatTrackValues...),
 			},
 			"track_settings": {
 				Type:        schema.TypeList,
@@ -318,7 +319,7 @@ func createManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Threat Rule - Map = ", threatRule)
 
-	addThreatRuleRes, err := client.ApiCall("add-threat-rule", threatRule, client.GetSessionID(), true, client.IsProxyUsed())
+	addThreatRuleRes, err := apiCall(client, "add-threat-rule", threatRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addThreatRuleRes.Success {
 		if addThreatRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addThreatRuleRes.ErrorMsg)
@@ -340,7 +341,7 @@ func readManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	showThreatRuleRes, err := client.ApiCall("show-threat-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showThreatRuleRes, err := apiCall(client, "show-threat-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -642,7 +643,7 @@ func updateManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Threat Rule - Map = ", threatRule)
 
-	updateThreatRuleRes, err := client.ApiCall("set-threat-rule", threatRule, client.GetSessionID(), true, client.IsProxyUsed())
+	updateThreatRuleRes, err := apiCall(client, "set-threat-rule", threatRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatRuleRes.Success {
 		if updateThreatRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateThreatRuleRes.ErrorMsg)
@@ -660,7 +661,7 @@ func deleteManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 		"layer": d.Get("layer"),
 	}
 
-	deleteThreatRuleRes, err := client.ApiCall("delete-threat-rule", threatRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteThreatRuleRes, err := apiCall(client, "delete-threat-rule", threatRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteThreatRuleRes.Success {
 		if deleteThreatRuleRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteThreatRuleRes.ErrorMsg)
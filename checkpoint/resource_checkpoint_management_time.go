@@ -299,7 +299,7 @@ func createManagementTime(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create Time - Map = ", time)
 
-	addTimeRes, err := client.ApiCall("add-time", time, client.GetSessionID(), true, client.IsProxyUsed())
+	addTimeRes, err := apiCall(client, "add-time", time, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTimeRes.Success {
 		if addTimeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addTimeRes.ErrorMsg)
@@ -320,7 +320,7 @@ func readManagementTime(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showTimeRes, err := client.ApiCall("show-time", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTimeRes, err := apiCall(client, "show-time", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -644,7 +644,7 @@ func updateManagementTime(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update Time - Map = ", time)
 
-	updateTimeRes, err := client.ApiCall("set-time", time, client.GetSessionID(), true, client.IsProxyUsed())
+	updateTimeRes, err := apiCall(client, "set-time", time, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTimeRes.Success {
 		if updateTimeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateTimeRes.ErrorMsg)
@@ -671,7 +671,7 @@ func deleteManagementTime(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete Time")
 
-	deleteTimeRes, err := client.ApiCall("delete-time", timePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteTimeRes, err := apiCall(client, "delete-time", timePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteTimeRes.Success {
 		if deleteTimeRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteTimeRes.ErrorMsg)
@@ -201,7 +201,7 @@ func createManagementTrustedClient(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Create TrustedClient - Map = ", trustedClient)
 
-	addTrustedClientRes, err := client.ApiCall("add-trusted-client", trustedClient, client.GetSessionID(), true, client.IsProxyUsed())
+	addTrustedClientRes, err := apiCall(client, "add-trusted-client", trustedClient, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTrustedClientRes.Success {
 		if addTrustedClientRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addTrustedClientRes.ErrorMsg)
@@ -222,7 +222,7 @@ func readManagementTrustedClient(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showTrustedClientRes, err := client.ApiCall("show-trusted-client", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showTrustedClientRes, err := apiCall(client, "show-trusted-client", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -431,7 +431,7 @@ func updateManagementTrustedClient(d *schema.ResourceData, m interface{}) error
 
 	log.Println("Update TrustedClient - Map = ", trustedClient)
 
-	updateTrustedClientRes, err := client.ApiCall("set-trusted-client", trustedClient, client.GetSessionID(), true, client.IsProxyUsed())
+	updateTrustedClientRes, err := apiCall(client, "set-trusted-client", trustedClient, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTrustedClientRes.Success {
 		if updateTrustedClientRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateTrustedClientRes.ErrorMsg)
@@ -458,7 +458,7 @@ func deleteManagementTrustedClient(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Delete TrustedClient")
 
-	deleteTrustedClientRes, err := client.ApiCall("delete-trusted-client", trustedClientPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteTrustedClientRes, err := apiCall(client, "delete-trusted-client", trustedClientPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteTrustedClientRes.Success {
 		if deleteTrustedClientRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteTrustedClientRes.ErrorMsg)
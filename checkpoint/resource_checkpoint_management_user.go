@@ -307,7 +307,7 @@ func createManagementUser(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create User - Map = ", user)
 
-	addUserRes, err := client.ApiCall("add-user", user, client.GetSessionID(), true, client.IsProxyUsed())
+	addUserRes, err := apiCall(client, "add-user", user, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addUserRes.Success {
 		if addUserRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addUserRes.ErrorMsg)
@@ -328,7 +328,7 @@ func readManagementUser(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showUserRes, err := client.ApiCall("show-user", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showUserRes, err := apiCall(client, "show-user", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -599,7 +599,7 @@ func updateManagementUser(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update User - Map = ", user)
 
-	updateUserRes, err := client.ApiCall("set-user", user, client.GetSessionID(), true, client.IsProxyUsed())
+	updateUserRes, err := apiCall(client, "set-user", user, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateUserRes.Success {
 		if updateUserRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateUserRes.ErrorMsg)
@@ -626,7 +626,7 @@ func deleteManagementUser(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete User")
 
-	deleteUserRes, err := client.ApiCall("delete-user", userPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteUserRes, err := apiCall(client, "delete-user", userPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteUserRes.Success {
 		if deleteUserRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteUserRes.ErrorMsg)
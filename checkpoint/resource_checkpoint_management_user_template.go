@@ -273,7 +273,7 @@ func createManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Create UserTemplate - Map = ", userTemplate)
 
-	addUserTemplateRes, err := client.ApiCall("add-user-template", userTemplate, client.GetSessionID(), true, client.IsProxyUsed())
+	addUserTemplateRes, err := apiCall(client, "add-user-template", userTemplate, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addUserTemplateRes.Success {
 		if addUserTemplateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addUserTemplateRes.ErrorMsg)
@@ -294,7 +294,7 @@ func readManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 		"uid": d.Id(),
 	}
 
-	showUserTemplateRes, err := client.ApiCall("show-user-template", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showUserTemplateRes, err := apiCall(client, "show-user-template", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -557,7 +557,7 @@ func updateManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 
 	log.Println("Update UserTemplate - Map = ", userTemplate)
 
-	updateUserTemplateRes, err := client.ApiCall("set-user-template", userTemplate, client.GetSessionID(), true, client.IsProxyUsed())
+	updateUserTemplateRes, err := apiCall(client, "set-user-template", userTemplate, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateUserTemplateRes.Success {
 		if updateUserTemplateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateUserTemplateRes.ErrorMsg)
@@ -584,7 +584,7 @@ func deleteManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Delete UserTemplate")
 
-	deleteUserTemplateRes, err := client.ApiCall("delete-user-template", userTemplatePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteUserTemplateRes, err := apiCall(client, "delete-user-template", userTemplatePayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteUserTemplateRes.Success {
 		if deleteUserTemplateRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteUserTemplateRes.ErrorMsg)
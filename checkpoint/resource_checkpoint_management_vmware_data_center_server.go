@@ -179,7 +179,7 @@ func createManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{
 
 	log.Println("Create vmwareDataCenterServer - Map = ", vmwareDataCenterServer)
 
-	addVMwareDataCenterServerRes, err := client.ApiCall("add-data-center-server", vmwareDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	addVMwareDataCenterServerRes, err := apiCall(client, "add-data-center-server", vmwareDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -193,7 +193,7 @@ func createManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{
 	payload := map[string]interface{}{
 		"name": vmwareDataCenterServer["name"],
 	}
-	showVMwareDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVMwareDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -210,7 +210,7 @@ func readManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showVMwareDataCenterServerRes, err := client.ApiCall("show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVMwareDataCenterServerRes, err := apiCall(client, "show-data-center-server", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -362,7 +362,7 @@ func updateManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{
 
 	log.Println("Update vmwareDataCenterServer - Map = ", vmwareDataCenterServer)
 
-	updateVMwareDataCenterServerRes, err := client.ApiCall("set-data-center-server", vmwareDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVMwareDataCenterServerRes, err := apiCall(client, "set-data-center-server", vmwareDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -393,7 +393,7 @@ func deleteManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{
 	}
 	log.Println("Delete vmwareDataCenterServer")
 
-	deleteVMwareDataCenterServerRes, err := client.ApiCall("delete-data-center-server", vmwareDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVMwareDataCenterServerRes, err := apiCall(client, "delete-data-center-server", vmwareDataCenterServerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVMwareDataCenterServerRes.Success {
 		if deleteVMwareDataCenterServerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVMwareDataCenterServerRes.ErrorMsg)
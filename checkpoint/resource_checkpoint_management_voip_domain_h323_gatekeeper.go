@@ -152,7 +152,7 @@ func createManagementVoipDomainH323Gatekeeper(d *schema.ResourceData, m interfac
 
 	log.Println("Create VoipDomainH323Gatekeeper - Map = ", voipDomainH323Gatekeeper)
 
-	addVoipDomainH323GatekeeperRes, err := client.ApiCall("add-voip-domain-h323-gatekeeper", voipDomainH323Gatekeeper, client.GetSessionID(), true, client.IsProxyUsed())
+	addVoipDomainH323GatekeeperRes, err := apiCall(client, "add-voip-domain-h323-gatekeeper", voipDomainH323Gatekeeper, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVoipDomainH323GatekeeperRes.Success {
 		if addVoipDomainH323GatekeeperRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVoipDomainH323GatekeeperRes.ErrorMsg)
@@ -173,7 +173,7 @@ func readManagementVoipDomainH323Gatekeeper(d *schema.ResourceData, m interface{
 		"uid": d.Id(),
 	}
 
-	showVoipDomainH323GatekeeperRes, err := client.ApiCall("show-voip-domain-h323-gatekeeper", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVoipDomainH323GatekeeperRes, err := apiCall(client, "show-voip-domain-h323-gatekeeper", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -335,7 +335,7 @@ func updateManagementVoipDomainH323Gatekeeper(d *schema.ResourceData, m interfac
 
 	log.Println("Update VoipDomainH323Gatekeeper - Map = ", voipDomainH323Gatekeeper)
 
-	updateVoipDomainH323GatekeeperRes, err := client.ApiCall("set-voip-domain-h323-gatekeeper", voipDomainH323Gatekeeper, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVoipDomainH323GatekeeperRes, err := apiCall(client, "set-voip-domain-h323-gatekeeper", voipDomainH323Gatekeeper, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVoipDomainH323GatekeeperRes.Success {
 		if updateVoipDomainH323GatekeeperRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVoipDomainH323GatekeeperRes.ErrorMsg)
@@ -356,7 +356,7 @@ func deleteManagementVoipDomainH323Gatekeeper(d *schema.ResourceData, m interfac
 
 	log.Println("Delete VoipDomainH323Gatekeeper")
 
-	deleteVoipDomainH323GatekeeperRes, err := client.ApiCall("delete-voip-domain-h323-gatekeeper", voipDomainH323GatekeeperPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVoipDomainH323GatekeeperRes, err := apiCall(client, "delete-voip-domain-h323-gatekeeper", voipDomainH323GatekeeperPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVoipDomainH323GatekeeperRes.Success {
 		if deleteVoipDomainH323GatekeeperRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVoipDomainH323GatekeeperRes.ErrorMsg)
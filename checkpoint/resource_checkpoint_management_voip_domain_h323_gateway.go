@@ -143,7 +143,7 @@ func createManagementVoipDomainH323Gateway(d *schema.ResourceData, m interface{}
 
 	log.Println("Create VoipDomainH323Gateway - Map = ", voipDomainH323Gateway)
 
-	addVoipDomainH323GatewayRes, err := client.ApiCall("add-voip-domain-h323-gateway", voipDomainH323Gateway, client.GetSessionID(), true, client.IsProxyUsed())
+	addVoipDomainH323GatewayRes, err := apiCall(client, "add-voip-domain-h323-gateway", voipDomainH323Gateway, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVoipDomainH323GatewayRes.Success {
 		if addVoipDomainH323GatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVoipDomainH323GatewayRes.ErrorMsg)
@@ -164,7 +164,7 @@ func readManagementVoipDomainH323Gateway(d *schema.ResourceData, m interface{})
 		"uid": d.Id(),
 	}
 
-	showVoipDomainH323GatewayRes, err := client.ApiCall("show-voip-domain-h323-gateway", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVoipDomainH323GatewayRes, err := apiCall(client, "show-voip-domain-h323-gateway", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -320,7 +320,7 @@ func updateManagementVoipDomainH323Gateway(d *schema.ResourceData, m interface{}
 
 	log.Println("Update VoipDomainH323Gateway - Map = ", voipDomainH323Gateway)
 
-	updateVoipDomainH323GatewayRes, err := client.ApiCall("set-voip-domain-h323-gateway", voipDomainH323Gateway, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVoipDomainH323GatewayRes, err := apiCall(client, "set-voip-domain-h323-gateway", voipDomainH323Gateway, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVoipDomainH323GatewayRes.Success {
 		if updateVoipDomainH323GatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVoipDomainH323GatewayRes.ErrorMsg)
@@ -341,7 +341,7 @@ func deleteManagementVoipDomainH323Gateway(d *schema.ResourceData, m interface{}
 
 	log.Println("Delete VoipDomainH323Gateway")
 
-	deleteVoipDomainH323GatewayRes, err := client.ApiCall("delete-voip-domain-h323-gateway", voipDomainH323GatewayPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVoipDomainH323GatewayRes, err := apiCall(client, "delete-voip-domain-h323-gateway", voipDomainH323GatewayPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVoipDomainH323GatewayRes.Success {
 		if deleteVoipDomainH323GatewayRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVoipDomainH323GatewayRes.ErrorMsg)
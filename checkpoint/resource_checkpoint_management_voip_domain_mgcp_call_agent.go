@@ -104,7 +104,7 @@ func createManagementVoipDomainMgcpCallAgent(d *schema.ResourceData, m interface
 
 	log.Println("Create VoipDomainMgcpCallAgent - Map = ", voipDomainMgcpCallAgent)
 
-	addVoipDomainMgcpCallAgentRes, err := client.ApiCall("add-voip-domain-mgcp-call-agent", voipDomainMgcpCallAgent, client.GetSessionID(), true, client.IsProxyUsed())
+	addVoipDomainMgcpCallAgentRes, err := apiCall(client, "add-voip-domain-mgcp-call-agent", voipDomainMgcpCallAgent, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVoipDomainMgcpCallAgentRes.Success {
 		if addVoipDomainMgcpCallAgentRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVoipDomainMgcpCallAgentRes.ErrorMsg)
@@ -125,7 +125,7 @@ func readManagementVoipDomainMgcpCallAgent(d *schema.ResourceData, m interface{}
 		"uid": d.Id(),
 	}
 
-	showVoipDomainMgcpCallAgentRes, err := client.ApiCall("show-voip-domain-mgcp-call-agent", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVoipDomainMgcpCallAgentRes, err := apiCall(client, "show-voip-domain-mgcp-call-agent", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -242,7 +242,7 @@ func updateManagementVoipDomainMgcpCallAgent(d *schema.ResourceData, m interface
 
 	log.Println("Update VoipDomainMgcpCallAgent - Map = ", voipDomainMgcpCallAgent)
 
-	updateVoipDomainMgcpCallAgentRes, err := client.ApiCall("set-voip-domain-mgcp-call-agent", voipDomainMgcpCallAgent, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVoipDomainMgcpCallAgentRes, err := apiCall(client, "set-voip-domain-mgcp-call-agent", voipDomainMgcpCallAgent, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVoipDomainMgcpCallAgentRes.Success {
 		if updateVoipDomainMgcpCallAgentRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVoipDomainMgcpCallAgentRes.ErrorMsg)
@@ -263,7 +263,7 @@ func deleteManagementVoipDomainMgcpCallAgent(d *schema.ResourceData, m interface
 
 	log.Println("Delete VoipDomainMgcpCallAgent")
 
-	deleteVoipDomainMgcpCallAgentRes, err := client.ApiCall("delete-voip-domain-mgcp-call-agent", voipDomainMgcpCallAgentPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVoipDomainMgcpCallAgentRes, err := apiCall(client, "delete-voip-domain-mgcp-call-agent", voipDomainMgcpCallAgentPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVoipDomainMgcpCallAgentRes.Success {
 		if deleteVoipDomainMgcpCallAgentRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVoipDomainMgcpCallAgentRes.ErrorMsg)
@@ -104,7 +104,7 @@ func createManagementVoipDomainSccpCallManager(d *schema.ResourceData, m interfa
 
 	log.Println("Create VoipDomainSccpCallManager - Map = ", voipDomainSccpCallManager)
 
-	addVoipDomainSccpCallManagerRes, err := client.ApiCall("add-voip-domain-sccp-call-manager", voipDomainSccpCallManager, client.GetSessionID(), true, client.IsProxyUsed())
+	addVoipDomainSccpCallManagerRes, err := apiCall(client, "add-voip-domain-sccp-call-manager", voipDomainSccpCallManager, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVoipDomainSccpCallManagerRes.Success {
 		if addVoipDomainSccpCallManagerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVoipDomainSccpCallManagerRes.ErrorMsg)
@@ -125,7 +125,7 @@ func readManagementVoipDomainSccpCallManager(d *schema.ResourceData, m interface
 		"uid": d.Id(),
 	}
 
-	showVoipDomainSccpCallManagerRes, err := client.ApiCall("show-voip-domain-sccp-call-manager", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVoipDomainSccpCallManagerRes, err := apiCall(client, "show-voip-domain-sccp-call-manager", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -242,7 +242,7 @@ func updateManagementVoipDomainSccpCallManager(d *schema.ResourceData, m interfa
 
 	log.Println("Update VoipDomainSccpCallManager - Map = ", voipDomainSccpCallManager)
 
-	updateVoipDomainSccpCallManagerRes, err := client.ApiCall("set-voip-domain-sccp-call-manager", voipDomainSccpCallManager, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVoipDomainSccpCallManagerRes, err := apiCall(client, "set-voip-domain-sccp-call-manager", voipDomainSccpCallManager, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVoipDomainSccpCallManagerRes.Success {
 		if updateVoipDomainSccpCallManagerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVoipDomainSccpCallManagerRes.ErrorMsg)
@@ -263,7 +263,7 @@ func deleteManagementVoipDomainSccpCallManager(d *schema.ResourceData, m interfa
 
 	log.Println("Delete VoipDomainSccpCallManager")
 
-	deleteVoipDomainSccpCallManagerRes, err := client.ApiCall("delete-voip-domain-sccp-call-manager", voipDomainSccpCallManagerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVoipDomainSccpCallManagerRes, err := apiCall(client, "delete-voip-domain-sccp-call-manager", voipDomainSccpCallManagerPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVoipDomainSccpCallManagerRes.Success {
 		if deleteVoipDomainSccpCallManagerRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVoipDomainSccpCallManagerRes.ErrorMsg)
@@ -104,7 +104,7 @@ func createManagementVoipDomainSipProxy(d *schema.ResourceData, m interface{}) e
 
 	log.Println("Create VoipDomainSipProxy - Map = ", voipDomainSipProxy)
 
-	addVoipDomainSipProxyRes, err := client.ApiCall("add-voip-domain-sip-proxy", voipDomainSipProxy, client.GetSessionID(), true, client.IsProxyUsed())
+	addVoipDomainSipProxyRes, err := apiCall(client, "add-voip-domain-sip-proxy", voipDomainSipProxy, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVoipDomainSipProxyRes.Success {
 		if addVoipDomainSipProxyRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVoipDomainSipProxyRes.ErrorMsg)
@@ -125,7 +125,7 @@ func readManagementVoipDomainSipProxy(d *schema.ResourceData, m interface{}) err
 		"uid": d.Id(),
 	}
 
-	showVoipDomainSipProxyRes, err := client.ApiCall("show-voip-domain-sip-proxy", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVoipDomainSipProxyRes, err := apiCall(client, "show-voip-domain-sip-proxy", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -242,7 +242,7 @@ func updateManagementVoipDomainSipProxy(d *schema.ResourceData, m interface{}) e
 
 	log.Println("Update VoipDomainSipProxy - Map = ", voipDomainSipProxy)
 
-	updateVoipDomainSipProxyRes, err := client.ApiCall("set-voip-domain-sip-proxy", voipDomainSipProxy, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVoipDomainSipProxyRes, err := apiCall(client, "set-voip-domain-sip-proxy", voipDomainSipProxy, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVoipDomainSipProxyRes.Success {
 		if updateVoipDomainSipProxyRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVoipDomainSipProxyRes.ErrorMsg)
@@ -263,7 +263,7 @@ func deleteManagementVoipDomainSipProxy(d *schema.ResourceData, m interface{}) e
 
 	log.Println("Delete VoipDomainSipProxy")
 
-	deleteVoipDomainSipProxyRes, err := client.ApiCall("delete-voip-domain-sip-proxy", voipDomainSipProxyPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVoipDomainSipProxyRes, err := apiCall(client, "delete-voip-domain-sip-proxy", voipDomainSipProxyPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVoipDomainSipProxyRes.Success {
 		if deleteVoipDomainSipProxyRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVoipDomainSipProxyRes.ErrorMsg)
@@ -1017,7 +1017,7 @@ func createManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) e
 
 	log.Println("Create VpnCommunityMeshed - Map = ", vpnCommunityMeshed)
 
-	addVpnCommunityMeshedRes, err := client.ApiCall("add-vpn-community-meshed", vpnCommunityMeshed, client.GetSessionID(), true, client.IsProxyUsed())
+	addVpnCommunityMeshedRes, err := apiCall(client, "add-vpn-community-meshed", vpnCommunityMeshed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVpnCommunityMeshedRes.Success {
 		if addVpnCommunityMeshedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVpnCommunityMeshedRes.ErrorMsg)
@@ -1038,7 +1038,7 @@ func readManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) err
 		"uid": d.Id(),
 	}
 
-	showVpnCommunityMeshedRes, err := client.ApiCall("show-vpn-community-meshed", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVpnCommunityMeshedRes, err := apiCall(client, "show-vpn-community-meshed", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -2095,7 +2095,7 @@ func updateManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) e
 
 	log.Println("Update VpnCommunityMeshed - Map = ", vpnCommunityMeshed)
 
-	updateVpnCommunityMeshedRes, err := client.ApiCall("set-vpn-community-meshed", vpnCommunityMeshed, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVpnCommunityMeshedRes, err := apiCall(client, "set-vpn-community-meshed", vpnCommunityMeshed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVpnCommunityMeshedRes.Success {
 		if updateVpnCommunityMeshedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVpnCommunityMeshedRes.ErrorMsg)
@@ -2122,7 +2122,7 @@ func deleteManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) e
 	}
 	log.Println("Delete VpnCommunityMeshed")
 
-	deleteVpnCommunityMeshedRes, err := client.ApiCall("delete-vpn-community-meshed", vpnCommunityMeshedPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVpnCommunityMeshedRes, err := apiCall(client, "delete-vpn-community-meshed", vpnCommunityMeshedPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVpnCommunityMeshedRes.Success {
 		if deleteVpnCommunityMeshedRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVpnCommunityMeshedRes.ErrorMsg)
@@ -150,7 +150,7 @@ func createManagementVpnCommunityRemoteAccess(d *schema.ResourceData, m interfac
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetVpnCommunityRemoteAccessRes, _ := client.ApiCall("set-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetVpnCommunityRemoteAccessRes, _ := apiCall(client, "set-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetVpnCommunityRemoteAccessRes.Success {
 		return fmt.Errorf("%s", SetVpnCommunityRemoteAccessRes.ErrorMsg)
 	}
@@ -240,7 +240,7 @@ func updateManagementVpnCommunityRemoteAccess(d *schema.ResourceData, m interfac
 		payload["ignore-errors"] = v.(bool)
 	}
 
-	SetVpnCommunityRemoteAccessRes, _ := client.ApiCall("set-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	SetVpnCommunityRemoteAccessRes, _ := apiCall(client, "set-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if !SetVpnCommunityRemoteAccessRes.Success {
 		return fmt.Errorf("%s", SetVpnCommunityRemoteAccessRes.ErrorMsg)
 	}
@@ -255,7 +255,7 @@ func readManagementVpnCommunityRemoteAccess(d *schema.ResourceData, m interface{
 		"uid": d.Id(),
 	}
 
-	showVpnCommunityRemoteAccessRes, err := client.ApiCall("show-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVpnCommunityRemoteAccessRes, err := apiCall(client, "show-vpn-community-remote-access", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -1236,7 +1236,7 @@ func createManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Create VpnCommunityStar - Map = ", vpnCommunityStar)
 
-	addVpnCommunityStarRes, err := client.ApiCall("add-vpn-community-star", vpnCommunityStar, client.GetSessionID(), true, client.IsProxyUsed())
+	addVpnCommunityStarRes, err := apiCall(client, "add-vpn-community-star", vpnCommunityStar, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVpnCommunityStarRes.Success {
 		if addVpnCommunityStarRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", addVpnCommunityStarRes.ErrorMsg)
@@ -1257,7 +1257,7 @@ func readManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) error
 		"uid": d.Id(),
 	}
 
-	showVpnCommunityStarRes, err := client.ApiCall("show-vpn-community-star", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	showVpnCommunityStarRes, err := apiCall(client, "show-vpn-community-star", payload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
 		return fmt.Errorf("%s", err.Error())
 	}
@@ -2513,7 +2513,7 @@ func updateManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) err
 
 	log.Println("Update VpnCommunityStar - Map = ", vpnCommunityStar)
 
-	updateVpnCommunityStarRes, err := client.ApiCall("set-vpn-community-star", vpnCommunityStar, client.GetSessionID(), true, client.IsProxyUsed())
+	updateVpnCommunityStarRes, err := apiCall(client, "set-vpn-community-star", vpnCommunityStar, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVpnCommunityStarRes.Success {
 		if updateVpnCommunityStarRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", updateVpnCommunityStarRes.ErrorMsg)
@@ -2541,7 +2541,7 @@ func deleteManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) err
 	}
 	log.Println("Delete VpnCommunityStar")
 
-	deleteVpnCommunityStarRes, err := client.ApiCall("delete-vpn-community-star", vpnCommunityStarPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	deleteVpnCommunityStarRes, err := apiCall(client, "delete-vpn-community-star", vpnCommunityStarPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !deleteVpnCommunityStarRes.Success {
 		if deleteVpnCommunityStarRes.ErrorMsg != "" {
 			return fmt.Errorf("%s", deleteVpnCommunityStarRes.ErrorMsg)
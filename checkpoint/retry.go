@@ -0,0 +1,185 @@
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = 2
+	maxRetryBackoff     = time.Minute
+)
+
+// Errors which are retried when the provider "retryable_errors" is not set. Each entry is compared
+// with the API error code and searched in the error message.
+var defaultRetryableErrors = []string{
+	"generic_err_object_locked",
+	"err_object_locked",
+	"is locked",
+	"locked by another session",
+	"server is busy",
+	"try again later",
+}
+
+// HTTP statuses of a server or proxy which may not have handled the request. A command which changes
+// objects may have run, so only read-only (show-*) commands are retried on these statuses.
+var retryableStatusCodes = []string{"502", "503", "504"}
+
+// retryPolicy decides which failed API calls are sent again and how long to wait between tries.
+type retryPolicy struct {
+	maxRetries int
+	backoff    time.Duration
+	errors     []string
+}
+
+func newRetryPolicy(maxRetries int, backoffSeconds int, retryableErrors []string) retryPolicy {
+	if len(retryableErrors) == 0 {
+		retryableErrors = defaultRetryableErrors
+	}
+	errs := make([]string, 0, len(retryableErrors))
+	for _, e := range retryableErrors {
+		if e = strings.ToLower(strings.TrimSpace(e)); e != "" {
+			errs = append(errs, e)
+		}
+	}
+	return retryPolicy{
+		maxRetries: maxRetries,
+		backoff:    time.Duration(backoffSeconds) * time.Second,
+		errors:     errs,
+	}
+}
+
+// retryable returns the reason to retry a failed API call of the command, or an empty string when
+// the call should not be retried. Transport errors are retried only when no request reached the
+// server.
+func (p retryPolicy) retryable(command string, res checkpoint.APIResponse, err error) string {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return err.Error()
+		}
+		if p.matches("", err.Error()) {
+			return err.Error()
+		}
+		return ""
+	}
+	if res.Success {
+		return ""
+	}
+	if strings.HasPrefix(command, "show-") {
+		for _, status := range retryableStatusCodes {
+			if strings.HasPrefix(res.StatusCode, status) {
+				return res.StatusCode
+			}
+		}
+	}
+	code := ""
+	if data := res.GetData(); data != nil {
+		code, _ = data["code"].(string)
+	}
+	if p.matches(code, res.ErrorMsg) {
+		return res.ErrorMsg
+	}
+	return ""
+}
+
+func (p retryPolicy) matches(code string, message string) bool {
+	code = strings.ToLower(code)
+	message = strings.ToLower(message)
+	for _, e := range p.errors {
+		if e == code || strings.Contains(message, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns the time to wait before the given retry, doubled on each retry.
+func (p retryPolicy) delay(retry int) time.Duration {
+	d := p.backoff
+	for i := 1; i < retry && d < maxRetryBackoff; i++ {
+		d *= 2
+	}
+	if d > maxRetryBackoff {
+		d = maxRetryBackoff
+	}
+	return d
+}
+
+// Retry policies of the provider clients. Clients without a policy are not retried.
+var retryPolicies sync.Map
+
+// retrySleep is replaced by tests.
+var retrySleep = time.Sleep
+
+func setRetryPolicy(client *checkpoint.ApiClient, policy retryPolicy) {
+	retryPolicies.Store(client, policy)
+}
+
+// apiCall runs client.ApiCall with the retry policy of the client.
+func apiCall(client *checkpoint.ApiClient, command string, payload map[string]interface{}, sid string, waitForTask bool, useProxy bool, method ...string) (checkpoint.APIResponse, error) {
+	return withRetry(client, command, payload, func() (checkpoint.APIResponse, error) {
+		return client.ApiCall(command, payload, sid, waitForTask, useProxy, method...)
+	})
+}
+
+// apiCallSimple runs client.ApiCallSimple with the retry policy of the client.
+func apiCallSimple(client *checkpoint.ApiClient, command string, payload map[string]interface{}) (checkpoint.APIResponse, error) {
+	return withRetry(client, command, payload, func() (checkpoint.APIResponse, error) {
+		return client.ApiCallSimple(command, payload)
+	})
+}
+
+func withRetry(client *checkpoint.ApiClient, command string, payload map[string]interface{}, call func() (checkpoint.APIResponse, error)) (checkpoint.APIResponse, error) {
+	res, err := call()
+	v, ok := retryPolicies.Load(client)
+	if !ok {
+		return res, err
+	}
+	policy := v.(retryPolicy)
+	for retry := 1; retry <= policy.maxRetries; retry++ {
+		reason := policy.retryable(command, res, err)
+		if reason == "" {
+			break
+		}
+		delay := policy.delay(retry)
+		log.Printf("[WARN] %s failed, retry %d of %d in %s: %s", command, retry, policy.maxRetries, delay, reason)
+		logRetry(client, command, payload, res, err, retry, policy.maxRetries)
+		retrySleep(delay)
+		res, err = call()
+	}
+	return res, err
+}
+
+// logRetry records a retried API call in the debug log when TF_CP_DEBUG is set.
+func logRetry(client *checkpoint.ApiClient, command string, payload map[string]interface{}, res checkpoint.APIResponse, err error, retry int, maxRetries int) {
+	if os.Getenv("TF_CP_DEBUG") == "" {
+		return
+	}
+	ensureDebugServerFromClient(client)
+	errMsg := res.ErrorMsg
+	var respData map[string]interface{}
+	if err != nil {
+		errMsg = err.Error()
+	} else {
+		respData = res.GetData()
+	}
+	debugLogOperation(
+		"provider",
+		fmt.Sprintf("retry %d/%d", retry, maxRetries),
+		command,
+		payload,
+		respData,
+		false,
+		errMsg,
+	)
+}
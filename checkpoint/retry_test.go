@@ -0,0 +1,90 @@
+package checkpoint
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+)
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	policy := newRetryPolicy(3, 1, nil)
+	cases := []struct {
+		name    string
+		command string
+		res     checkpoint.APIResponse
+		err     error
+		retry   bool
+	}{
+		{"success", "add-host", checkpoint.APIResponse{Success: true}, nil, false},
+		{"locked", "set-host", checkpoint.APIResponse{StatusCode: "409 Conflict", ErrorMsg: "Message: Object host1 is locked by another session"}, nil, true},
+		{"unavailable", "show-host", checkpoint.APIResponse{StatusCode: "503 Service Unavailable"}, nil, true},
+		{"unavailable write", "add-host", checkpoint.APIResponse{StatusCode: "503 Service Unavailable"}, nil, false},
+		{"gateway timeout write", "publish", checkpoint.APIResponse{StatusCode: "504 Gateway Timeout"}, nil, false},
+		{"invalid", "show-host", checkpoint.APIResponse{StatusCode: "400 Bad Request", ErrorMsg: "Message: Invalid parameter"}, nil, false},
+		{"dial", "add-host", checkpoint.APIResponse{}, &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"read", "show-host", checkpoint.APIResponse{}, &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}, false},
+	}
+	for _, c := range cases {
+		if retry := policy.retryable(c.command, c.res, c.err) != ""; retry != c.retry {
+			t.Errorf("%s: expected retry %v, got %v", c.name, c.retry, retry)
+		}
+	}
+
+	custom := newRetryPolicy(3, 1, []string{"Generic_Error"})
+	if custom.retryable("add-host", checkpoint.APIResponse{ErrorMsg: "Code: generic_error"}, nil) == "" {
+		t.Errorf("expected configured error to be retried")
+	}
+	if custom.retryable("add-host", checkpoint.APIResponse{ErrorMsg: "Object is locked"}, nil) != "" {
+		t.Errorf("expected configured errors to replace the default errors")
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := newRetryPolicy(10, 2, nil)
+	expected := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second}
+	for i, d := range expected {
+		if got := policy.delay(i + 1); got != d {
+			t.Errorf("retry %d: expected %s, got %s", i+1, d, got)
+		}
+	}
+	if got := policy.delay(10); got != maxRetryBackoff {
+		t.Errorf("expected delay to be capped, got %s", got)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	var delays []time.Duration
+	retrySleep = func(d time.Duration) { delays = append(delays, d) }
+	defer func() { retrySleep = time.Sleep }()
+
+	client := &checkpoint.ApiClient{}
+	locked := checkpoint.APIResponse{ErrorMsg: "Object is locked by another session"}
+
+	calls := 0
+	call := func() (checkpoint.APIResponse, error) {
+		calls++
+		if calls < 3 {
+			return locked, nil
+		}
+		return checkpoint.APIResponse{Success: true}, nil
+	}
+
+	if res, _ := withRetry(client, "set-host", nil, call); res.Success || calls != 1 {
+		t.Fatalf("expected a client without a policy not to be retried")
+	}
+
+	calls = 0
+	setRetryPolicy(client, newRetryPolicy(3, 1, nil))
+	defer retryPolicies.Delete(client)
+	if res, _ := withRetry(client, "set-host", nil, call); !res.Success || calls != 3 || len(delays) != 2 {
+		t.Fatalf("expected success after 2 retries, got %d calls and delays %v", calls, delays)
+	}
+
+	calls = -10
+	if res, _ := withRetry(client, "set-host", nil, call); res.Success || calls != -6 {
+		t.Fatalf("expected to give up after 3 retries, got %d calls", calls+10)
+	}
+}
@@ -0,0 +1,342 @@
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rulebaseSpec describes how to read the rulebase a rule resource belongs to.
+type rulebaseSpec struct {
+	command      string // show-*-rulebase command
+	containerKey string // resource field which identifies the rulebase (layer or package)
+}
+
+// Rule resources with a "position" field which is checked against the rulebase on read.
+var positionedRuleResources = map[string]rulebaseSpec{
+	"checkpoint_management_access_rule": {command: "show-access-rulebase", containerKey: "layer"},
+	"checkpoint_management_nat_rule":    {command: "show-nat-rulebase", containerKey: "package"},
+	"checkpoint_management_threat_rule": {command: "show-threat-rulebase", containerKey: "layer"},
+	"checkpoint_management_https_rule":  {command: "show-https-rulebase", containerKey: "layer"},
+}
+
+// errRulebaseNotFound is returned by showRulebase when the layer or package doesn't exist.
+var errRulebaseNotFound = errors.New("rulebase not found")
+
+var uidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// rulebaseEntry is a rule or a section of a rulebase, in rulebase order.
+type rulebaseEntry struct {
+	uid         string
+	name        string
+	section     bool
+	number      int
+	sectionUid  string
+	sectionName string
+	object      map[string]interface{}
+}
+
+func (e rulebaseEntry) String() string {
+	if e.section {
+		if e.name != "" {
+			return "section " + e.name
+		}
+		return "section " + e.uid
+	}
+	if e.name != "" {
+		return fmt.Sprintf("rule %d (%s)", e.number, e.name)
+	}
+	return fmt.Sprintf("rule %d", e.number)
+}
+
+// rulebaseCache checks the position of rules against their rulebase when rules are read.
+// Rules which were moved outside Terraform (e.g. in SmartConsole) are reported as a diff of the
+// "position" field, and the computed "position_drift" field describes where the rule moved.
+// Each rulebase is read once and kept until a rule of the rulebase is changed.
+type rulebaseCache struct {
+	mu        sync.Mutex
+	rulebases map[rulebaseKey][]rulebaseEntry
+}
+
+// rulebaseKey identifies a cached rulebase. Each domain has its own client, so layers with the same
+// name in different domains are cached apart.
+type rulebaseKey struct {
+	client    *checkpoint.ApiClient
+	command   string
+	container string
+}
+
+// route adds position drift detection to the rule resources.
+func (c *rulebaseCache) route(provider *schema.Provider) {
+	for name, spec := range positionedRuleResources {
+		if r, ok := provider.ResourcesMap[name]; ok {
+			c.routeResource(r, spec)
+		}
+	}
+	if r, ok := provider.ResourcesMap["checkpoint_management_access_rulebase"]; ok {
+		c.routeWrites(r, accessRulebaseSpec)
+	}
+}
+
+func (c *rulebaseCache) routeResource(r *schema.Resource, spec rulebaseSpec) {
+	r.Schema["position_drift"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Where the rule moved when it no longer sits at its configured position.",
+	}
+	c.routeWrites(r, spec)
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, m interface{}) error {
+		if err := read(d, m); err != nil || d.Id() == "" {
+			return err
+		}
+		client, ok := m.(*checkpoint.ApiClient)
+		if !ok {
+			return nil
+		}
+		return c.checkPosition(d, client, spec)
+	}
+}
+
+// routeWrites invalidates the rulebase of the resource when the resource changes it. Reads of
+// other rules of the rulebase during the write may cache the rulebase before the change, so it is
+// invalidated after the write as well.
+func (c *rulebaseCache) routeWrites(r *schema.Resource, spec rulebaseSpec) {
+	invalidate := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, m interface{}) error {
+			client, _ := m.(*checkpoint.ApiClient)
+			key := rulebaseKey{client: client, command: spec.command, container: d.Get(spec.containerKey).(string)}
+			c.invalidate(key)
+			defer c.invalidate(key)
+			return f(d, m)
+		}
+	}
+	r.Create = invalidate(r.Create)
+	r.Update = invalidate(r.Update)
+	r.Delete = invalidate(r.Delete)
+}
+
+func (c *rulebaseCache) invalidate(key rulebaseKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.rulebases, key)
+}
+
+// checkPosition reports the rule position as changed when the rule no longer sits where its
+// configured position placed it. The anchor the rule moved past is cleared, so the plan restores it
+// and the next apply moves the rule back.
+func (c *rulebaseCache) checkPosition(d *schema.ResourceData, client *checkpoint.ApiClient, spec rulebaseSpec) error {
+	positions, ok := d.Get("position").([]interface{})
+	if !ok || len(positions) == 0 || positions[0] == nil {
+		// e.g. imported rules, the position is set on next apply
+		return nil
+	}
+	position := positions[0].(map[string]interface{})
+
+	entries, err := c.rulebase(client, spec, d.Get(spec.containerKey).(string))
+	if err != nil {
+		log.Printf("[WARN] failed to check rule %s position: %s", d.Id(), err)
+		return nil
+	}
+
+	key, value := rulePositionDrift(entries, d.Id(), position)
+	if err := d.Set("position_drift", value); err != nil {
+		return err
+	}
+	if key == "" {
+		return nil
+	}
+	log.Printf("[WARN] rule %s %s", d.Id(), value)
+	position[key] = ""
+	return d.Set("position", []interface{}{position})
+}
+
+func (c *rulebaseCache) rulebase(client *checkpoint.ApiClient, spec rulebaseSpec, container string) ([]rulebaseEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cacheKey := rulebaseKey{client: client, command: spec.command, container: container}
+	if entries, ok := c.rulebases[cacheKey]; ok {
+		return entries, nil
+	}
+
+	entries, err := showRulebase(client, spec, container, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.rulebases == nil {
+		c.rulebases = make(map[rulebaseKey][]rulebaseEntry)
+	}
+	c.rulebases[cacheKey] = entries
+	return entries, nil
+}
+
+// showRulebase reads all pages of a rulebase. When resolveObjects is set the rule fields hold the
+// referenced objects instead of uids.
+func showRulebase(client *checkpoint.ApiClient, spec rulebaseSpec, container string, resolveObjects bool) ([]rulebaseEntry, error) {
+	entries := make([]rulebaseEntry, 0)
+	limit := 500
+	for offset := 0; ; offset += limit {
+		payload := map[string]interface{}{
+			"offset":        offset,
+			"limit":         limit,
+			"details-level": "standard",
+		}
+		if resolveObjects {
+			payload["use-object-dictionary"] = false
+		}
+		if spec.containerKey == "package" {
+			payload["package"] = container
+		} else if uidPattern.MatchString(container) {
+			payload["uid"] = container
+		} else {
+			payload["name"] = container
+		}
+
+		showRulebaseRes, err := apiCall(client, spec.command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+		if err != nil {
+			return nil, err
+		}
+		if !showRulebaseRes.Success {
+			if objectNotFound(getString(showRulebaseRes.GetData(), "code")) {
+				return nil, fmt.Errorf("%w: %s", errRulebaseNotFound, showRulebaseRes.ErrorMsg)
+			}
+			return nil, fmt.Errorf("%s", showRulebaseRes.ErrorMsg)
+		}
+		data := showRulebaseRes.GetData()
+		entries = appendRulebaseEntries(entries, data["rulebase"])
+
+		to, _ := data["to"].(float64)
+		total, _ := data["total"].(float64)
+		if int(to) >= int(total) || int(to) < offset+1 {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// appendRulebaseEntries flattens a rulebase page. A section which continues from the previous page
+// is not added twice.
+func appendRulebaseEntries(entries []rulebaseEntry, rulebase interface{}) []rulebaseEntry {
+	items, _ := rulebase.([]interface{})
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if strings.HasSuffix(getString(itemMap, "type"), "-section") {
+			section := rulebaseEntry{uid: getString(itemMap, "uid"), name: getString(itemMap, "name"), section: true, object: itemMap}
+			if !rulebaseHasSection(entries, section.uid) {
+				entries = append(entries, section)
+			}
+			rules, _ := itemMap["rulebase"].([]interface{})
+			for _, rule := range rules {
+				if ruleMap, ok := rule.(map[string]interface{}); ok {
+					entries = append(entries, newRulebaseRule(ruleMap, section))
+				}
+			}
+			continue
+		}
+		entries = append(entries, newRulebaseRule(itemMap, rulebaseEntry{}))
+	}
+	return entries
+}
+
+func rulebaseHasSection(entries []rulebaseEntry, uid string) bool {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].section {
+			return entries[i].uid == uid
+		}
+	}
+	return false
+}
+
+func newRulebaseRule(rule map[string]interface{}, section rulebaseEntry) rulebaseEntry {
+	number, _ := rule["rule-number"].(float64)
+	return rulebaseEntry{
+		uid:         getString(rule, "uid"),
+		name:        getString(rule, "name"),
+		number:      int(number),
+		sectionUid:  section.uid,
+		sectionName: section.name,
+		object:      rule,
+	}
+}
+
+// findRulebaseEntry finds a rule or section by uid, or by name.
+func findRulebaseEntry(entries []rulebaseEntry, identifier string, sectionOnly bool) int {
+	for i, e := range entries {
+		if e.uid == identifier && (e.section || !sectionOnly) {
+			return i
+		}
+	}
+	for i, e := range entries {
+		if e.name == identifier && (e.section || !sectionOnly) {
+			return i
+		}
+	}
+	return -1
+}
+
+// rulePositionDrift returns the position field the rule no longer matches and the value to report
+// for it, or empty strings when the rule is still in place. Rules positioned "above"/"below" an
+// anchor must stay on that side of it, rules positioned on top or bottom of a section must stay in
+// that section. Positions by rule number and top/bottom of the entire rulebase are not checked.
+func rulePositionDrift(entries []rulebaseEntry, ruleUid string, position map[string]interface{}) (string, string) {
+	ruleIdx := findRulebaseEntry(entries, ruleUid, false)
+	if ruleIdx < 0 {
+		return "", ""
+	}
+	rule := entries[ruleIdx]
+
+	for _, key := range []string{"above", "below", "top", "bottom"} {
+		anchor, _ := position[key].(string)
+		if anchor == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(anchor); err == nil {
+			continue
+		}
+
+		switch key {
+		case "above", "below":
+			anchorIdx := findRulebaseEntry(entries, anchor, false)
+			if anchorIdx < 0 {
+				continue
+			}
+			if key == "above" && ruleIdx > anchorIdx {
+				return key, fmt.Sprintf("moved past %s, now below %s", entries[anchorIdx], entries[ruleIdx-1])
+			}
+			if key == "below" && ruleIdx < anchorIdx {
+				return key, fmt.Sprintf("moved past %s, now above %s", entries[anchorIdx], entries[ruleIdx+1])
+			}
+
+		case "top", "bottom":
+			if anchor == key {
+				continue
+			}
+			sectionIdx := findRulebaseEntry(entries, anchor, true)
+			if sectionIdx < 0 || entries[sectionIdx].uid == rule.sectionUid {
+				continue
+			}
+			if rule.sectionUid == "" {
+				return key, fmt.Sprintf("moved out of %s, now outside of sections", entries[sectionIdx])
+			}
+			current := rulebaseEntry{uid: rule.sectionUid, name: rule.sectionName, section: true}
+			return key, fmt.Sprintf("moved out of %s, now in %s", entries[sectionIdx], current)
+		}
+	}
+	return "", ""
+}
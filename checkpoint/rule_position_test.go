@@ -0,0 +1,127 @@
+package checkpoint
+
+import (
+	"testing"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testRulebaseEntries() []rulebaseEntry {
+	page1 := map[string]interface{}{
+		"rulebase": []interface{}{
+			map[string]interface{}{"type": "access-rule", "uid": "r1", "name": "first", "rule-number": float64(1)},
+			map[string]interface{}{
+				"type": "access-section", "uid": "s1", "name": "web",
+				"rulebase": []interface{}{
+					map[string]interface{}{"type": "access-rule", "uid": "r2", "name": "web-in", "rule-number": float64(2)},
+				},
+			},
+		},
+	}
+	page2 := map[string]interface{}{
+		"rulebase": []interface{}{
+			map[string]interface{}{
+				"type": "access-section", "uid": "s1", "name": "web",
+				"rulebase": []interface{}{
+					map[string]interface{}{"type": "access-rule", "uid": "r3", "name": "", "rule-number": float64(3)},
+				},
+			},
+			map[string]interface{}{"type": "access-rule", "uid": "r4", "name": "cleanup", "rule-number": float64(4)},
+		},
+	}
+	entries := appendRulebaseEntries(nil, page1["rulebase"])
+	return appendRulebaseEntries(entries, page2["rulebase"])
+}
+
+func TestAppendRulebaseEntries(t *testing.T) {
+	entries := testRulebaseEntries()
+	if len(entries) != 5 {
+		t.Fatalf("expected 5 entries, got %v", entries)
+	}
+	if entries[3].uid != "r3" || entries[3].sectionUid != "s1" {
+		t.Fatalf("unexpected entry %v", entries[3])
+	}
+}
+
+func TestRulePositionDrift(t *testing.T) {
+	entries := testRulebaseEntries()
+	cases := []struct {
+		rule     string
+		position map[string]interface{}
+		key      string
+		value    string
+	}{
+		{"r2", map[string]interface{}{"above": "cleanup"}, "", ""},
+		{"r4", map[string]interface{}{"above": "web-in"}, "above", "moved past rule 2 (web-in), now below rule 3"},
+		{"r1", map[string]interface{}{"below": "r2"}, "below", "moved past rule 2 (web-in), now above section web"},
+		{"r3", map[string]interface{}{"top": "web"}, "", ""},
+		{"r4", map[string]interface{}{"bottom": "web"}, "bottom", "moved out of section web, now outside of sections"},
+		{"r4", map[string]interface{}{"top": "top"}, "", ""},
+		{"r4", map[string]interface{}{"above": "2"}, "", ""},
+		{"r4", map[string]interface{}{"above": "missing"}, "", ""},
+	}
+	for _, c := range cases {
+		key, value := rulePositionDrift(entries, c.rule, c.position)
+		if key != c.key || value != c.value {
+			t.Errorf("rule %s position %v: expected (%q, %q), got (%q, %q)", c.rule, c.position, c.key, c.value, key, value)
+		}
+	}
+}
+
+func TestRulebaseCachePositionDrift(t *testing.T) {
+	spec := positionedRuleResources["checkpoint_management_access_rule"]
+	key := rulebaseKey{command: spec.command, container: "Network"}
+	c := &rulebaseCache{rulebases: map[rulebaseKey][]rulebaseEntry{key: testRulebaseEntries()}}
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"layer":    {Type: schema.TypeString, Optional: true},
+		"position": {Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{"above": {Type: schema.TypeString, Optional: true}}}},
+	}}
+	c.routeResource(r, spec)
+
+	d := r.TestResourceData()
+	d.SetId("r4")
+	_ = d.Set("layer", "Network")
+	_ = d.Set("position", []interface{}{map[string]interface{}{"above": "web-in"}})
+	if err := c.checkPosition(d, nil, spec); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("position.0.above") != "" {
+		t.Errorf("expected the anchor the rule moved past to be cleared, got %q", d.Get("position.0.above"))
+	}
+	if drift := d.Get("position_drift"); drift != "moved past rule 2 (web-in), now below rule 3" {
+		t.Errorf("unexpected position drift %q", drift)
+	}
+
+	// a rulebase cached during a write is dropped after the write
+	r.Create = func(d *schema.ResourceData, m interface{}) error {
+		c.rulebases[key] = testRulebaseEntries()
+		return nil
+	}
+	c.routeResource(r, spec)
+	if err := r.Create(d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.rulebases[key]; ok {
+		t.Errorf("expected the rulebase to be invalidated after the write")
+	}
+}
+
+func TestRulebaseCacheDomains(t *testing.T) {
+	spec := positionedRuleResources["checkpoint_management_access_rule"]
+	domain1 := rulebaseKey{client: &checkpoint.ApiClient{}, command: spec.command, container: "Network"}
+	domain2 := rulebaseKey{client: &checkpoint.ApiClient{}, command: spec.command, container: "Network"}
+	c := &rulebaseCache{rulebases: map[rulebaseKey][]rulebaseEntry{
+		domain1: testRulebaseEntries(),
+		domain2: testRulebaseEntries()[:1],
+	}}
+
+	entries, err := c.rulebase(domain2.client, spec, "Network")
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected the rulebase of the second domain, got %v, %v", entries, err)
+	}
+	c.invalidate(domain1)
+	if _, ok := c.rulebases[domain2]; !ok {
+		t.Errorf("expected a write in one domain to keep the rulebase of the other domain")
+	}
+}
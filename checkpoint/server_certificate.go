@@ -0,0 +1,34 @@
+package checkpoint
+
+import (
+	"net"
+	"strconv"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
+)
+
+// newApiClient returns a client whose requests verify the server certificate with trust on their own
+// connection. The SDK can't pin a certificate: each call replaces the fingerprint of its arguments
+// with the one the server presents. So the server is registered with the verifying transport, which
+// also tunnels the requests through the proxy of the arguments, and the SDK client doesn't use the
+// proxy itself, as it would replace the transport.
+func newApiClient(args checkpoint.ApiClientArgs, trust servercert.Trust) *checkpoint.ApiClient {
+	port := args.Port
+	if port == -1 {
+		port = checkpoint.DefaultPort
+	}
+	servercert.Register(net.JoinHostPort(args.Server, strconv.Itoa(port)), trust, clientProxy(args))
+	args.ProxyHost = checkpoint.DefaultProxyHost
+	args.ProxyPort = checkpoint.DefaultProxyPort
+	return checkpoint.APIClient(args)
+}
+
+// clientProxy returns the proxy ("host:port") of the client arguments, or "" when the client doesn't
+// use a proxy.
+func clientProxy(args checkpoint.ApiClientArgs) string {
+	if args.ProxyHost == checkpoint.DefaultProxyHost || args.ProxyPort == checkpoint.DefaultProxyPort {
+		return ""
+	}
+	return net.JoinHostPort(args.ProxyHost, strconv.Itoa(args.ProxyPort))
+}
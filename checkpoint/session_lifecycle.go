@@ -0,0 +1,192 @@
+package checkpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resources which control the session itself and therefore are not part of the session changes.
+var sessionControlResources = map[string]bool{
+	"checkpoint_management_publish":                 true,
+	"checkpoint_management_discard":                 true,
+	"checkpoint_management_disconnect":              true,
+	"checkpoint_management_keepalive":               true,
+	"checkpoint_management_login":                   true,
+	"checkpoint_management_logout":                  true,
+	"checkpoint_management_install_policy":          true,
+	"checkpoint_management_command_login_to_domain": true,
+	"checkpoint_management_revert_to_revision":      true,
+	"checkpoint_management_verify_revert":           true,
+	"checkpoint_management_policy_installation":     true,
+}
+
+// sessionLifecycle publishes the provider session each time no create/update/delete operation is
+// running and the operations since the last publish completed successfully, and discards it when an
+// operation failed (provider "publish_when_idle").
+// Changes made in other domains are published and discarded in the sessions of those domains.
+//
+// Terraform doesn't notify providers when an apply ends, and starts the operations of a resource once
+// the operations of the resources it depends on completed. So an apply of dependent resources is
+// published in more than one publish, and a failure discards the changes since the last publish only;
+// the documentation points to a checkpoint_management_publish resource for a single publish. After a
+// failure the following operations of the provider are rejected, so no changes stay locked in the session.
+type sessionLifecycle struct {
+	mu        sync.Mutex
+	enabled   bool
+	inFlight  int
+	changes   int
+	failed    bool
+	discarded bool
+	sessions  []*checkpoint.ApiClient // sessions changed since the last publish
+}
+
+func (l *sessionLifecycle) configure(enabled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.enabled = enabled
+	l.inFlight = 0
+	l.changes = 0
+	l.failed = false
+	l.discarded = false
+	l.sessions = nil
+}
+
+// route makes create/update/delete of management resources part of the session lifecycle.
+func (l *sessionLifecycle) route(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		if strings.HasPrefix(name, "checkpoint_management_") && !sessionControlResources[name] {
+			l.routeResource(r)
+		}
+	}
+}
+
+func (l *sessionLifecycle) routeResource(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, m interface{}) error {
+			client, ok := m.(*checkpoint.ApiClient)
+			if !ok || client.GetContext() != checkpoint.WebContext {
+				return f(d, m)
+			}
+			if err := l.begin(); err != nil {
+				return err
+			}
+			err := f(d, m)
+			if endErr := l.end(client, err); endErr != nil && err == nil {
+				return endErr
+			}
+			return err
+		}
+	}
+	r.Create = wrap(r.Create)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
+
+func (l *sessionLifecycle) begin() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.enabled {
+		return nil
+	}
+	if l.failed {
+		return fmt.Errorf("session changes were discarded since a previous change failed")
+	}
+	l.inFlight++
+	return nil
+}
+
+// end completes an operation. When it was the last running operation, the session is published,
+// or discarded if one of the operations failed.
+func (l *sessionLifecycle) end(client *checkpoint.ApiClient, opErr error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.enabled {
+		return nil
+	}
+	l.inFlight--
+	l.track(client)
+	if opErr != nil {
+		l.failed = true
+	} else {
+		l.changes++
+	}
+	if l.inFlight > 0 {
+		return nil
+	}
+
+	if l.failed {
+		if !l.discarded {
+			l.discarded = true
+			return l.discardAll()
+		}
+		return nil
+	}
+
+	if l.changes == 0 {
+		return nil
+	}
+	l.changes = 0
+	for i, session := range l.sessions {
+		log.Println("Publish session changes")
+		publishRes, err := apiCall(session, "publish", map[string]interface{}{}, session.GetSessionID(), true, session.IsProxyUsed())
+		if err == nil && !publishRes.Success {
+			err = fmt.Errorf("%s", publishRes.ErrorMsg)
+		}
+		if err != nil {
+			l.failed = true
+			l.discarded = true
+			l.sessions = l.sessions[i:]
+			if discardErr := l.discardAll(); discardErr != nil {
+				log.Printf("Failed to discard session after publish failure: %s", discardErr)
+			}
+			return fmt.Errorf("failed to publish session changes: %s", err)
+		}
+	}
+	l.sessions = nil
+	return nil
+}
+
+// track adds the session of an operation to the sessions to publish or discard.
+func (l *sessionLifecycle) track(client *checkpoint.ApiClient) {
+	if client == nil {
+		return
+	}
+	for _, session := range l.sessions {
+		if session == client {
+			return
+		}
+	}
+	l.sessions = append(l.sessions, client)
+}
+
+// discardAll discards the changed sessions and returns the first error.
+func (l *sessionLifecycle) discardAll() error {
+	var firstErr error
+	for _, session := range l.sessions {
+		if err := discardSession(session); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	l.sessions = nil
+	return firstErr
+}
+
+func discardSession(client *checkpoint.ApiClient) error {
+	log.Println("Discard session changes")
+	discardRes, err := apiCall(client, "discard", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return err
+	}
+	if !discardRes.Success {
+		return fmt.Errorf("failed to discard session changes: %s", discardRes.ErrorMsg)
+	}
+	return nil
+}
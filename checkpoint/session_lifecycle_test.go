@@ -0,0 +1,168 @@
+package checkpoint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSessionLifecycleDisabled(t *testing.T) {
+	l := &sessionLifecycle{}
+	l.configure(false)
+	if err := l.begin(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := l.end(nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if l.inFlight != 0 || l.changes != 0 {
+		t.Fatalf("disabled lifecycle should not track changes")
+	}
+}
+
+func TestSessionLifecycleRejectsAfterFailure(t *testing.T) {
+	l := &sessionLifecycle{}
+	l.configure(true)
+	l.failed = true
+	if err := l.begin(); err == nil {
+		t.Fatalf("expected change to be rejected after a failure")
+	}
+
+	l.configure(true)
+	if err := l.begin(); err != nil {
+		t.Fatalf("unexpected error after reconfigure: %s", err)
+	}
+}
+
+func TestSessionLifecycleRoute(t *testing.T) {
+	called := 0
+	create := func(d *schema.ResourceData, m interface{}) error {
+		called++
+		return nil
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"checkpoint_management_host":    {Create: create},
+			"checkpoint_management_publish": {Create: create},
+		},
+	}
+	l := &sessionLifecycle{}
+	l.configure(true)
+	l.route(provider)
+
+	// Meta which is not a management client is passed through.
+	if err := provider.ResourcesMap["checkpoint_management_host"].Create(nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if called != 1 || l.inFlight != 0 || l.changes != 0 {
+		t.Fatalf("expected pass-through call, got called=%d inFlight=%d changes=%d", called, l.inFlight, l.changes)
+	}
+	if provider.ResourcesMap["checkpoint_management_publish"].Create == nil {
+		t.Fatalf("session control resource lost its create function")
+	}
+}
+
+// testPublishedNames returns the names of the published objects of the type.
+func testPublishedNames(server *fakeServer, objectType string) []string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	names := make([]string, 0)
+	for _, object := range server.published {
+		if object["type"] == objectType {
+			names = append(names, fmt.Sprint(object["name"]))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestSessionLifecyclePublish_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{"publish_when_idle": true})
+
+	testFakeApply(t, provider, "checkpoint_management_host", nil, map[string]interface{}{"name": "h1", "ipv4_address": "10.0.0.1"})
+	if names := testPublishedNames(server, "host"); strings.Join(names, ",") != "h1" {
+		t.Errorf("expected the host to be published, got %v", names)
+	}
+
+	// changes which run together are published once the last one completed
+	client := provider.Meta().(*checkpoint.ApiClient)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	addHost := func(name string, wait bool) func(*schema.ResourceData, interface{}) error {
+		return func(d *schema.ResourceData, m interface{}) error {
+			if wait {
+				close(started)
+				<-release
+			}
+			_, err := apiCall(client, "add-host", map[string]interface{}{"name": name, "ip-address": "10.0.0.2"}, client.GetSessionID(), true, false)
+			return err
+		}
+	}
+	running := &schema.Provider{ResourcesMap: map[string]*schema.Resource{
+		"checkpoint_management_a": {Create: addHost("h2", true)},
+		"checkpoint_management_b": {Create: addHost("h3", false)},
+	}}
+	l := &sessionLifecycle{}
+	l.configure(true)
+	l.route(running)
+	done := make(chan error)
+	go func() { done <- running.ResourcesMap["checkpoint_management_a"].Create(nil, client) }()
+	<-started
+	if err := running.ResourcesMap["checkpoint_management_b"].Create(nil, client); err != nil {
+		t.Fatal(err)
+	}
+	if names := testPublishedNames(server, "host"); strings.Join(names, ",") != "h1" {
+		t.Errorf("expected no publish while a change is running, got %v", names)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if names := testPublishedNames(server, "host"); strings.Join(names, ",") != "h1,h2,h3" {
+		t.Errorf("expected the changes to be published together, got %v", names)
+	}
+}
+
+func TestSessionLifecycleDiscard_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{"publish_when_idle": true})
+	state := testFakeApply(t, provider, "checkpoint_management_host", nil, map[string]interface{}{"name": "h1", "ipv4_address": "10.0.0.1"})
+
+	// the host is added, then reading it fails
+	server.fail("show-host", "Internal error")
+	if _, diags := testFakeApplyDiags(t, provider, "checkpoint_management_host", nil, map[string]interface{}{"name": "h2", "ipv4_address": "10.0.0.2"}); !diags.HasError() {
+		t.Fatalf("expected the change to fail")
+	}
+	if server.find("host", map[string]interface{}{"name": "h2"}) != nil {
+		t.Errorf("expected the failed change to be discarded")
+	}
+	if names := testPublishedNames(server, "host"); strings.Join(names, ",") != "h1" {
+		t.Errorf("expected only the successful change to be published, got %v", names)
+	}
+
+	// following changes of the apply are rejected
+	_, diags := testFakeApplyDiags(t, provider, "checkpoint_management_host", state, nil)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "discarded since a previous change") {
+		t.Errorf("expected the change to be rejected, got %v", diags)
+	}
+}
+
+func TestSessionLifecyclePublishFailure_offline(t *testing.T) {
+	server, provider := testFakeProvider(t, "web_api", map[string]interface{}{"publish_when_idle": true})
+	server.fail("publish", "Publish is not allowed")
+
+	_, diags := testFakeApplyDiags(t, provider, "checkpoint_management_host", nil, map[string]interface{}{"name": "h1", "ipv4_address": "10.0.0.1"})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "failed to publish session changes") || !strings.Contains(diags[0].Summary, "Publish is not allowed") {
+		t.Fatalf("expected the publish to fail, got %v", diags)
+	}
+	if server.find("host", map[string]interface{}{"name": "h1"}) != nil || len(testPublishedNames(server, "host")) != 0 {
+		t.Errorf("expected the changes which weren't published to be discarded")
+	}
+	commands := server.commands()
+	if commands[len(commands)-1] != "discard" {
+		t.Errorf("expected the session to be discarded, got %v", commands)
+	}
+}
@@ -0,0 +1,150 @@
+package checkpoint
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+)
+
+// Provider "stale_sessions" modes.
+const (
+	staleSessionsTakeover = "takeover"
+	staleSessionsDiscard  = "discard"
+)
+
+// showSessions returns the sessions of the domain, all pages of show-sessions.
+func showSessions(client *checkpoint.ApiClient, viewPublished bool) ([]map[string]interface{}, error) {
+	const limit = 500
+	sessions := make([]map[string]interface{}, 0)
+	for offset := 0; ; offset += limit {
+		payload := map[string]interface{}{
+			"limit":                   limit,
+			"offset":                  offset,
+			"details-level":           "full",
+			"view-published-sessions": viewPublished,
+		}
+		res, err := apiCall(client, "show-sessions", payload, client.GetSessionID(), false, client.IsProxyUsed())
+		if err != nil {
+			return nil, err
+		}
+		if !res.Success {
+			return nil, fmt.Errorf("%s", res.ErrorMsg)
+		}
+		objects, _ := res.GetData()["objects"].([]interface{})
+		for _, object := range objects {
+			if session, ok := object.(map[string]interface{}); ok {
+				sessions = append(sessions, session)
+			}
+		}
+		total, _ := res.GetData()["total"].(float64)
+		to, _ := res.GetData()["to"].(float64)
+		if len(objects) == 0 || to >= total {
+			return sessions, nil
+		}
+	}
+}
+
+// sessionLoginTime returns the last login time of the session.
+func sessionLoginTime(session map[string]interface{}) (time.Time, bool) {
+	loginTime, _ := session["last-login-time"].(map[string]interface{})
+	posix, ok := loginTime["posix"].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(posix)), true
+}
+
+// sessionAge returns the minutes since the last login of the session.
+func sessionAge(session map[string]interface{}, now time.Time) int {
+	loginTime, ok := sessionLoginTime(session)
+	if !ok {
+		return 0
+	}
+	return int(now.Sub(loginTime) / time.Minute)
+}
+
+// staleSessions returns the open sessions of earlier runs of the provider: sessions with the
+// provider session name, of the administrator of the current session, which logged in at least minAge
+// minutes ago. The newest session is first.
+func staleSessions(sessions []map[string]interface{}, currentUid string, sessionName string, username string, minAge int, now time.Time) []map[string]interface{} {
+	stale := make([]map[string]interface{}, 0)
+	for _, session := range sessions {
+		if getString(session, "uid") == currentUid || getString(session, "name") != sessionName {
+			continue
+		}
+		if getString(session, "user-name") != username {
+			continue
+		}
+		if state := getString(session, "state"); state != "" && state != "open" {
+			continue
+		}
+		if sessionAge(session, now) < minAge {
+			continue
+		}
+		stale = append(stale, session)
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		ti, _ := sessionLoginTime(stale[i])
+		tj, _ := sessionLoginTime(stale[j])
+		return ti.After(tj)
+	})
+	return stale
+}
+
+// handleStaleSessions takes over or discards the sessions an earlier run left open, e.g. when a CI
+// job died mid-apply, so their locks don't fail this run. On takeover the newest stale session is
+// taken over and the provider continues in it, so its changes are published with the changes of this
+// run. Other stale sessions are discarded. Only sessions of the administrator of the current session,
+// as show-session reports it, are handled, also when the provider logged in with an API key.
+func handleStaleSessions(client *checkpoint.ApiClient, mode string, currentUid string, sessionName string, minAge int) error {
+	res, err := apiCall(client, "show-session", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf("failed to read the current session: %s", err)
+	}
+	if !res.Success {
+		return fmt.Errorf("failed to read the current session: %s", res.ErrorMsg)
+	}
+	username := getString(res.GetData(), "user-name")
+	if username == "" {
+		log.Printf("[WARN] show-session doesn't report the administrator of session %s, stale sessions aren't handled", currentUid)
+		return nil
+	}
+
+	sessions, err := showSessions(client, false)
+	if err != nil {
+		return fmt.Errorf("failed to find stale sessions: %s", err)
+	}
+	stale := staleSessions(sessions, currentUid, sessionName, username, minAge, time.Now())
+	for i, session := range stale {
+		uid := getString(session, "uid")
+		if i == 0 && mode == staleSessionsTakeover {
+			log.Printf("Take over stale session %s [%s]", sessionName, uid)
+			if err := staleSessionCall(client, "take-over-session", map[string]interface{}{"uid": uid, "disconnect-active-session": true}); err != nil {
+				return fmt.Errorf("failed to take over stale session %s: %s", uid, err)
+			}
+			if err := staleSessionCall(client, "switch-session", map[string]interface{}{"uid": uid}); err != nil {
+				return fmt.Errorf("failed to switch to stale session %s: %s", uid, err)
+			}
+			continue
+		}
+		log.Printf("Discard stale session %s [%s]", sessionName, uid)
+		if err := staleSessionCall(client, "discard", map[string]interface{}{"uid": uid}); err != nil {
+			return fmt.Errorf("failed to discard stale session %s: %s", uid, err)
+		}
+	}
+	return nil
+}
+
+func staleSessionCall(client *checkpoint.ApiClient, command string, payload map[string]interface{}) error {
+	res, err := apiCall(client, command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return err
+	}
+	if !res.Success {
+		return fmt.Errorf("%s", res.ErrorMsg)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package checkpoint
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testStaleSessionsServer returns a server with sessions of earlier runs: two stale sessions of the
+// pipeline, a session of a run in progress and sessions of another pipeline and administrator.
+func testStaleSessionsServer() *fakeServer {
+	server := newFakeServer()
+	now := time.Now()
+	server.addSessionInfo("stale-old", "pipeline", fakeServerUsername, now.Add(-5*time.Hour))
+	server.addSessionInfo("stale-new", "pipeline", fakeServerUsername, now.Add(-2*time.Hour))
+	server.addSessionInfo("running", "pipeline", fakeServerUsername, now.Add(-10*time.Minute))
+	server.addSessionInfo("other-name", "other pipeline", fakeServerUsername, now.Add(-5*time.Hour))
+	server.addSessionInfo("other-user", "pipeline", "operator", now.Add(-5*time.Hour))
+	return server
+}
+
+func testStaleSessionsProvider(t *testing.T, server *fakeServer, mode string, config ...map[string]interface{}) {
+	raw := server.providerConfig("web_api", filepath.Join(t.TempDir(), DefaultSessionFilename))
+	for _, c := range config {
+		for k, v := range c {
+			raw[k] = v
+		}
+	}
+	raw["session_name"] = "pipeline"
+	raw["stale_sessions"] = mode
+	if diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+}
+
+func TestStaleSessionsDiscard_offline(t *testing.T) {
+	server := testStaleSessionsServer()
+	defer server.Close()
+	testStaleSessionsProvider(t, server, staleSessionsDiscard)
+
+	for uid, state := range map[string]string{
+		"stale-old":  "discarded",
+		"stale-new":  "discarded",
+		"running":    "open",
+		"other-name": "open",
+		"other-user": "open",
+	} {
+		if server.details[uid]["state"] != state {
+			t.Errorf("expected session %s to be %s, got %v", uid, state, server.details[uid]["state"])
+		}
+	}
+}
+
+func TestStaleSessionsApiKey_offline(t *testing.T) {
+	server := testStaleSessionsServer()
+	defer server.Close()
+	testStaleSessionsProvider(t, server, staleSessionsDiscard, map[string]interface{}{"username": "", "password": "", "api_key": fakeServerApiKey})
+
+	if server.details["stale-old"]["state"] != "discarded" || server.details["other-user"]["state"] != "open" {
+		t.Errorf("expected only the stale sessions of the administrator of the API key to be discarded, got %v and %v",
+			server.details["stale-old"]["state"], server.details["other-user"]["state"])
+	}
+}
+
+func TestStaleSessionsTakeover_offline(t *testing.T) {
+	server := testStaleSessionsServer()
+	defer server.Close()
+	testStaleSessionsProvider(t, server, staleSessionsTakeover)
+
+	if server.details["stale-new"]["state"] != "open" || server.details["stale-old"]["state"] != "discarded" {
+		t.Errorf("expected the newest stale session to be taken over and the older one discarded, got %v and %v",
+			server.details["stale-new"]["state"], server.details["stale-old"]["state"])
+	}
+	switched := false
+	for _, uid := range server.sessions {
+		switched = switched || uid == "stale-new"
+	}
+	if !switched {
+		t.Errorf("expected the provider session to switch to the taken over session")
+	}
+}
+
+func TestDataSourceManagementSessions_offline(t *testing.T) {
+	server := testStaleSessionsServer()
+	defer server.Close()
+	raw := server.providerConfig("web_api", filepath.Join(t.TempDir(), DefaultSessionFilename))
+	provider := Provider()
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatal(diags)
+	}
+	ds := provider.DataSourcesMap["checkpoint_management_sessions"]
+
+	d := ds.TestResourceData()
+	_ = d.Set("name", "pipeline")
+	_ = d.Set("user_name", fakeServerUsername)
+	_ = d.Set("min_age", 60)
+	if err := ds.Read(d, provider.Meta()); err != nil {
+		t.Fatal(err)
+	}
+	sessions := d.Get("sessions").([]interface{})
+	uids := map[string]bool{}
+	for _, session := range sessions {
+		uids[session.(map[string]interface{})["uid"].(string)] = true
+	}
+	if len(uids) != 2 || !uids["stale-old"] || !uids["stale-new"] {
+		t.Errorf("expected the stale sessions of the pipeline, got %v", sessions)
+	}
+	if age := sessions[0].(map[string]interface{})["age"].(int); age < 120 {
+		t.Errorf("expected the session age in minutes, got %d", age)
+	}
+}
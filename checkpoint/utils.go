@@ -1,12 +1,12 @@
 package checkpoint
 
 import (
-	"encoding/json"
 	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/sessionstore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"io/ioutil"
-	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -17,39 +17,14 @@ const (
 	DefaultSessionFilename = "sid.json"
 )
 
-type Session struct {
-	Sid string `json:"sid"`
-	Uid string `json:"uid"`
-}
-
-func (s *Session) Save(sessionFileName string) error {
-	f, err := json.MarshalIndent(s, "", " ")
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(sessionFileName, f, 0644)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// Session is the session the provider logged in with.
+type Session = sessionstore.Session
 
+// GetSession returns the session saved without a key in the session file, or its only session.
+//
+// Deprecated: use sessionstore.Store.Get, which returns the session of a server, domain and user.
 func GetSession(sessionFileName string) (Session, error) {
-	if _, err := os.Stat(sessionFileName); os.IsNotExist(err) {
-		_, err := os.Create(sessionFileName)
-		if err != nil {
-			return Session{}, err
-		}
-	}
-	b, err := ioutil.ReadFile(sessionFileName)
-	if err != nil || len(b) == 0 {
-		return Session{}, err
-	}
-	var s Session
-	if err = json.Unmarshal(b, &s); err != nil {
-		return Session{}, err
-	}
-	return s, nil
+	return sessionstore.New(sessionFileName).Legacy()
 }
 
 func CheckSession(c *checkpoint.ApiClient, uid string) bool {
@@ -242,7 +217,7 @@ func handleObjectLiteral(showCommand string, showPayload map[string]interface{},
 }
 
 func getObjectIfExists(command string, payload map[string]interface{}, client *checkpoint.ApiClient) (bool, checkpoint.APIResponse, error) {
-	response, err := client.ApiCallSimple(command, payload)
+	response, err := apiCallSimple(client, command, payload)
 	if err != nil {
 		return false, response, fmt.Errorf(err.Error())
 	}
@@ -261,7 +236,7 @@ func getObjectIfExists(command string, payload map[string]interface{}, client *c
 }
 
 func addObjectLiteral(addCommand string, addPayload map[string]interface{}, showCommand string, showPayload map[string]interface{}, client *checkpoint.ApiClient) (checkpoint.APIResponse, error) {
-	addResponse, err := client.ApiCallSimple(addCommand, addPayload)
+	addResponse, err := apiCallSimple(client, addCommand, addPayload)
 	if err != nil {
 		return addResponse, fmt.Errorf(err.Error())
 	}
@@ -283,3 +258,56 @@ func addObjectLiteral(addCommand string, addPayload map[string]interface{}, show
 func objectAlreadyExistsError(response checkpoint.APIResponse) bool {
 	return response.GetData()["errors"] != nil && len(response.GetData()["errors"].([]interface{})) > 0 && strings.Contains(response.GetData()["errors"].([]interface{})[0].(map[string]interface{})["message"].(string), "More than one object named")
 }
+
+// importGaiaResource returns an importer for Gaia resources. Gaia objects have no UID, so the
+// import ID carries the natural key of the object - the values of the given attributes separated
+// by ';' (e.g. "1.2.3.0;24" for a static route). Resources without a key (system-wide settings)
+// accept any import ID. The key attributes are set on the state and a new ID is generated with
+// the same prefix used on create; the resource read function then loads the rest of the object.
+func importGaiaResource(idPrefix string, keys ...string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if len(keys) > 0 {
+				arr := strings.Split(d.Id(), ";")
+				if len(arr) != len(keys) {
+					return nil, fmt.Errorf("invalid unique identifier format. ID format: %s", gaiaImportIdFormat(keys))
+				}
+				for i, key := range keys {
+					if err := setGaiaImportKey(d, key, arr[i]); err != nil {
+						return nil, err
+					}
+				}
+			}
+			d.SetId(idPrefix + acctest.RandString(10))
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// setGaiaImportKey sets an import key attribute, converting the value to the attribute type.
+func setGaiaImportKey(d *schema.ResourceData, key string, value string) error {
+	switch d.Get(key).(type) {
+	case int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid value [%s] for %s: expected a number", value, key)
+		}
+		return d.Set(key, n)
+	case bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value [%s] for %s: expected true or false", value, key)
+		}
+		return d.Set(key, b)
+	default:
+		return d.Set(key, value)
+	}
+}
+
+func gaiaImportIdFormat(keys []string) string {
+	format := make([]string, len(keys))
+	for i, key := range keys {
+		format[i] = "<" + strings.ToUpper(key) + ">"
+	}
+	return strings.Join(format, ";")
+}
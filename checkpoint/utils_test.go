@@ -0,0 +1,77 @@
+package checkpoint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImportGaiaResource(t *testing.T) {
+	r := resourceGaiaStaticRoute()
+	d := r.TestResourceData()
+	d.SetId("1.2.3.0;24")
+
+	res, err := r.Importer.State(d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(res) != 1 {
+		t.Fatalf("expected 1 resource, got %d", len(res))
+	}
+	if v := res[0].Get("address").(string); v != "1.2.3.0" {
+		t.Fatalf("expected address 1.2.3.0, got %s", v)
+	}
+	if v := res[0].Get("mask_length").(int); v != 24 {
+		t.Fatalf("expected mask_length 24, got %d", v)
+	}
+	if !strings.HasPrefix(res[0].Id(), "static-route-") {
+		t.Fatalf("unexpected id %s", res[0].Id())
+	}
+
+	d = r.TestResourceData()
+	d.SetId("1.2.3.0")
+	if _, err := r.Importer.State(d, nil); err == nil || !strings.Contains(err.Error(), "<ADDRESS>;<MASK_LENGTH>") {
+		t.Fatalf("expected format error, got %v", err)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("1.2.3.0;abc")
+	if _, err := r.Importer.State(d, nil); err == nil {
+		t.Fatalf("expected error for invalid mask_length")
+	}
+}
+
+func TestImportGaiaResourceWithoutKey(t *testing.T) {
+	r := resourceGaiaDns()
+	d := r.TestResourceData()
+	d.SetId("dns")
+
+	res, err := r.Importer.State(d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.HasPrefix(res[0].Id(), "dns-") {
+		t.Fatalf("unexpected id %s", res[0].Id())
+	}
+}
+
+func TestGaiaResourcesImportable(t *testing.T) {
+	// the read of these resources doesn't call the API, so an import wouldn't read anything
+	withoutRead := map[string]bool{
+		"checkpoint_gaia_igmp_interface_local_group": true,
+		"checkpoint_gaia_mld_interface_local_group":  true,
+	}
+	for name, r := range Provider().ResourcesMap {
+		if !strings.HasPrefix(name, "checkpoint_gaia_") || strings.HasPrefix(name, "checkpoint_gaia_command_") {
+			continue
+		}
+		if withoutRead[name] {
+			if r.Importer != nil {
+				t.Errorf("%s supports import without reading the resource", name)
+			}
+			continue
+		}
+		if r.Importer == nil {
+			t.Errorf("%s does not support import", name)
+		}
+	}
+}
@@ -2,9 +2,25 @@ package checkpoint
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Object colors supported by the Management API.
+var objectColors = []string{
+	"aquamarine", "black", "blue", "crete blue", "burlywood", "cyan", "dark green", "khaki", "orchid",
+	"dark orange", "dark sea green", "pink", "turquoise", "dark blue", "firebrick", "brown", "forest green",
+	"gold", "dark gold", "gray", "dark gray", "light green", "lemon chiffon", "coral", "sea green", "sky blue",
+	"magenta", "purple", "slate blue", "violet red", "navy blue", "olive", "orange", "red", "sienna", "yellow",
+}
+
+// Track values of Threat Prevention and HTTPS Inspection rules.
+var threatTrackValues = []string{
+	"None", "Log", "Alert", "Mail", "SNMP trap", "User Alert", "User Alert 1", "User Alert 2", "User Alert 3",
+}
+
 func objectNotFound(code string) bool {
 	notFoundCode := "generic_err_object_not_found"
 	return code == notFoundCode
@@ -21,8 +37,70 @@ func validateStringValue(optionalValues ...string) schema.SchemaValidateFunc {
 			}
 		}
 		if !ok {
-			errs = append(errs, fmt.Errorf("'%q' %q is not a valid value. Optional values are: %v", value, k, optionalValues))
+			errs = append(errs, invalidValueError(value, k, optionalValues))
+		}
+		return
+	}
+}
+
+// validateStringValueIgnoreCase is used for values the API matches case-insensitively (e.g. rule action "accept").
+func validateStringValueIgnoreCase(optionalValues ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (warns []string, errs []error) {
+		value := v.(string)
+		ok := false
+		for _, optionalValue := range optionalValues {
+			if strings.EqualFold(value, optionalValue) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			errs = append(errs, invalidValueError(value, k, optionalValues))
 		}
 		return
 	}
 }
+
+func validateColor() schema.SchemaValidateFunc {
+	return validateStringValue(objectColors...)
+}
+
+func validateIntRange(min int, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (warns []string, errs []error) {
+		value := v.(int)
+		if value < min || value > max {
+			errs = append(errs, fmt.Errorf("%d is not a valid value for %s. Value must be between %d and %d", value, k, min, max))
+		}
+		return
+	}
+}
+
+// validatePort accepts a port number, a port range ("44-55") or a port comparison (">1023", "<1024").
+func validatePort() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (warns []string, errs []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+		ports := []string{value}
+		if strings.HasPrefix(value, ">") || strings.HasPrefix(value, "<") {
+			ports = []string{value[1:]}
+		} else if strings.Contains(value, "-") {
+			ports = strings.SplitN(value, "-", 2)
+		}
+		prev := -1
+		for _, port := range ports {
+			n, err := strconv.Atoi(strings.TrimSpace(port))
+			if err != nil || n < 0 || n > 65535 || n < prev {
+				errs = append(errs, fmt.Errorf("%q is not a valid value for %s. Value must be a port number (0-65535), a port range (e.g. 44-55) or a port comparison (e.g. >1023)", value, k))
+				return
+			}
+			prev = n
+		}
+		return
+	}
+}
+
+func invalidValueError(value string, k string, optionalValues []string) error {
+	return fmt.Errorf("%q is not a valid value for %s. Optional values are: %s", value, k, strings.Join(optionalValues, ", "))
+}
@@ -0,0 +1,86 @@
+package checkpoint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidateStringValue(t *testing.T) {
+	f := validateStringValue("hide", "static")
+	if _, errs := f("hide", "method"); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	_, errs := f("Hide", "method")
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), "Optional values are: hide, static") {
+		t.Fatalf("expected allowed values in error, got %s", errs[0])
+	}
+}
+
+func TestValidateStringValueIgnoreCase(t *testing.T) {
+	f := validateStringValueIgnoreCase("Accept", "Drop")
+	for _, v := range []string{"Accept", "accept", "DROP"} {
+		if _, errs := f(v, "action"); len(errs) != 0 {
+			t.Fatalf("unexpected errors for %s: %v", v, errs)
+		}
+	}
+	if _, errs := f("Allow", "action"); len(errs) != 1 {
+		t.Fatalf("expected error for Allow")
+	}
+}
+
+func TestValidateIntRange(t *testing.T) {
+	f := validateIntRange(0, 32)
+	for v, valid := range map[int]bool{0: true, 24: true, 32: true, -1: false, 33: false} {
+		if _, errs := f(v, "mask_length4"); (len(errs) == 0) != valid {
+			t.Errorf("value %d: expected valid=%v, got %v", v, valid, errs)
+		}
+	}
+}
+
+func TestValidatePort(t *testing.T) {
+	f := validatePort()
+	for v, valid := range map[string]bool{
+		"":          true,
+		"80":        true,
+		"44-55":     true,
+		">1023":     true,
+		"<1024":     true,
+		"65536":     false,
+		"55-44":     false,
+		"http":      false,
+		"80-":       false,
+		">":         false,
+		"1000-2000": true,
+	} {
+		if _, errs := f(v, "port"); (len(errs) == 0) != valid {
+			t.Errorf("value %q: expected valid=%v, got %v", v, valid, errs)
+		}
+	}
+}
+
+func TestAccessRuleSchemaValidation(t *testing.T) {
+	r := resourceManagementAccessRule()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"layer":  "Network",
+		"name":   "rule",
+		"action": "Acept",
+		"position": []interface{}{
+			map[string]interface{}{"top": "top"},
+		},
+		"track": []interface{}{
+			map[string]interface{}{"type": "Log"},
+		},
+	})
+	diags := r.Validate(config)
+	if !diags.HasError() {
+		t.Fatalf("expected validation error for invalid action")
+	}
+	if !strings.Contains(diags[0].Summary, "Optional values are: Accept, Drop") {
+		t.Fatalf("expected allowed values in error, got %s", diags[0].Summary)
+	}
+}
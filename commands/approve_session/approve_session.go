@@ -18,7 +18,7 @@ func main() {
 	}
 	payload := make(map[string]interface{})
 	payload["uid"] = os.Args[1]
-	approveSessionRes, err := apiClient.ApiCall("approve-session", payload, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	approveSessionRes, err := commands.ApiCall(&apiClient, "approve-session", payload, true)
 	if err != nil {
 		fmt.Println("Approve Session error: " + err.Error())
 		os.Exit(1)
@@ -269,7 +269,7 @@ func main() {
 		log.Fatalf("error: %s", err)
 	}
 
-	publishRes, err := apiClient.ApiCall("publish", map[string]interface{}{}, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	publishRes, err := commands.ApiCall(&apiClient, "publish", map[string]interface{}{}, true)
 	if err != nil {
 		log.Fatalf("error: %s", err)
 	}
@@ -0,0 +1,468 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/commands"
+)
+
+// Exit codes, so scripts can tell a failed install on some gateways from a server which can't be reached.
+const (
+	exitOK        = 0 // the command succeeded
+	exitFailed    = 1 // the command or its task failed
+	exitUsage     = 2 // invalid command or arguments
+	exitPartial   = 3 // the task succeeded on some targets and failed on others, e.g. install-policy
+	exitTransport = 4 // the server couldn't be reached or its response couldn't be read
+	exitConfig    = 5 // invalid configuration, credentials or session
+)
+
+// Output formats.
+const (
+	outputText = "text"
+	outputJson = "json"
+)
+
+// apiFunc runs a Management API command without waiting for its task. Errors the server returns are
+// *apiError, other errors are transport errors.
+type apiFunc func(command string, payload map[string]interface{}) (map[string]interface{}, error)
+
+type apiError struct {
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+// cliCommand is a subcommand, the Management API command it runs and its arguments.
+type cliCommand struct {
+	name        string
+	apiCommand  string
+	usage       string
+	description string
+	// payload parses the arguments of the subcommand into the payload of the API command
+	payload func(fs *flag.FlagSet, args []string) (map[string]interface{}, error)
+}
+
+var cliCommands = []cliCommand{
+	{
+		name:        "publish",
+		apiCommand:  "publish",
+		description: "Publish the changes of the session.",
+		payload:     noArguments,
+	},
+	{
+		name:        "discard",
+		apiCommand:  "discard",
+		description: "Discard the changes of the session.",
+		payload:     noArguments,
+	},
+	{
+		name:        "logout",
+		apiCommand:  "logout",
+		description: "Log out of the session.",
+		payload:     noArguments,
+	},
+	{
+		name:        "install-policy",
+		apiCommand:  "install-policy",
+		usage:       "-policy-package <name> [-target <name or uid>]...",
+		description: "Install the policy package on the targets.",
+		payload:     installPolicyPayload,
+	},
+	{
+		name:        "verify-policy",
+		apiCommand:  "verify-policy",
+		usage:       "-policy-package <name>",
+		description: "Verify the policy package.",
+		payload:     verifyPolicyPayload,
+	},
+	{
+		name:        "approve-session",
+		apiCommand:  "approve-session",
+		usage:       "<session uid>",
+		description: "Approve the session.",
+		payload:     sessionPayload(false),
+	},
+	{
+		name:        "reject-session",
+		apiCommand:  "reject-session",
+		usage:       "<session uid> [comments]",
+		description: "Reject the session.",
+		payload:     sessionPayload(true),
+	},
+	{
+		name:        "submit-session",
+		apiCommand:  "submit-session",
+		usage:       "[session uid]",
+		description: "Submit the session for approval. Default is the session of the provider.",
+		payload:     submitSessionPayload,
+	},
+	{
+		name:        "show-task",
+		usage:       "<task id>...",
+		description: "Wait for the tasks and show their result.",
+		payload:     taskIdArguments,
+	},
+}
+
+// result is the outcome of a subcommand, written as JSON with -output json.
+type result struct {
+	Command string                 `json:"command"`
+	Status  string                 `json:"status"`
+	Message string                 `json:"message,omitempty"`
+	TaskIds []string               `json:"task_ids,omitempty"`
+	Targets []target               `json:"targets,omitempty"`
+	Data    map[string]interface{} `json:"data,omitempty"`
+	code    int
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, connect))
+}
+
+// connect returns the API of the session the provider saved, connected with the profile and the
+// CHECKPOINT_* environment variables.
+func connect(configFile string, profile string) (apiFunc, string, error) {
+	config, err := commands.LoadConfig(configFile, profile)
+	if err != nil {
+		return nil, "", err
+	}
+	apiClient, err := commands.NewClient(config)
+	if err != nil {
+		return nil, "", err
+	}
+	api := func(command string, payload map[string]interface{}) (map[string]interface{}, error) {
+		res, err := commands.ApiCall(apiClient, command, payload, false)
+		if err != nil {
+			return nil, err
+		}
+		if !res.Success {
+			return res.GetData(), &apiError{message: res.ErrorMsg}
+		}
+		return res.GetData(), nil
+	}
+	return api, apiClient.GetSessionID(), nil
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer, connectFunc func(string, string) (apiFunc, string, error)) int {
+	global := flag.NewFlagSet("checkpoint-cli", flag.ContinueOnError)
+	global.SetOutput(stderr)
+	var output, profile, configFile string
+	var pollInterval time.Duration
+	global.StringVar(&output, "output", outputText, "Output format, text or json.")
+	global.StringVar(&profile, "profile", "", "Profile of the configuration file. Default is CHECKPOINT_PROFILE or the default profile.")
+	global.StringVar(&configFile, "config", "", "Configuration file. Default is CHECKPOINT_CONFIG_FILE or ~/"+commands.DefaultConfigFile+".")
+	global.DurationVar(&pollInterval, "poll-interval", 2*time.Second, "Interval of polling the progress of tasks.")
+	global.Usage = func() { usage(global, stderr) }
+	if err := global.Parse(args); err != nil {
+		return exitUsage
+	}
+	if output != outputText && output != outputJson {
+		fmt.Fprintf(stderr, "invalid output %q, valid outputs: %s, %s\n", output, outputText, outputJson)
+		return exitUsage
+	}
+	if global.NArg() == 0 {
+		usage(global, stderr)
+		return exitUsage
+	}
+
+	var cmd *cliCommand
+	for i := range cliCommands {
+		if cliCommands[i].name == global.Arg(0) {
+			cmd = &cliCommands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "unknown command %q\n", global.Arg(0))
+		usage(global, stderr)
+		return exitUsage
+	}
+
+	res := runCommand(cmd, global.Args()[1:], stderr, output, pollInterval, configFile, profile, connectFunc)
+	if res == nil {
+		return exitUsage
+	}
+	writeResult(res, output, stdout, stderr)
+	return res.code
+}
+
+func usage(global *flag.FlagSet, w io.Writer) {
+	fmt.Fprintf(w, "Usage: checkpoint-cli [flags] <command> [arguments]\n\nCommands:\n")
+	for _, cmd := range cliCommands {
+		fmt.Fprintf(w, "  %-16s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(w, "\nFlags:\n")
+	global.PrintDefaults()
+}
+
+// runCommand runs the subcommand and waits for its tasks. A nil result stands for invalid arguments,
+// which were already reported.
+func runCommand(cmd *cliCommand, args []string, stderr io.Writer, output string, pollInterval time.Duration, configFile string, profile string, connectFunc func(string, string) (apiFunc, string, error)) *result {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: checkpoint-cli %s %s\n\n%s\n", cmd.name, cmd.usage, cmd.description)
+		fs.PrintDefaults()
+	}
+	// arguments are parsed before connecting, for invalid arguments to fail without a server
+	payload, err := cmd.payload(fs, args)
+	if err != nil {
+		if err != errArgumentsReported {
+			fmt.Fprintf(stderr, "%s: %s\n", cmd.name, err)
+		}
+		return nil
+	}
+
+	res := &result{Command: cmd.name}
+	api, sid, err := connectFunc(configFile, profile)
+	if err != nil {
+		return res.fail(exitConfig, err)
+	}
+	if cmd.name == "submit-session" && payload["uid"] == nil {
+		payload["uid"] = sid
+	}
+
+	var progress io.Writer
+	if output == outputText {
+		progress = stderr
+	}
+	waiter := &taskWaiter{api: api, interval: pollInterval, progress: progress}
+
+	taskIds := fs.Args()
+	if cmd.apiCommand != "" {
+		data, err := api(cmd.apiCommand, payload)
+		if err != nil {
+			return res.fail(errorCode(err), err)
+		}
+		taskIds = resolveTaskIds(data)
+		if len(taskIds) == 0 {
+			res.Status = taskSucceeded
+			res.Data = data
+			return res
+		}
+	}
+
+	res.TaskIds = taskIds
+	tasks, err := waiter.wait(taskIds)
+	if err != nil {
+		return res.fail(errorCode(err), err)
+	}
+	res.Status, res.Targets, res.Message = tasksStatus(tasks)
+	res.Data = map[string]interface{}{"tasks": tasks}
+	res.code = statusCode(res.Status)
+	return res
+}
+
+func (r *result) fail(code int, err error) *result {
+	r.Status = "failed"
+	if code == exitTransport || code == exitConfig {
+		r.Status = "error"
+	}
+	r.Message = err.Error()
+	r.code = code
+	return r
+}
+
+func errorCode(err error) int {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return exitFailed
+	}
+	return exitTransport
+}
+
+func statusCode(status string) int {
+	switch status {
+	case taskSucceeded:
+		return exitOK
+	case taskPartiallySucceeded:
+		return exitPartial
+	}
+	return exitFailed
+}
+
+func writeResult(res *result, output string, stdout io.Writer, stderr io.Writer) {
+	if output == outputJson {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(res)
+		return
+	}
+
+	w := stdout
+	if res.code != exitOK {
+		w = stderr
+	}
+	line := fmt.Sprintf("%s %s.", res.Command, res.Status)
+	if res.Message != "" {
+		line = fmt.Sprintf("%s %s: %s", res.Command, res.Status, res.Message)
+	}
+	if len(res.TaskIds) > 0 {
+		line += fmt.Sprintf(" task-id [%s]", strings.Join(res.TaskIds, ", "))
+	}
+	fmt.Fprintln(w, line)
+	for _, t := range res.Targets {
+		name := t.Name
+		if name == "" {
+			name = t.Uid
+		}
+		fmt.Fprintf(w, "  %s: %s", name, t.Status)
+		if t.Description != "" {
+			fmt.Fprintf(w, " (%s)", t.Description)
+		}
+		fmt.Fprintln(w)
+		for _, message := range t.Messages {
+			fmt.Fprintf(w, "    %s\n", message)
+		}
+	}
+}
+
+// errArgumentsReported is returned for invalid flags, which the flag set already reported.
+var errArgumentsReported = errors.New("invalid arguments")
+
+func parseArgs(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errArgumentsReported
+	}
+	return nil
+}
+
+func noArguments(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+	if err := parseArgs(fs, args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments %s", strings.Join(fs.Args(), " "))
+	}
+	return map[string]interface{}{}, nil
+}
+
+type arrayFlags []string
+
+func (i *arrayFlags) String() string {
+	return strings.Join(*i, ",")
+}
+
+func (i *arrayFlags) Set(value string) error {
+	*i = append(*i, value)
+	return nil
+}
+
+func taskIdArguments(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+	if err := parseArgs(fs, args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() == 0 {
+		return nil, fmt.Errorf("missing task id")
+	}
+	return map[string]interface{}{}, nil
+}
+
+func installPolicyPayload(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+	var targets arrayFlags
+	policyPackage := fs.String("policy-package", "", "The name of the Policy Package to be installed.")
+	fs.Var(&targets, "target", "On what targets to execute this command. Targets may be identified by their name, or object unique identifier. Multiple targets can be added.")
+	revision := fs.String("revision", "", "The UID of the revision of the policy to install.")
+	flags := map[string]*bool{
+		"access":                                 fs.Bool("access", false, "Install the Access Control policy. By default, the value is true if Access Control policy is enabled on the input policy package, otherwise false."),
+		"desktop-security":                       fs.Bool("desktop-security", false, "Install the Desktop Security policy. By default, the value is true if desktop security policy is enabled on the input policy package, otherwise false."),
+		"qos":                                    fs.Bool("qos", false, "Install the QoS policy. By default, the value is true if Quality-of-Service policy is enabled on the input policy package, otherwise false."),
+		"threat-prevention":                      fs.Bool("threat-prevention", false, "Install the Threat Prevention policy. By default, the value is true if Threat Prevention policy is enabled on the input policy package, otherwise false."),
+		"install-on-all-cluster-members-or-fail": fs.Bool("install-on-all-cluster-members-or-fail", false, "Relevant for the gateway clusters. If the installation on a cluster member fails, don't install on that cluster."),
+		"prepare-only":                           fs.Bool("prepare-only", false, "Prepare the policy for the installation, but don't install it on an installation target."),
+		"ignore-warnings":                        fs.Bool("ignore-warnings", false, "Install policy ignoring policy mismatch warnings."),
+	}
+	if err := parseArgs(fs, args); err != nil {
+		return nil, err
+	}
+	if *policyPackage == "" {
+		return nil, fmt.Errorf("missing -policy-package")
+	}
+
+	payload := map[string]interface{}{
+		"policy-package": *policyPackage,
+	}
+	if len(targets) > 0 {
+		payload["targets"] = []string(targets)
+	}
+	if *revision != "" {
+		payload["revision"] = *revision
+	}
+	// only flags which are set are sent, for the defaults of the policy package to apply
+	fs.Visit(func(f *flag.Flag) {
+		if v, ok := flags[f.Name]; ok {
+			payload[f.Name] = *v
+		}
+	})
+	return payload, nil
+}
+
+func verifyPolicyPayload(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+	policyPackage := fs.String("policy-package", "", "Policy package identified by the name or UID to be verified.")
+	if err := parseArgs(fs, args); err != nil {
+		return nil, err
+	}
+	if *policyPackage == "" {
+		return nil, fmt.Errorf("missing -policy-package")
+	}
+	return map[string]interface{}{"policy-package": *policyPackage}, nil
+}
+
+// sessionPayload parses the session uid argument, and the comments when withComments is set.
+func sessionPayload(withComments bool) func(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+	return func(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+		if err := parseArgs(fs, args); err != nil {
+			return nil, err
+		}
+		maxArgs := 1
+		if withComments {
+			maxArgs = 2
+		}
+		if fs.NArg() == 0 || fs.NArg() > maxArgs {
+			return nil, fmt.Errorf("expected the session uid argument")
+		}
+		payload := map[string]interface{}{"uid": fs.Arg(0)}
+		if withComments && fs.NArg() == 2 {
+			payload["comments"] = fs.Arg(1)
+		}
+		return payload, nil
+	}
+}
+
+func submitSessionPayload(fs *flag.FlagSet, args []string) (map[string]interface{}, error) {
+	if err := parseArgs(fs, args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 1 {
+		return nil, fmt.Errorf("unexpected arguments %s", strings.Join(fs.Args()[1:], " "))
+	}
+	payload := map[string]interface{}{}
+	if fs.NArg() == 1 {
+		payload["uid"] = fs.Arg(0)
+	}
+	return payload, nil
+}
+
+// resolveTaskIds returns the ids of the tasks the command started.
+func resolveTaskIds(data map[string]interface{}) []string {
+	ids := make([]string, 0)
+	if tasks, ok := data["tasks"].([]interface{}); ok {
+		for _, task := range tasks {
+			if taskMap, ok := task.(map[string]interface{}); ok && taskMap["task-id"] != nil {
+				ids = append(ids, fmt.Sprint(taskMap["task-id"]))
+			}
+		}
+	}
+	if v := data["task-id"]; v != nil && len(ids) == 0 {
+		ids = append(ids, fmt.Sprint(v))
+	}
+	return ids
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// fakeApi is a Management API with tasks which finish after one poll.
+type fakeApi struct {
+	calls   []string
+	results map[string]map[string]interface{} // command to its response
+	tasks   map[string]map[string]interface{} // task id to the finished task
+	polled  map[string]bool
+	err     error
+}
+
+func (f *fakeApi) call(command string, payload map[string]interface{}) (map[string]interface{}, error) {
+	f.calls = append(f.calls, command)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if command != "show-task" {
+		if data, ok := f.results[command]; ok {
+			return data, nil
+		}
+		return map[string]interface{}{}, &apiError{message: "Unknown command " + command}
+	}
+	tasks := make([]interface{}, 0)
+	for _, id := range payload["task-id"].([]string) {
+		task, ok := f.tasks[id]
+		if !ok {
+			continue
+		}
+		if !f.polled[id] {
+			f.polled[id] = true
+			tasks = append(tasks, map[string]interface{}{"task-id": id, "task-name": "task", "status": taskInProgress, "progress-percentage": 50})
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return map[string]interface{}{"tasks": tasks}, nil
+}
+
+func (f *fakeApi) connect(string, string) (apiFunc, string, error) {
+	return f.call, "sid-uid", nil
+}
+
+func testInstallTask(statuses ...string) map[string]interface{} {
+	details := make([]interface{}, 0)
+	for i, status := range statuses {
+		details = append(details, map[string]interface{}{
+			"gatewayName":       fmt.Sprintf("gw%d", i+1),
+			"gatewayId":         fmt.Sprintf("uid%d", i+1),
+			"statusCode":        status,
+			"statusDescription": status,
+		})
+	}
+	return map[string]interface{}{"task-id": "t1", "task-name": "install-policy", "status": taskSucceeded, "task-details": details}
+}
+
+func newTestApi(task map[string]interface{}) *fakeApi {
+	return &fakeApi{
+		results: map[string]map[string]interface{}{
+			"install-policy": {"task-id": "t1"},
+			"logout":         {"message": "OK"},
+		},
+		tasks:  map[string]map[string]interface{}{"t1": task},
+		polled: map[string]bool{},
+	}
+}
+
+func TestInstallPolicyExitCodes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statuses []string
+		code     int
+		status   string
+	}{
+		"succeeded": {[]string{"SUCCEEDED", "SUCCEEDED"}, exitOK, taskSucceeded},
+		"partial":   {[]string{"SUCCEEDED", "FAILED"}, exitPartial, taskPartiallySucceeded},
+		"failed":    {[]string{"FAILED", "FAILED"}, exitFailed, taskFailed},
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := newTestApi(testInstallTask(tc.statuses...))
+			var stdout, stderr bytes.Buffer
+			args := []string{"-output", "json", "-poll-interval", "1ms", "install-policy", "-policy-package", "standard", "-target", "gw1", "-target", "gw2"}
+			if code := run(args, &stdout, &stderr, api.connect); code != tc.code {
+				t.Fatalf("expected exit code %d, got %d: %s", tc.code, code, stderr.String())
+			}
+			var res result
+			if err := json.Unmarshal(stdout.Bytes(), &res); err != nil {
+				t.Fatalf("expected JSON output, got %s: %s", stdout.String(), err)
+			}
+			if res.Status != tc.status || len(res.Targets) != 2 || res.TaskIds[0] != "t1" {
+				t.Errorf("expected status %s with the targets, got %+v", tc.status, res)
+			}
+			if strings.Join(api.calls, ",") != "install-policy,show-task,show-task,show-task" {
+				t.Errorf("expected the task to be polled until it finished, got %v", api.calls)
+			}
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	api := newTestApi(testInstallTask("SUCCEEDED"))
+
+	if code := run([]string{"install-policy"}, &stdout, &stderr, api.connect); code != exitUsage || len(api.calls) > 0 {
+		t.Errorf("expected a usage error without API calls, got %d and %v", code, api.calls)
+	}
+	if code := run([]string{"unknown"}, &stdout, &stderr, api.connect); code != exitUsage {
+		t.Errorf("expected a usage error of an unknown command, got %d", code)
+	}
+	if code := run([]string{"publish"}, &stdout, &stderr, api.connect); code != exitFailed {
+		t.Errorf("expected the failed command exit code, got %d", code)
+	}
+	if code := run([]string{"logout"}, &stdout, &stderr, api.connect); code != exitOK {
+		t.Errorf("expected logout without a task to succeed, got %d: %s", code, stderr.String())
+	}
+
+	api.err = errors.New("connection refused")
+	if code := run([]string{"publish"}, &stdout, &stderr, api.connect); code != exitTransport {
+		t.Errorf("expected the transport error exit code, got %d", code)
+	}
+	noSession := func(string, string) (apiFunc, string, error) {
+		return nil, "", errors.New("session id not found")
+	}
+	if code := run([]string{"publish"}, &stdout, &stderr, noSession); code != exitConfig {
+		t.Errorf("expected the configuration error exit code, got %d", code)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Task statuses of show-task.
+const (
+	taskInProgress         = "in progress"
+	taskSucceeded          = "succeeded"
+	taskPartiallySucceeded = "partially succeeded"
+	taskFailed             = "failed"
+)
+
+// target is the result of a task on one gateway, e.g. of install-policy.
+type target struct {
+	Name        string   `json:"name"`
+	Uid         string   `json:"uid"`
+	Status      string   `json:"status"`
+	Description string   `json:"description,omitempty"`
+	Messages    []string `json:"messages,omitempty"`
+}
+
+// taskWaiter polls tasks until they finish and reports their progress.
+type taskWaiter struct {
+	api      apiFunc
+	interval time.Duration
+	progress io.Writer // nil for no progress
+}
+
+// wait returns the tasks with their details once none of them is in progress.
+func (w *taskWaiter) wait(taskIds []string) ([]map[string]interface{}, error) {
+	reported := map[string]string{}
+	for {
+		tasks, err := w.showTasks(taskIds, false)
+		if err != nil {
+			return nil, err
+		}
+		inProgress := false
+		for _, task := range tasks {
+			status := fmt.Sprint(task["status"])
+			inProgress = inProgress || status == taskInProgress
+			w.report(task, reported)
+		}
+		if !inProgress {
+			return w.showTasks(taskIds, true)
+		}
+		time.Sleep(w.interval)
+	}
+}
+
+func (w *taskWaiter) showTasks(taskIds []string, full bool) ([]map[string]interface{}, error) {
+	payload := map[string]interface{}{"task-id": taskIds}
+	if full {
+		payload["details-level"] = "full"
+	}
+	data, err := w.api("show-task", payload)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]map[string]interface{}, 0)
+	list, _ := data["tasks"].([]interface{})
+	for _, task := range list {
+		if taskMap, ok := task.(map[string]interface{}); ok {
+			tasks = append(tasks, taskMap)
+		}
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("tasks %s not found", strings.Join(taskIds, ", "))
+	}
+	return tasks, nil
+}
+
+// report writes the progress of the task when it changed since it was last reported.
+func (w *taskWaiter) report(task map[string]interface{}, reported map[string]string) {
+	if w.progress == nil {
+		return
+	}
+	id := fmt.Sprint(task["task-id"])
+	progress := fmt.Sprintf("%v %v%%", task["status"], task["progress-percentage"])
+	if reported[id] == progress {
+		return
+	}
+	reported[id] = progress
+	fmt.Fprintf(w.progress, "%v [%s]: %s\n", task["task-name"], id, progress)
+}
+
+// tasksStatus returns the overall status of the finished tasks, the results on their targets and
+// the failure messages. Tasks with targets, e.g. install-policy, get their status from the targets:
+// succeeded on some targets and failed on others is partially succeeded.
+func tasksStatus(tasks []map[string]interface{}) (string, []target, string) {
+	targets := make([]target, 0)
+	messages := make([]string, 0)
+	succeeded, failed := false, false
+	for _, task := range tasks {
+		switch status := fmt.Sprint(task["status"]); {
+		case status == taskPartiallySucceeded:
+			succeeded, failed = true, true
+		case strings.HasPrefix(status, taskSucceeded):
+			succeeded = true
+		default:
+			failed = true
+			if comments, ok := task["comments"].(string); ok && comments != "" {
+				messages = append(messages, comments)
+			}
+		}
+		details, _ := task["task-details"].([]interface{})
+		for _, detail := range details {
+			detailMap, ok := detail.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, ok := detailMap["fault-message"].(string); ok && v != "" {
+				messages = append(messages, v)
+			}
+			if t, ok := taskTarget(detailMap); ok {
+				targets = append(targets, t)
+			}
+		}
+	}
+	if len(targets) > 0 {
+		succeeded, failed = false, false
+	}
+	for _, t := range targets {
+		if t.Status == taskFailed {
+			failed = true
+		} else {
+			succeeded = true
+		}
+	}
+
+	status := taskSucceeded
+	switch {
+	case succeeded && failed:
+		status = taskPartiallySucceeded
+	case failed:
+		status = taskFailed
+	}
+	return status, targets, strings.Join(messages, "; ")
+}
+
+// taskTarget returns the result on the gateway of a task-details entry, e.g. of install-policy.
+func taskTarget(detail map[string]interface{}) (target, bool) {
+	name, _ := detail["gatewayName"].(string)
+	uid, _ := detail["gatewayId"].(string)
+	if name == "" && uid == "" {
+		return target{}, false
+	}
+	t := target{
+		Name:     name,
+		Uid:      uid,
+		Status:   strings.ToLower(fmt.Sprint(detail["statusCode"])),
+		Messages: make([]string, 0),
+	}
+	t.Description, _ = detail["statusDescription"].(string)
+	stages, _ := detail["stagesInfo"].([]interface{})
+	for _, stage := range stages {
+		stageMap, _ := stage.(map[string]interface{})
+		stageMessages, _ := stageMap["messages"].([]interface{})
+		for _, message := range stageMessages {
+			if messageMap, ok := message.(map[string]interface{}); ok {
+				if v, ok := messageMap["message"].(string); ok && v != "" {
+					t.Messages = append(t.Messages, v)
+				}
+			}
+		}
+	}
+	return t, true
+}
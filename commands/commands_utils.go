@@ -1,11 +1,11 @@
 package commands
 
 import (
-	"encoding/json"
 	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
-	"io/ioutil"
-	"os"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/servercert"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/sessionstore"
+	"net"
 	"strconv"
 	"time"
 )
@@ -14,27 +14,14 @@ const (
 	DefaultFilename = "sid.json"
 )
 
-type Session struct {
-	Sid string `json:"sid"`
-	Uid string `json:"uid"`
-}
+// Session is a session the provider saved.
+type Session = sessionstore.Session
 
+// GetSession returns the session saved without a key in the session file, or its only session.
+//
+// Deprecated: use sessionstore.Store.Get, which returns the session of a server, domain and user.
 func GetSession(sessionFileName string) (Session, error) {
-	if _, err := os.Stat(sessionFileName); os.IsNotExist(err) {
-		_, err := os.Create(sessionFileName)
-		if err != nil {
-			return Session{}, err
-		}
-	}
-	b, err := ioutil.ReadFile(sessionFileName)
-	if err != nil || len(b) == 0 {
-		return Session{}, err
-	}
-	var s Session
-	if err = json.Unmarshal(b, &s); err != nil {
-		return Session{}, err
-	}
-	return s, nil
+	return sessionstore.New(sessionFileName).Legacy()
 }
 
 func ResolveTaskId(data map[string]interface{}) interface{} {
@@ -53,79 +40,66 @@ func ResolveTaskId(data map[string]interface{}) interface{} {
 	return nil
 }
 
+// InitClient returns a client of the session the provider saved, connected with the CHECKPOINT_*
+// environment variables.
 func InitClient() (checkpoint.ApiClient, error) {
-	// Default values
-	port := checkpoint.DefaultPort
-	timeout := checkpoint.TimeOut
-	proxyPort := checkpoint.DefaultProxyPort
-	autoPublishBatchSize := -1
-
-	// Get credentials from Environment variables
-	server := os.Getenv("CHECKPOINT_SERVER")
-	username := os.Getenv("CHECKPOINT_USERNAME")
-	password := os.Getenv("CHECKPOINT_PASSWORD")
-	portVal := os.Getenv("CHECKPOINT_PORT")
-	timeoutVal := os.Getenv("CHECKPOINT_TIMEOUT")
-	sessionFileName := os.Getenv("CHECKPOINT_SESSION_FILE_NAME")
-	proxyHost := os.Getenv("CHECKPOINT_PROXY_HOST")
-	proxyPortStr := os.Getenv("CHECKPOINT_PROXY_PORT")
-	apiKey := os.Getenv("CHECKPOINT_API_KEY")
-	cloudMgmtId := os.Getenv("CHECKPOINT_CLOUD_MGMT_ID")
-	autoPublishBatchSizeVal := os.Getenv("CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE")
-
-	var err error
-	if portVal != "" {
-		port, err = strconv.Atoi(portVal)
-		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_PORT to integer")
-		}
+	config, err := ConfigFromEnv()
+	if err != nil {
+		return checkpoint.ApiClient{}, err
 	}
-
-	if proxyPortStr != "" {
-		proxyPort, err = strconv.Atoi(proxyPortStr)
-		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_PROXY_PORT to integer")
-		}
+	mgmt, err := NewClient(config)
+	if err != nil {
+		return checkpoint.ApiClient{}, err
 	}
+	return *mgmt, nil
+}
 
-	if timeoutVal != "" {
-		timeoutInteger, err := strconv.Atoi(timeoutVal)
-		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_TIMEOUT to integer")
-		}
-		timeout = time.Duration(timeoutInteger)
+// NewClient returns a client of the session the provider saved for the server, domain and user of
+// the configuration.
+func NewClient(config Config) (*checkpoint.ApiClient, error) {
+	timeout := checkpoint.TimeOut
+	if config.Timeout != 0 {
+		timeout = time.Duration(config.Timeout)
 	}
 
+	sessionFileName := config.SessionFileName
 	if sessionFileName == "" {
 		sessionFileName = DefaultFilename
 	}
 
-	if autoPublishBatchSizeVal != "" {
-		autoPublishBatchSize, err = strconv.Atoi(timeoutVal)
-		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE to integer")
-		}
+	if config.Server == "" || ((config.Username == "" || config.Password == "") && config.ApiKey == "") {
+		return nil, fmt.Errorf("missing at least one required parameter to initialize API client (CHECKPOINT_SERVER, (CHECKPOINT_USERNAME and CHECKPOINT_PASSWORD) OR CHECKPOINT_API_KEY)")
 	}
 
-	if server == "" || ((username == "" || password == "") && apiKey == "") {
-		return checkpoint.ApiClient{}, fmt.Errorf("missing at least one required parameter to initialize API client (CHECKPOINT_SERVER, (CHECKPOINT_USERNAME and CHECKPOINT_PASSWORD) OR CHECKPOINT_API_KEY)")
+	// install policy/publish - only on management api
+	if config.Context == "gaia_api" {
+		return nil, fmt.Errorf("post apply/destroy scripts are valid only on management api. Env var CHECKPOINT_CONTEXT is 'gaia_api'")
 	}
 
-	// install policy/publish - only on management api
-	if val, ok := os.LookupEnv("CHECKPOINT_CONTEXT"); ok {
-		if val == "gaia_api" {
-			return checkpoint.ApiClient{}, fmt.Errorf("post apply/destroy scripts are valid only on management api. Env var CHECKPOINT_CONTEXT is 'gaia_api'")
-		}
+	// verify the server certificate on the connection of each request, before the session id is sent
+	trust, err := servercert.New(config.ServerCertificateFingerprint, config.CaCertificatePem)
+	if err != nil {
+		return nil, err
+	}
+	proxy := ""
+	if config.ProxyHost != checkpoint.DefaultProxyHost && config.ProxyPort != checkpoint.DefaultProxyPort {
+		proxy = net.JoinHostPort(config.ProxyHost, strconv.Itoa(config.ProxyPort))
+	}
+	port := config.Port
+	if port == -1 {
+		port = checkpoint.DefaultPort
 	}
+	// the transport servercert installs tunnels through the proxy, the SDK proxy client would replace it
+	servercert.Register(net.JoinHostPort(config.Server, strconv.Itoa(port)), trust, proxy)
 
 	args := checkpoint.ApiClientArgs{
-		Port:                    port,
+		Port:                    config.Port,
 		Fingerprint:             "",
 		Sid:                     "",
-		Server:                  server,
-		ProxyHost:               proxyHost,
-		ProxyPort:               proxyPort,
-		ApiVersion:              "",
+		Server:                  config.Server,
+		ProxyHost:               checkpoint.DefaultProxyHost,
+		ProxyPort:               checkpoint.DefaultProxyPort,
+		ApiVersion:              config.ApiVersion,
 		IgnoreServerCertificate: false,
 		AcceptServerCertificate: false,
 		DebugFile:               "deb.txt",
@@ -133,21 +107,25 @@ func InitClient() (checkpoint.ApiClient, error) {
 		Timeout:                 timeout,
 		Sleep:                   checkpoint.SleepTime,
 		UserAgent:               "Terraform",
-		CloudMgmtId:             cloudMgmtId,
-		AutoPublishBatchSize:    autoPublishBatchSize,
+		CloudMgmtId:             config.CloudMgmtId,
+		AutoPublishBatchSize:    config.AutoPublishBatchSize,
 	}
 
-	s, err := GetSession(sessionFileName)
+	// use the session the provider saved for the same server, domain and user
+	s, err := sessionstore.New(sessionFileName).Get(sessionstore.NewKey(config.Server, config.Domain, config.Username, config.ApiKey, config.CloudMgmtId))
 	if err != nil {
-		return checkpoint.ApiClient{}, err
+		return nil, err
 	}
 	if s.Sid != "" {
 		args.Sid = s.Sid
 	} else {
-		return checkpoint.ApiClient{}, fmt.Errorf("session id not found. Verify %s file exists in working directory", sessionFileName)
+		return nil, fmt.Errorf("session id not found. Verify %s file exists in working directory and holds a session of server %s", sessionFileName, config.Server)
 	}
 
-	mgmt := checkpoint.APIClient(args)
+	return checkpoint.APIClient(args), nil
+}
 
-	return *mgmt, nil
+// ApiCall runs the command in the session of a client NewClient or InitClient returned.
+func ApiCall(client *checkpoint.ApiClient, command string, payload map[string]interface{}, waitForTask bool) (checkpoint.APIResponse, error) {
+	return client.ApiCall(command, payload, client.GetSessionID(), waitForTask, client.IsProxyUsed())
 }
@@ -0,0 +1,188 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+)
+
+const (
+	// DefaultConfigFile is the configuration file in the home directory of the user.
+	DefaultConfigFile = ".checkpoint/config.json"
+	// DefaultProfile is the profile used when no profile is selected.
+	DefaultProfile = "default"
+)
+
+// Config is the Management Server and the credentials the commands connect with. The fields are
+// named after the provider arguments, and can be set in a profile of the configuration file or with
+// the CHECKPOINT_* environment variables.
+type Config struct {
+	Server                       string `json:"server"`
+	Username                     string `json:"username"`
+	Password                     string `json:"password"`
+	ApiKey                       string `json:"api_key"`
+	Domain                       string `json:"domain"`
+	CloudMgmtId                  string `json:"cloud_mgmt_id"`
+	Context                      string `json:"context"`
+	Port                         int    `json:"port"`
+	Timeout                      int    `json:"timeout"`
+	SessionFileName              string `json:"session_file_name"`
+	ProxyHost                    string `json:"proxy_host"`
+	ProxyPort                    int    `json:"proxy_port"`
+	AutoPublishBatchSize         int    `json:"auto_publish_batch_size"`
+	ServerCertificateFingerprint string `json:"server_certificate_fingerprint"`
+	CaCertificatePem             string `json:"ca_certificate_pem"`
+	ApiVersion                   string `json:"api_version"`
+}
+
+// configFile is the configuration file, profiles by name.
+type configFile struct {
+	Profiles map[string]json.RawMessage `json:"profiles"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		Port:                 checkpoint.DefaultPort,
+		ProxyPort:            checkpoint.DefaultProxyPort,
+		SessionFileName:      DefaultFilename,
+		AutoPublishBatchSize: -1,
+	}
+}
+
+// ConfigFromEnv returns the configuration of the CHECKPOINT_* environment variables.
+func ConfigFromEnv() (Config, error) {
+	config := defaultConfig()
+	if err := config.applyEnv(); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// LoadConfig returns the configuration of the profile in the configuration file and the
+// CHECKPOINT_* environment variables which are set. An empty path stands for
+// CHECKPOINT_CONFIG_FILE or the default configuration file, and an empty profile for
+// CHECKPOINT_PROFILE or the default profile. The configuration file is optional unless a profile
+// or a path is selected. When the path or the profile is given, the fields of the profile take
+// precedence over the environment variables, otherwise the environment variables take precedence.
+func LoadConfig(path string, profile string) (Config, error) {
+	explicit := path != "" || profile != ""
+	required := explicit
+	if path == "" {
+		path = os.Getenv("CHECKPOINT_CONFIG_FILE")
+		required = required || path != ""
+	}
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err == nil {
+			path = filepath.Join(home, DefaultConfigFile)
+		}
+	}
+	if profile == "" {
+		profile = os.Getenv("CHECKPOINT_PROFILE")
+		required = required || profile != ""
+	}
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	config := defaultConfig()
+	if explicit {
+		if err := config.applyEnv(); err != nil {
+			return Config{}, err
+		}
+	}
+	if path != "" {
+		if err := config.readProfile(path, profile, required); err != nil {
+			return Config{}, err
+		}
+	}
+	if !explicit {
+		if err := config.applyEnv(); err != nil {
+			return Config{}, err
+		}
+	}
+	return config, nil
+}
+
+// readProfile reads the profile of the configuration file. The file holds credentials, so it must
+// not be accessible to other users.
+func (c *Config) readProfile(path string, profile string, required bool) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) && !required {
+			return nil
+		}
+		return fmt.Errorf("failed to read configuration file: %s", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return fmt.Errorf("configuration file %s is accessible to other users, restrict its permissions to 0600", path)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read configuration file: %s", err)
+	}
+	var file configFile
+	if err := json.Unmarshal(b, &file); err != nil {
+		return fmt.Errorf("failed to parse configuration file %s: %s", path, err)
+	}
+	raw, ok := file.Profiles[profile]
+	if !ok {
+		if !required {
+			return nil
+		}
+		names := make([]string, 0, len(file.Profiles))
+		for name := range file.Profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("profile %s not found in configuration file %s, profiles: %s", profile, path, strings.Join(names, ", "))
+	}
+	if err := json.Unmarshal(raw, c); err != nil {
+		return fmt.Errorf("failed to parse profile %s of configuration file %s: %s", profile, path, err)
+	}
+	return nil
+}
+
+// applyEnv overrides the configuration with the CHECKPOINT_* environment variables which are set.
+func (c *Config) applyEnv() error {
+	for env, field := range map[string]*string{
+		"CHECKPOINT_SERVER":                         &c.Server,
+		"CHECKPOINT_USERNAME":                       &c.Username,
+		"CHECKPOINT_PASSWORD":                       &c.Password,
+		"CHECKPOINT_API_KEY":                        &c.ApiKey,
+		"CHECKPOINT_DOMAIN":                         &c.Domain,
+		"CHECKPOINT_CLOUD_MGMT_ID":                  &c.CloudMgmtId,
+		"CHECKPOINT_CONTEXT":                        &c.Context,
+		"CHECKPOINT_SESSION_FILE_NAME":              &c.SessionFileName,
+		"CHECKPOINT_PROXY_HOST":                     &c.ProxyHost,
+		"CHECKPOINT_SERVER_CERTIFICATE_FINGERPRINT": &c.ServerCertificateFingerprint,
+		"CHECKPOINT_CA_CERTIFICATE_PEM":             &c.CaCertificatePem,
+		"CHECKPOINT_API_VERSION":                    &c.ApiVersion,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+	for env, field := range map[string]*int{
+		"CHECKPOINT_PORT":                    &c.Port,
+		"CHECKPOINT_TIMEOUT":                 &c.Timeout,
+		"CHECKPOINT_PROXY_PORT":              &c.ProxyPort,
+		"CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE": &c.AutoPublishBatchSize,
+	} {
+		if v := os.Getenv(env); v != "" {
+			value, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s to integer", env)
+			}
+			*field = value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testConfigFile = `{
+  "profiles": {
+    "default": {"server": "10.0.0.1", "username": "admin", "password": "secret"},
+    "lab": {"server": "10.0.0.2", "api_key": "key", "domain": "Lab", "port": 4434}
+  }
+}`
+
+func writeTestConfig(t *testing.T, mode os.FileMode) string {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(testConfigFile), mode); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	path := writeTestConfig(t, 0600)
+	t.Setenv("CHECKPOINT_SERVER", "")
+	t.Setenv("CHECKPOINT_DOMAIN", "")
+
+	config, err := LoadConfig(path, "lab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server != "10.0.0.2" || config.ApiKey != "key" || config.Domain != "Lab" || config.Port != 4434 {
+		t.Errorf("expected the lab profile, got %+v", config)
+	}
+	if config.SessionFileName != DefaultFilename || config.AutoPublishBatchSize != -1 {
+		t.Errorf("expected the defaults of unset fields, got %+v", config)
+	}
+
+	// environment variables take precedence over the profile
+	t.Setenv("CHECKPOINT_DOMAIN", "Prod")
+	t.Setenv("CHECKPOINT_PROFILE", "default")
+	config, err = LoadConfig(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server != "10.0.0.1" || config.Username != "admin" || config.Domain != "Prod" {
+		t.Errorf("expected the default profile with the domain of the environment, got %+v", config)
+	}
+}
+
+func TestLoadConfigExplicitProfile(t *testing.T) {
+	path := writeTestConfig(t, 0600)
+	t.Setenv("CHECKPOINT_SERVER", "10.0.0.9")
+	t.Setenv("CHECKPOINT_DOMAIN", "Prod")
+	t.Setenv("CHECKPOINT_USERNAME", "pipeline")
+	t.Setenv("CHECKPOINT_PROFILE", "default")
+
+	// the selected profile takes precedence over the environment variables
+	config, err := LoadConfig(path, "lab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server != "10.0.0.2" || config.Domain != "Lab" || config.ApiKey != "key" {
+		t.Errorf("expected the server and the domain of the lab profile, got %+v", config)
+	}
+	if config.Username != "pipeline" {
+		t.Errorf("expected the environment to set the fields the profile doesn't set, got %+v", config)
+	}
+
+	// so does the profile of a selected configuration file
+	config, err = LoadConfig(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server != "10.0.0.1" || config.Username != "admin" || config.Domain != "Prod" {
+		t.Errorf("expected the default profile with the domain of the environment, got %+v", config)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	t.Setenv("CHECKPOINT_CONFIG_FILE", "")
+	t.Setenv("CHECKPOINT_PROFILE", "")
+
+	if _, err := LoadConfig(writeTestConfig(t, 0600), "prod"); err == nil || !strings.Contains(err.Error(), "default, lab") {
+		t.Errorf("expected an unknown profile error, got %v", err)
+	}
+	if _, err := LoadConfig(writeTestConfig(t, 0644), "lab"); err == nil || !strings.Contains(err.Error(), "0600") {
+		t.Errorf("expected a permissions error, got %v", err)
+	}
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.json"), ""); err == nil {
+		t.Errorf("expected an error of a missing configuration file")
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("CHECKPOINT_PORT", "https")
+	if _, err := LoadConfig("", ""); err == nil || !strings.Contains(err.Error(), "CHECKPOINT_PORT") {
+		t.Errorf("expected an invalid port error, got %v", err)
+	}
+}
@@ -13,7 +13,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	discardRes, err := apiClient.ApiCall("discard", map[string]interface{}{}, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	discardRes, err := commands.ApiCall(&apiClient, "discard", map[string]interface{}{}, true)
 	if err != nil {
 		fmt.Println("Discard error: " + err.Error())
 		os.Exit(1)
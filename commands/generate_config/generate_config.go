@@ -0,0 +1,351 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/checkpoint"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/commands"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Number of objects and rules to fetch per request.
+const pageSize = 500
+
+// apiFunc runs a Management API command and returns the response data.
+type apiFunc func(command string, payload map[string]interface{}) (map[string]interface{}, error)
+
+// generator collects the objects and rulebases of the management database and writes them as
+// resources with import blocks. Resources are collected first, so references to objects which are
+// generated as well can be written as Terraform references.
+type generator struct {
+	api       apiFunc
+	resources map[string]*schema.Resource
+	types     map[string]bool // object types to generate, all when empty
+	blocks    []*resourceBlock
+	addresses map[string]string // object uid to resource address
+	names     map[string]bool   // used resource addresses
+	skipped   map[string]int    // object types without a resource
+}
+
+// resourceBlock is a resource to generate from an API object.
+type resourceBlock struct {
+	resourceType string
+	name         string
+	importId     string
+	object       map[string]interface{}
+	attributes   map[string]string // attributes which are not read from the object, as HCL expressions
+	position     []string          // position attribute and HCL expression for rules and sections
+}
+
+func (b *resourceBlock) address() string {
+	return b.resourceType + "." + b.name
+}
+
+func newGenerator(api apiFunc, resources map[string]*schema.Resource, types []string) *generator {
+	g := &generator{
+		api:       api,
+		resources: resources,
+		types:     make(map[string]bool),
+		addresses: make(map[string]string),
+		names:     make(map[string]bool),
+		skipped:   make(map[string]int),
+	}
+	for _, t := range types {
+		if t = strings.TrimSpace(t); t != "" {
+			g.types[t] = true
+		}
+	}
+	return g
+}
+
+func main() {
+	var output string
+	var types string
+	var skipRulebases bool
+
+	flag.StringVar(&output, "output", "", "File to write the generated configuration to. Default is the standard output.")
+	flag.StringVar(&types, "types", "", "Comma separated object types to generate, e.g. host,network,group. Default is all types.")
+	flag.BoolVar(&skipRulebases, "skip-rulebases", false, "Don't generate access and NAT rulebases.")
+	flag.Parse()
+
+	apiClient, err := commands.InitClient()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Generate config error: "+err.Error())
+		os.Exit(1)
+	}
+
+	api := func(command string, payload map[string]interface{}) (map[string]interface{}, error) {
+		res, err := commands.ApiCall(&apiClient, command, payload, true)
+		if err != nil {
+			return nil, err
+		}
+		if !res.Success {
+			return nil, fmt.Errorf("%s failed: %s", command, res.ErrorMsg)
+		}
+		return res.GetData(), nil
+	}
+
+	g := newGenerator(api, checkpoint.Provider().ResourcesMap, strings.Split(types, ","))
+	if err := g.collect(!skipRulebases); err != nil {
+		fmt.Fprintln(os.Stderr, "Generate config error: "+err.Error())
+		os.Exit(1)
+	}
+
+	out := os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Generate config error: "+err.Error())
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
+	}
+	if _, err := out.WriteString(g.render()); err != nil {
+		fmt.Fprintln(os.Stderr, "Generate config error: "+err.Error())
+		os.Exit(1)
+	}
+
+	for _, t := range sortedKeys(g.skipped) {
+		fmt.Fprintf(os.Stderr, "Skipped %d objects of type %s which has no resource\n", g.skipped[t], t)
+	}
+	destination := output
+	if destination == "" {
+		destination = "the standard output"
+	}
+	fmt.Fprintf(os.Stderr, "Generate config finished successfully. %d resources written to %s\n", len(g.blocks), destination)
+}
+
+// collect fetches the objects, packages and layers and, when rulebases is set, the access and NAT rules.
+func (g *generator) collect(rulebases bool) error {
+	if err := g.collectObjects(); err != nil {
+		return err
+	}
+	if !rulebases {
+		return nil
+	}
+
+	packages, err := g.list("show-packages", "packages", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	layers, err := g.list("show-access-layers", "access-layers", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	for _, layer := range layers {
+		g.add("access-layer", layer, stringValue(layer["uid"]))
+	}
+	for _, pkg := range packages {
+		g.add("package", pkg, stringValue(pkg["uid"]))
+	}
+
+	for _, layer := range layers {
+		if err := g.collectRulebase("show-access-rulebase", "access", "layer", layer); err != nil {
+			return err
+		}
+	}
+	for _, pkg := range packages {
+		if nat, _ := pkg["nat-policy"].(bool); nat {
+			if err := g.collectRulebase("show-nat-rulebase", "nat", "package", pkg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectObjects adds the objects of show-objects which have a resource. Predefined objects are skipped.
+func (g *generator) collectObjects() error {
+	objects, err := g.list("show-objects", "objects", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if domain, ok := object["domain"].(map[string]interface{}); ok && domain["domain-type"] == "data domain" {
+			continue
+		}
+		if readOnly, _ := object["read-only"].(bool); readOnly {
+			continue
+		}
+		g.add(stringValue(object["type"]), object, stringValue(object["uid"]))
+	}
+	return nil
+}
+
+// collectRulebase adds the sections and rules of a rulebase. Rules are positioned below the
+// previous rule, or on top of their section.
+func (g *generator) collectRulebase(command string, kind string, container string, owner map[string]interface{}) error {
+	ownerName := stringValue(owner["name"])
+	payload := map[string]interface{}{"uid": owner["uid"], "use-object-dictionary": false}
+	if container == "package" {
+		payload = map[string]interface{}{"package": ownerName, "use-object-dictionary": false}
+	}
+	items, err := g.list(command, "rulebase", payload)
+	if err != nil {
+		return err
+	}
+
+	ownerExpr := quote(ownerName)
+	if address, ok := g.addresses[stringValue(owner["uid"])]; ok {
+		ownerExpr = address + ".name"
+	}
+	sections := make(map[string]*resourceBlock)
+	prev := ""
+	var walk func(items []interface{}, section string)
+	walk = func(items []interface{}, section string) {
+		for _, v := range items {
+			item, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			uid := stringValue(item["uid"])
+			switch stringValue(item["type"]) {
+			case kind + "-section":
+				if _, ok := sections[uid]; !ok {
+					b := g.add(kind+"-section", item, ownerName+";"+uid)
+					if b == nil {
+						continue
+					}
+					b.attributes[container] = ownerExpr
+					b.position = position(prev)
+					sections[uid] = b
+					prev = b.address()
+				}
+				// Sections which are split between pages are returned by each page.
+				children, _ := item["rulebase"].([]interface{})
+				walk(children, sections[uid].address())
+			case kind + "-rule":
+				if auto, _ := item["auto-generated"].(bool); auto {
+					continue
+				}
+				b := g.add(kind+"-rule", item, ownerName+";"+uid)
+				if b == nil {
+					continue
+				}
+				b.attributes[container] = ownerExpr
+				if section != "" && (prev == section || prev == "") {
+					b.position = []string{"top", section + ".id"}
+				} else {
+					b.position = position(prev)
+				}
+				prev = b.address()
+			}
+		}
+	}
+	rulebase := make([]interface{}, len(items))
+	for i, item := range items {
+		rulebase[i] = item
+	}
+	walk(rulebase, "")
+	return nil
+}
+
+// position returns the position below the previous rule or section, or on top of the rulebase.
+func position(prev string) []string {
+	if prev == "" {
+		return []string{"top", quote("top")}
+	}
+	return []string{"below", prev + ".id"}
+}
+
+// add adds a resource for the object and returns it, or nil when the type has no resource or
+// the object was already added.
+func (g *generator) add(objectType string, object map[string]interface{}, importId string) *resourceBlock {
+	uid := stringValue(object["uid"])
+	if _, ok := g.addresses[uid]; ok && uid != "" {
+		return nil
+	}
+	if len(g.types) > 0 && !g.types[objectType] && !strings.HasSuffix(objectType, "-rule") && !strings.HasSuffix(objectType, "-section") {
+		return nil
+	}
+	resourceType := "checkpoint_management_" + strings.ReplaceAll(objectType, "-", "_")
+	if _, ok := g.resources[resourceType]; !ok {
+		g.skipped[objectType]++
+		return nil
+	}
+
+	name := stringValue(object["name"])
+	if name == "" {
+		name = objectType
+	}
+	b := &resourceBlock{
+		resourceType: resourceType,
+		name:         g.uniqueName(resourceType, name),
+		importId:     importId,
+		object:       object,
+		attributes:   make(map[string]string),
+	}
+	g.blocks = append(g.blocks, b)
+	if uid != "" {
+		g.addresses[uid] = b.address()
+	}
+	return b
+}
+
+// uniqueName returns a Terraform resource name for the object name which is not used yet.
+func (g *generator) uniqueName(resourceType string, name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune('_')
+		}
+	}
+	base := sb.String()
+	if base == "" || (base[0] >= '0' && base[0] <= '9') || base[0] == '-' {
+		base = "_" + base
+	}
+	candidate := base
+	for i := 2; g.names[resourceType+"."+candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.names[resourceType+"."+candidate] = true
+	return candidate
+}
+
+// list fetches all pages of a show command and returns the objects of the list key.
+func (g *generator) list(command string, key string, payload map[string]interface{}) ([]map[string]interface{}, error) {
+	var objects []map[string]interface{}
+	for offset := 0; ; {
+		request := map[string]interface{}{"details-level": "full", "limit": pageSize, "offset": offset}
+		for k, v := range payload {
+			request[k] = v
+		}
+		data, err := g.api(command, request)
+		if err != nil {
+			return nil, err
+		}
+		page, _ := data[key].([]interface{})
+		for _, v := range page {
+			if object, ok := v.(map[string]interface{}); ok {
+				objects = append(objects, object)
+			}
+		}
+		total, _ := data["total"].(float64)
+		to, _ := data["to"].(float64)
+		if len(page) == 0 || to == 0 || int(to) >= int(total) {
+			return objects, nil
+		}
+		offset = int(to)
+	}
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
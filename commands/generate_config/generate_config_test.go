@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/CheckPointSW/terraform-provider-checkpoint/v3/checkpoint"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	predefined := map[string]interface{}{"domain-type": "data domain", "name": "Check Point Data"}
+	local := map[string]interface{}{"domain-type": "domain", "name": "SMC User"}
+	host := map[string]interface{}{"uid": "u-host", "name": "web server", "type": "host", "domain": local, "ipv4-address": "192.0.2.1", "color": "black", "comments": ""}
+	responses := map[string]map[string]interface{}{
+		"show-objects": {"total": float64(4), "to": float64(4), "objects": []interface{}{
+			host,
+			map[string]interface{}{"uid": "u-group", "name": "servers", "type": "group", "domain": local, "members": []interface{}{host, map[string]interface{}{"uid": "u-any", "name": "Any"}}},
+			map[string]interface{}{"uid": "u-http", "name": "http", "type": "service-tcp", "domain": predefined, "port": "80"},
+			map[string]interface{}{"uid": "u-vpn", "name": "vpn1", "type": "unknown-type", "domain": local},
+		}},
+		"show-packages": {"total": float64(1), "to": float64(1), "packages": []interface{}{
+			map[string]interface{}{"uid": "u-pkg", "name": "Standard", "type": "package", "nat-policy": false},
+		}},
+		"show-access-layers": {"total": float64(1), "to": float64(1), "access-layers": []interface{}{
+			map[string]interface{}{"uid": "u-layer", "name": "Network", "type": "access-layer"},
+		}},
+		"show-access-rulebase": {"total": float64(2), "to": float64(2), "rulebase": []interface{}{
+			map[string]interface{}{"uid": "u-section", "name": "Web", "type": "access-section", "rulebase": []interface{}{
+				map[string]interface{}{"uid": "u-rule1", "name": "allow web", "type": "access-rule", "destination": []interface{}{"u-group"}, "action": map[string]interface{}{"uid": "u-accept", "name": "Accept"}},
+				map[string]interface{}{"uid": "u-rule2", "name": "cleanup", "type": "access-rule", "action": map[string]interface{}{"uid": "u-drop", "name": "Drop"}},
+			}},
+		}},
+	}
+	api := func(command string, payload map[string]interface{}) (map[string]interface{}, error) {
+		if res, ok := responses[command]; ok {
+			return res, nil
+		}
+		return nil, fmt.Errorf("unexpected command %s", command)
+	}
+
+	g := newGenerator(api, checkpoint.Provider().ResourcesMap, nil)
+	if err := g.collect(true); err != nil {
+		t.Fatal(err)
+	}
+	out := g.render()
+
+	for _, expected := range []string{
+		"import {\n  to = checkpoint_management_host.web_server\n  id = \"u-host\"\n}",
+		"resource \"checkpoint_management_host\" \"web_server\" {\n  name = \"web server\"\n  ipv4_address = \"192.0.2.1\"\n}",
+		"members = [checkpoint_management_host.web_server.name, \"Any\"]",
+		"id = \"Network;u-rule1\"",
+		"layer = checkpoint_management_access_layer.network.name",
+		"position {\n    top = checkpoint_management_access_section.web.id\n  }",
+		"position {\n    below = checkpoint_management_access_rule.allow_web.id\n  }",
+		"destination = [checkpoint_management_group.servers.name]",
+		"action = \"Accept\"",
+		"ignore_changes = [position]",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected generated config to contain\n%s\n\ngot:\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "service_tcp") {
+		t.Errorf("predefined objects should not be generated")
+	}
+	if g.skipped["unknown-type"] != 1 {
+		t.Errorf("expected objects without a resource to be skipped, got %v", g.skipped)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	if q := quote("a \"b\" ${c} %{d}"); q != `"a \"b\" $${c} %%{d}"` {
+		t.Errorf("unexpected quoted string %s", q)
+	}
+}
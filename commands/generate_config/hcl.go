@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes which are not generated from the API objects.
+var skippedAttributes = map[string]bool{
+	"ignore_warnings": true,
+	"ignore_errors":   true,
+	"domain":          true,
+	"position":        true,
+	"layer":           true,
+	"package":         true,
+	"new_name":        true,
+}
+
+// render returns the resources and their import blocks as HCL.
+func (g *generator) render() string {
+	var sb strings.Builder
+	for _, b := range g.blocks {
+		r := g.resources[b.resourceType]
+		sb.WriteString(fmt.Sprintf("import {\n  to = %s\n  id = %s\n}\n\n", b.address(), quote(b.importId)))
+		sb.WriteString(fmt.Sprintf("resource %q %q {\n", b.resourceType, b.name))
+		for _, k := range sortedAttributes(b.attributes) {
+			sb.WriteString(fmt.Sprintf("  %s = %s\n", k, b.attributes[k]))
+		}
+		if len(b.position) == 2 {
+			sb.WriteString(fmt.Sprintf("  position {\n    %s = %s\n  }\n", b.position[0], b.position[1]))
+		}
+		g.writeAttributes(&sb, r.Schema, b.object, "  ")
+		if len(b.position) == 2 {
+			// The position isn't read back from the server, keep the imported rules where they are.
+			sb.WriteString("  lifecycle {\n    ignore_changes = [position]\n  }\n")
+		}
+		sb.WriteString("}\n\n")
+	}
+	return sb.String()
+}
+
+// writeAttributes writes the attributes and nested blocks of the schema which are set in the object.
+// API fields use dashes where the schema uses underscores.
+func (g *generator) writeAttributes(sb *strings.Builder, s map[string]*schema.Schema, object map[string]interface{}, indent string) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if _, ok := s["name"]; ok {
+		// name first, like in the examples
+		sort.SliceStable(keys, func(i, j int) bool { return keys[i] == "name" && keys[j] != "name" })
+	}
+
+	for _, k := range keys {
+		field := s[k]
+		if skippedAttributes[k] || field.Sensitive || (field.Computed && !field.Optional && !field.Required) {
+			continue
+		}
+		value, ok := object[strings.ReplaceAll(k, "_", "-")]
+		if !ok || value == nil || isEmpty(value) || (field.Default != nil && reflect.DeepEqual(normalize(field.Default), normalize(value))) {
+			continue
+		}
+
+		if elem, ok := field.Elem.(*schema.Resource); ok && (field.Type == schema.TypeList || field.Type == schema.TypeSet) {
+			items, isList := value.([]interface{})
+			if !isList {
+				items = []interface{}{value}
+			}
+			for _, item := range items {
+				nested, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				var block strings.Builder
+				g.writeAttributes(&block, elem.Schema, nested, indent+"  ")
+				if block.Len() > 0 {
+					sb.WriteString(fmt.Sprintf("%s%s {\n%s%s}\n", indent, k, block.String(), indent))
+				}
+			}
+			continue
+		}
+		if expr := g.expression(field, value); expr != "" {
+			sb.WriteString(fmt.Sprintf("%s%s = %s\n", indent, k, expr))
+		}
+	}
+}
+
+// expression returns the HCL expression of an attribute value, or "" when the value doesn't
+// match the attribute type.
+func (g *generator) expression(field *schema.Schema, value interface{}) string {
+	switch field.Type {
+	case schema.TypeList, schema.TypeSet:
+		items, ok := value.([]interface{})
+		if !ok {
+			return ""
+		}
+		elem, _ := field.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+		exprs := make([]string, 0, len(items))
+		for _, item := range items {
+			if expr := g.expression(elem, item); expr != "" {
+				exprs = append(exprs, expr)
+			}
+		}
+		return "[" + strings.Join(exprs, ", ") + "]"
+	case schema.TypeMap:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			if expr := g.expression(&schema.Schema{Type: schema.TypeString}, m[k]); expr != "" {
+				pairs = append(pairs, fmt.Sprintf("%s = %s", strings.ReplaceAll(k, "-", "_"), expr))
+			}
+		}
+		return "{" + strings.Join(pairs, ", ") + "}"
+	case schema.TypeBool:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b)
+		}
+	case schema.TypeInt, schema.TypeFloat:
+		if f, ok := value.(float64); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	default:
+		switch v := value.(type) {
+		case string:
+			if address, ok := g.addresses[v]; ok {
+				return address + ".name"
+			}
+			return quote(v)
+		case float64:
+			return quote(strconv.FormatFloat(v, 'f', -1, 64))
+		case bool:
+			return quote(strconv.FormatBool(v))
+		case map[string]interface{}:
+			// object reference
+			if address, ok := g.addresses[stringValue(v["uid"])]; ok {
+				return address + ".name"
+			}
+			if name := stringValue(v["name"]); name != "" {
+				return quote(name)
+			}
+		}
+	}
+	return ""
+}
+
+// quote returns an HCL string literal. Template sequences are escaped.
+func quote(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	return strings.ReplaceAll(q, "%{", "%%{")
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// normalize converts schema defaults to the types of decoded JSON values.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return value
+}
+
+func sortedAttributes(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -89,7 +89,7 @@ func main() {
 		payload["ignore-warnings"] = ignoreWarnings
 	}
 
-	installPolicyRes, err := apiClient.ApiCall("install-policy", payload, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	installPolicyRes, err := commands.ApiCall(&apiClient, "install-policy", payload, true)
 	if err != nil {
 		fmt.Println("Install policy error: " + err.Error())
 		os.Exit(1)
@@ -13,7 +13,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	logoutRes, err := apiClient.ApiCall("logout", make(map[string]interface{}), apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	logoutRes, err := commands.ApiCall(&apiClient, "logout", make(map[string]interface{}), true)
 	if err != nil {
 		fmt.Println("logout error: " + err.Error())
 		os.Exit(1)
@@ -13,7 +13,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	publishRes, err := apiClient.ApiCall("publish", map[string]interface{}{}, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	publishRes, err := commands.ApiCall(&apiClient, "publish", map[string]interface{}{}, true)
 	if err != nil {
 		fmt.Println("Publish error: " + err.Error())
 		os.Exit(1)
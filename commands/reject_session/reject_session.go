@@ -19,7 +19,7 @@ func main() {
 	payload := make(map[string]interface{})
 	payload["uid"] = os.Args[1]
 	payload["comments"] = os.Args[2]
-	rejectSessionRes, err := apiClient.ApiCall("reject-session", payload, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	rejectSessionRes, err := commands.ApiCall(&apiClient, "reject-session", payload, true)
 	if err != nil {
 		fmt.Println("Reject Session error: " + err.Error())
 		os.Exit(1)
@@ -20,7 +20,7 @@ func main() {
 		payload["uid"] = os.Args[1]
 	}
 
-	submitSessionRes, err := apiClient.ApiCall("submit-session", payload, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	submitSessionRes, err := commands.ApiCall(&apiClient, "submit-session", payload, true)
 	if err != nil {
 		fmt.Println("Submit Session error: " + err.Error())
 		os.Exit(1)
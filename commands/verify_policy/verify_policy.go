@@ -26,7 +26,7 @@ func main() {
 		payload["policy-package"] = policyPackage
 	}
 
-	verifyRes, err := apiClient.ApiCall("verify-policy", payload, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	verifyRes, err := commands.ApiCall(&apiClient, "verify-policy", payload, true)
 	if err != nil {
 		fmt.Println("Verify policy error: " + err.Error())
 		os.Exit(1)
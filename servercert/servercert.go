@@ -0,0 +1,254 @@
+// Package servercert verifies the certificate of a Check Point server on the connection of each
+// request the provider and the commands binaries send it. The SDK sends its requests through
+// http.DefaultTransport without verifying the certificate, so Register replaces it with a transport
+// which verifies the certificate of registered servers during the TLS handshake. The server is
+// trusted either by the fingerprint of its certificate or by the CA which issued it, e.g. the
+// internal CA of the Management Server.
+package servercert
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const dialTimeout = 30 * time.Second
+
+// Trust holds the fingerprint and the CA certificates the server certificate is verified with.
+// The zero value trusts any certificate.
+type Trust struct {
+	fingerprint string
+	roots       *x509.CertPool
+}
+
+// New returns the trust of a SHA-256 or SHA-1 certificate fingerprint in hex, with or without colons,
+// and of the CA certificates in caPEM. Both are optional.
+func New(fingerprint string, caPEM string) (Trust, error) {
+	var t Trust
+	if fingerprint != "" {
+		fp, err := normalizeFingerprint(fingerprint)
+		if err != nil {
+			return Trust{}, err
+		}
+		t.fingerprint = fp
+	}
+	if strings.TrimSpace(caPEM) != "" {
+		t.roots = x509.NewCertPool()
+		if !t.roots.AppendCertsFromPEM([]byte(caPEM)) {
+			return Trust{}, fmt.Errorf("CA certificate PEM has no valid certificate")
+		}
+	}
+	return t, nil
+}
+
+// Enabled reports whether the server certificate is verified.
+func (t Trust) Enabled() bool {
+	return t.fingerprint != "" || t.roots != nil
+}
+
+// endpoint is a registered server, with the trust of its certificate and the proxy its connections
+// go through.
+type endpoint struct {
+	trust Trust
+	proxy string
+}
+
+var (
+	endpoints   sync.Map // address -> endpoint
+	installOnce sync.Once
+	transport   *http.Transport
+)
+
+// Register makes the requests to the server at address ("host:port") which go through
+// http.DefaultTransport verify its certificate with trust on their own connection. The connections
+// go through the HTTP proxy ("host:port") when it's set, or the proxy of the environment. Clients of
+// the SDK must not set a proxy, the SDK replaces http.DefaultTransport for proxied requests.
+// A server registered with a trust keeps it when it's registered again without one. Idle
+// connections verified with another trust are closed.
+func Register(address string, trust Trust, proxy string) {
+	if proxy == "" {
+		proxy = environmentProxy(address)
+	}
+	previous, registered := endpoints.Load(address)
+	if registered && previous.(endpoint).trust.Enabled() && !trust.Enabled() {
+		trust = previous.(endpoint).trust
+	}
+	e := endpoint{trust: trust, proxy: proxy}
+	endpoints.Store(address, e)
+	installOnce.Do(func() {
+		transport = newTransport()
+		http.DefaultTransport = transport
+	})
+	if registered && previous.(endpoint) != e {
+		transport.CloseIdleConnections()
+	}
+}
+
+// newTransport returns the transport of the registered servers. Other servers are connected to as
+// by the default transport.
+func newTransport() *http.Transport {
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second}
+	return &http.Transport{
+		Proxy: func(req *http.Request) (*url.URL, error) {
+			if _, ok := endpoints.Load(canonicalAddress(req.URL)); ok {
+				// the proxy of registered servers is tunneled by DialTLSContext
+				return nil, nil
+			}
+			return http.ProxyFromEnvironment(req)
+		},
+		DialContext: dialer.DialContext,
+		DialTLSContext: func(ctx context.Context, network string, address string) (net.Conn, error) {
+			v, ok := endpoints.Load(address)
+			if !ok {
+				return (&tls.Dialer{NetDialer: dialer}).DialContext(ctx, network, address)
+			}
+			return v.(endpoint).dial(ctx, address)
+		},
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+// dial connects to the server and verifies its certificate during the handshake.
+func (e endpoint) dial(ctx context.Context, address string) (net.Conn, error) {
+	conn, err := dial(ctx, address, e.proxy)
+	if err != nil {
+		return nil, err
+	}
+	config := e.trust.TLSConfig(address)
+	config.ServerName, _, _ = net.SplitHostPort(address)
+	tlsConn := tls.Client(conn, config)
+	handshakeCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	if err := tlsConn.HandshakeContext(handshakeCtx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+func canonicalAddress(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	return net.JoinHostPort(u.Hostname(), "443")
+}
+
+// environmentProxy returns the proxy of the environment (HTTPS_PROXY, NO_PROXY) for the server.
+func environmentProxy(address string) string {
+	proxy, err := http.ProxyFromEnvironment(&http.Request{URL: &url.URL{Scheme: "https", Host: address}})
+	if err != nil || proxy == nil {
+		return ""
+	}
+	if proxy.Port() == "" {
+		return net.JoinHostPort(proxy.Hostname(), "80")
+	}
+	return proxy.Host
+}
+
+// TLSConfig returns the TLS configuration of a client which sends requests to the server at address
+// itself. The certificate is verified on each connection.
+func (t Trust) TLSConfig(address string) *tls.Config {
+	config := &tls.Config{InsecureSkipVerify: true}
+	if t.Enabled() {
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			return t.VerifyCertificates(address, state.PeerCertificates)
+		}
+	}
+	return config
+}
+
+// dial connects to address, through a CONNECT tunnel of the HTTP proxy when it's set.
+func dial(ctx context.Context, address string, proxy string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	if proxy == "" {
+		return dialer.DialContext(ctx, "tcp", address)
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", proxy)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(dialTimeout))
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	res, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused the connection: %s", proxy, res.Status)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// VerifyCertificates verifies the certificate chain the server at address presented.
+func (t Trust) VerifyCertificates(address string, certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return fmt.Errorf("server %s presented no certificate", address)
+	}
+	leaf := certs[0]
+	if t.fingerprint != "" {
+		actual := Fingerprint(leaf)
+		if len(t.fingerprint) == sha1.Size*2 {
+			sum := sha1.Sum(leaf.Raw)
+			actual = hex.EncodeToString(sum[:])
+		}
+		if actual != t.fingerprint {
+			return fmt.Errorf("certificate fingerprint of server %s doesn't match: expected %s, the server presented %s", address, t.fingerprint, actual)
+		}
+	}
+	if t.roots != nil {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		// The host name isn't verified: certificates of the Management Server internal CA name the
+		// server in the subject only.
+		_, err := leaf.Verify(x509.VerifyOptions{
+			Roots:         t.roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err != nil {
+			return fmt.Errorf("certificate of server %s isn't issued by the trusted CA: %s", address, err)
+		}
+	}
+	return nil
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the certificate in hex.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeFingerprint(fingerprint string) (string, error) {
+	fp := strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(fingerprint))
+	if _, err := hex.DecodeString(fp); err != nil || (len(fp) != sha256.Size*2 && len(fp) != sha1.Size*2) {
+		return "", fmt.Errorf("certificate fingerprint %q is not a SHA-256 or SHA-1 fingerprint in hex", fingerprint)
+	}
+	return fp, nil
+}
@@ -0,0 +1,243 @@
+package servercert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testServer(t *testing.T) (*httptest.Server, string, int) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	host, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return server, host, p
+}
+
+// testGet sends a request to the server at address through the default transport, on a new connection.
+func testGet(address string) error {
+	http.DefaultTransport.(*http.Transport).CloseIdleConnections()
+	res, err := http.Get("https://" + address + "/")
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+func TestVerifyFingerprint(t *testing.T) {
+	server, host, port := testServer(t)
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	cert := server.Certificate()
+
+	sha256Colons := strings.ToUpper(Fingerprint(cert))
+	var colons []string
+	for i := 0; i < len(sha256Colons); i += 2 {
+		colons = append(colons, sha256Colons[i:i+2])
+	}
+	sum := sha1.Sum(cert.Raw)
+	for _, fingerprint := range []string{Fingerprint(cert), strings.Join(colons, ":"), hex.EncodeToString(sum[:])} {
+		trust, err := New(fingerprint, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		Register(address, trust, "")
+		if err := testGet(address); err != nil {
+			t.Errorf("expected fingerprint %s to match, got %s", fingerprint, err)
+		}
+	}
+
+	trust, _ := New(strings.Repeat("ab", 32), "")
+	Register(address, trust, "")
+	if err := testGet(address); err == nil || !strings.Contains(err.Error(), "the server presented "+Fingerprint(cert)) {
+		t.Errorf("expected a fingerprint mismatch, got %v", err)
+	}
+	if _, err := New("not a fingerprint", ""); err == nil {
+		t.Errorf("expected an invalid fingerprint to be rejected")
+	}
+}
+
+func TestVerifyCA(t *testing.T) {
+	server, host, port := testServer(t)
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+
+	// the test server certificate is self-signed
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	trust, err := New("", caPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Register(address, trust, "")
+	if err := testGet(address); err != nil {
+		t.Errorf("expected the certificate to be trusted, got %s", err)
+	}
+
+	if err := trust.VerifyCertificates("other", []*x509.Certificate{testCertificate(t)}); err == nil || !strings.Contains(err.Error(), "isn't issued by the trusted CA") {
+		t.Errorf("expected a certificate of another CA to be rejected, got %v", err)
+	}
+	if _, err := New("", "not a certificate"); err == nil {
+		t.Errorf("expected an invalid CA certificate to be rejected")
+	}
+}
+
+// testCertificate returns a self-signed certificate.
+func testCertificate(t *testing.T) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "other"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestTrustDisabled(t *testing.T) {
+	var trust Trust
+	if trust.Enabled() {
+		t.Errorf("expected the zero value to trust any certificate")
+	}
+
+	server, host, port := testServer(t)
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	Register(address, trust, "")
+	if err := testGet(address); err != nil {
+		t.Errorf("expected any certificate of a server without a trust, got %s", err)
+	}
+	fingerprint, _ := New(Fingerprint(server.Certificate()), "")
+	Register(address, fingerprint, "")
+	Register(address, trust, "")
+	if v, _ := endpoints.Load(address); !v.(endpoint).trust.Enabled() {
+		t.Errorf("expected the server to keep its trust")
+	}
+
+	// servers which aren't registered are verified as by the default transport
+	_, host, port = testServer(t)
+	if err := testGet(net.JoinHostPort(host, strconv.Itoa(port))); err == nil || !strings.Contains(err.Error(), "certificate") {
+		t.Errorf("expected the certificate of a server which isn't registered to be verified, got %v", err)
+	}
+}
+
+// testProxy returns the address of an HTTP proxy which tunnels CONNECT requests and counts them.
+func testProxy(t *testing.T) (string, *atomic.Int32) {
+	tunnels := &atomic.Int32{}
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		tunnels.Add(1)
+		w.WriteHeader(http.StatusOK)
+		conn, buf, _ := w.(http.Hijacker).Hijack()
+		go func() {
+			_, _ = io.Copy(upstream, buf)
+			upstream.Close()
+		}()
+		_, _ = io.Copy(conn, upstream)
+		conn.Close()
+	}))
+	t.Cleanup(proxy.Close)
+	return proxy.Listener.Addr().String(), tunnels
+}
+
+func TestVerifyThroughProxy(t *testing.T) {
+	server, host, port := testServer(t)
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	proxy, tunnels := testProxy(t)
+
+	trust, _ := New(Fingerprint(server.Certificate()), "")
+	Register(address, trust, proxy)
+	if err := testGet(address); err != nil {
+		t.Fatalf("expected the certificate to be verified through the proxy, got %s", err)
+	}
+	if tunnels.Load() != 1 {
+		t.Errorf("expected the connection to go through the proxy, got %d tunnels", tunnels.Load())
+	}
+
+	untrusted, _ := New(strings.Repeat("ab", 32), "")
+	Register(address, untrusted, proxy)
+	if err := testGet(address); err == nil || !strings.Contains(err.Error(), "doesn't match") {
+		t.Errorf("expected the certificate to be verified through the proxy, got %v", err)
+	}
+	Register("192.0.2.1:1", trust, proxy)
+	if err := testGet("192.0.2.1:1"); err == nil || !strings.Contains(err.Error(), "refused the connection") {
+		t.Errorf("expected the proxy error, got %v", err)
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	server, _, _ := testServer(t)
+	address := server.Listener.Addr().String()
+
+	trusted, _ := New(Fingerprint(server.Certificate()), "")
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: trusted.TLSConfig(address)}}
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the request to be sent, got %s", err)
+	}
+	res.Body.Close()
+
+	untrusted, _ := New(strings.Repeat("ab", 32), "")
+	client = &http.Client{Transport: &http.Transport{TLSClientConfig: untrusted.TLSConfig(address)}}
+	if _, err := client.Get(server.URL); err == nil || !strings.Contains(err.Error(), "doesn't match") {
+		t.Errorf("expected the connection to be rejected, got %v", err)
+	}
+	if config := (Trust{}).TLSConfig(address); config.VerifyConnection != nil {
+		t.Errorf("expected no verification without a trust")
+	}
+}
+
+func TestVerifyOnRequestConnection(t *testing.T) {
+	server, host, port := testServer(t)
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	connections := &atomic.Int32{}
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+
+	trust, _ := New(Fingerprint(server.Certificate()), "")
+	Register(address, trust, "")
+	if err := testGet(address); err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.Get("https://" + address + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if connections.Load() != 1 {
+		t.Errorf("expected the requests to verify the certificate on their own connection, got %d connections", connections.Load())
+	}
+}
@@ -0,0 +1,194 @@
+// Package sessionstore keeps the Check Point sessions of the provider and the commands binaries in
+// a file, so later runs can continue the session instead of logging in again. Sessions are keyed
+// by server, domain, user and Smart-1 Cloud management id, so several sessions can be kept side by
+// side. The file is readable by its owner only and is locked while it's read or written, so
+// parallel runs in the same directory don't overwrite each other's sessions. Runs with the same
+// key share one session in the file: the session of the run which logged in last is kept.
+package sessionstore
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Key identifies the session of a user on a server.
+type Key struct {
+	Server      string
+	Domain      string
+	User        string
+	CloudMgmtId string
+}
+
+// NewKey returns the key of the user session. Users which login with an API key are identified by
+// a hash of the key, so the key isn't saved in the file.
+func NewKey(server string, domain string, username string, apiKey string, cloudMgmtId string) Key {
+	user := username
+	if apiKey != "" {
+		user = fmt.Sprintf("api-key:%x", sha256.Sum256([]byte(apiKey)))[:24]
+	}
+	return Key{Server: server, Domain: domain, User: user, CloudMgmtId: cloudMgmtId}
+}
+
+func (k Key) String() string {
+	return strings.Join([]string{k.Server, k.Domain, k.User, k.CloudMgmtId}, "|")
+}
+
+// Session is a saved session.
+type Session struct {
+	Sid string `json:"sid"`
+	Uid string `json:"uid"`
+}
+
+// Save saves the session in the session file at path without a key, as the key-less API of earlier
+// versions did.
+//
+// Deprecated: use Store.Put, which keeps a session per server, domain and user.
+func (s *Session) Save(path string) error {
+	return New(path).Put(Key{}, *s)
+}
+
+type storeFile struct {
+	Sessions map[string]Session `json:"sessions"`
+}
+
+// Store is a session file.
+type Store struct {
+	path string
+}
+
+func New(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path of the session file.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Get returns the saved session of the key. An empty session is returned when there's none.
+func (s *Store) Get(key Key) (Session, error) {
+	var session Session
+	err := s.withLock(false, func() error {
+		sessions, err := s.read()
+		if err != nil {
+			return err
+		}
+		session = sessions.Sessions[key.String()]
+		return nil
+	})
+	return session, err
+}
+
+// Legacy returns the session saved without a key by Session.Save, or the only session of the file
+// when there's none, as the key-less API of earlier versions did. An empty session is returned when
+// the file has no such session.
+func (s *Store) Legacy() (Session, error) {
+	var session Session
+	err := s.withLock(false, func() error {
+		sessions, err := s.read()
+		if err != nil {
+			return err
+		}
+		if saved, ok := sessions.Sessions[Key{}.String()]; ok {
+			session = saved
+			return nil
+		}
+		if len(sessions.Sessions) == 1 {
+			for _, only := range sessions.Sessions {
+				session = only
+			}
+		}
+		return nil
+	})
+	return session, err
+}
+
+// Put saves the session of the key. Sessions of other keys are kept.
+func (s *Store) Put(key Key, session Session) error {
+	return s.update(func(sessions *storeFile) {
+		sessions.Sessions[key.String()] = session
+	})
+}
+
+// Delete removes the session of the key.
+func (s *Store) Delete(key Key) error {
+	return s.update(func(sessions *storeFile) {
+		delete(sessions.Sessions, key.String())
+	})
+}
+
+func (s *Store) update(f func(sessions *storeFile)) error {
+	return s.withLock(true, func() error {
+		sessions, err := s.read()
+		if err != nil {
+			return err
+		}
+		f(&sessions)
+		return s.write(sessions)
+	})
+}
+
+// withLock runs f while holding a lock of the session file. Locks are taken on a separate lock
+// file, since the session file is replaced on write.
+func (s *Store) withLock(exclusive bool, f func() error) error {
+	lock, err := os.OpenFile(s.path+".lock", os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open session lock file: %s", err)
+	}
+	defer lock.Close()
+	if err := lockFile(lock, exclusive); err != nil {
+		return fmt.Errorf("failed to lock session file %s: %s", s.path, err)
+	}
+	defer unlockFile(lock)
+	return f()
+}
+
+// read returns the saved sessions. Files of earlier versions, which kept a single session without
+// a key, are read as empty.
+func (s *Store) read() (storeFile, error) {
+	sessions := storeFile{Sessions: make(map[string]Session)}
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) || (err == nil && len(b) == 0) {
+		return sessions, nil
+	}
+	if err != nil {
+		return sessions, err
+	}
+	if err := json.Unmarshal(b, &sessions); err != nil {
+		return storeFile{Sessions: make(map[string]Session)}, nil
+	}
+	if sessions.Sessions == nil {
+		sessions.Sessions = make(map[string]Session)
+	}
+	return sessions, nil
+}
+
+// write replaces the session file, so readers never see a partially written file.
+func (s *Store) write(sessions storeFile) error {
+	b, err := json.MarshalIndent(sessions, "", " ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
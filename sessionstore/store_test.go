@@ -0,0 +1,125 @@
+package sessionstore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestStoreKeepsSessionsByKey(t *testing.T) {
+	store := New(filepath.Join(t.TempDir(), "sid.json"))
+	mgmt := NewKey("mgmt1", "", "admin", "", "")
+	domain := NewKey("mgmt1", "domain1", "admin", "", "")
+
+	if s, err := store.Get(mgmt); err != nil || s.Sid != "" {
+		t.Fatalf("expected no session, got %v %v", s, err)
+	}
+	if err := store.Put(mgmt, Session{Sid: "sid1", Uid: "uid1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(domain, Session{Sid: "sid2", Uid: "uid2"}); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := store.Get(mgmt); s.Sid != "sid1" {
+		t.Errorf("expected sid1, got %v", s)
+	}
+	if s, _ := store.Get(domain); s.Sid != "sid2" {
+		t.Errorf("expected sid2, got %v", s)
+	}
+
+	if err := store.Delete(mgmt); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := store.Get(mgmt); s.Sid != "" {
+		t.Errorf("expected session to be deleted, got %v", s)
+	}
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(store.Path())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0o600 {
+			t.Errorf("expected mode 0600, got %o", info.Mode().Perm())
+		}
+	}
+}
+
+func TestStoreConcurrentPut(t *testing.T) {
+	store := New(filepath.Join(t.TempDir(), "sid.json"))
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// each run opens the store on its own, like parallel terraform runs
+			key := NewKey(fmt.Sprintf("mgmt%d", i), "", "admin", "", "")
+			if err := New(store.Path()).Put(key, Session{Sid: fmt.Sprintf("sid%d", i)}); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 20; i++ {
+		s, err := store.Get(NewKey(fmt.Sprintf("mgmt%d", i), "", "admin", "", ""))
+		if err != nil || s.Sid != fmt.Sprintf("sid%d", i) {
+			t.Errorf("session %d was lost: %v %v", i, s, err)
+		}
+	}
+}
+
+func TestStoreIgnoresLegacyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sid.json")
+	if err := ioutil.WriteFile(path, []byte(`{"sid": "old", "uid": "old"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store := New(path)
+	key := NewKey("mgmt1", "", "admin", "", "")
+	if s, err := store.Get(key); err != nil || s.Sid != "" {
+		t.Fatalf("expected no session, got %v %v", s, err)
+	}
+	if err := store.Put(key, Session{Sid: "sid1"}); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := store.Get(key); s.Sid != "sid1" {
+		t.Errorf("expected sid1, got %v", s)
+	}
+}
+
+func TestStoreLegacy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sid.json")
+	store := New(path)
+	key := NewKey("mgmt1", "", "admin", "", "")
+	if err := store.Put(key, Session{Sid: "sid1"}); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := store.Legacy(); err != nil || s.Sid != "sid1" {
+		t.Fatalf("expected the only session, got %v %v", s, err)
+	}
+
+	if err := (&Session{Sid: "saved"}).Save(path); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := store.Legacy(); s.Sid != "saved" {
+		t.Errorf("expected the session saved without a key, got %v", s)
+	}
+	if s, _ := store.Get(key); s.Sid != "sid1" {
+		t.Errorf("expected the keyed session to be kept, got %v", s)
+	}
+}
+
+func TestNewKeyHidesApiKey(t *testing.T) {
+	key := NewKey("mgmt1", "", "", "my-api-key", "")
+	if strings.Contains(key.String(), "my-api-key") || key.User == "" {
+		t.Errorf("unexpected key %v", key)
+	}
+	if key != NewKey("mgmt1", "", "", "my-api-key", "") || key == NewKey("mgmt1", "", "", "other-key", "") {
+		t.Errorf("expected keys of the same api key to match")
+	}
+}